@@ -14,17 +14,57 @@
 
 package entgql
 
-import "entgo.io/ent/schema"
+import (
+	"encoding/json"
+
+	"entgo.io/ent/schema"
+)
 
 // Annotation annotates fields and edges with metadata for templates.
 type Annotation struct {
 	// OrderField is the ordering field as defined in graphql schema.
+	// When used on edges, non-unique edges are ordered by the count
+	// of their neighbours, and unique edges by their OrderEdgeField.
 	OrderField string
+	// OrderEdgeField is the field of the neighbour node used
+	// for ordering by a unique edge annotated with OrderField.
+	OrderEdgeField string
 	// Bind implies the edge field name in graphql schema
-	// is equivalent to the name used in ent schema.
+	// is equivalent to the name used in ent schema. When
+	// used on fields, the ent name is accepted by the field
+	// collection in addition to its camel-case name.
 	Bind bool
 	// Mapping is the edge field names as defined in graphql schema.
+	// When used on fields, it replaces their camel-case name in
+	// the field collection.
 	Mapping []string
+	// RelayConnection exposes a non-unique edge as a Relay connection
+	// with its pagination arguments, instead of a list of nodes.
+	RelayConnection bool
+	// Type is the underlying GraphQL type name (e.g. Boolean).
+	Type string
+	// DefaultPageSize is the page size of connections that are paginated
+	// without the first and last arguments. When used on schemas, it applies
+	// to all connections of the type, and can be overridden by edges.
+	DefaultPageSize int
+	// MaxPageSize is the maximum value of the first and last arguments of
+	// connections, and their page size if no DefaultPageSize is set. When
+	// used on schemas, it applies to all connections of the type, and can
+	// be overridden by edges.
+	MaxPageSize int
+	// Events enables the generated change-event hooks of the
+	// schema, which publish its changes to a Broker.
+	Events bool
+	// Skip hides the field or the edge from the GraphQL API,
+	// that is, the generated schema and the Node API.
+	Skip bool
+	// EnumValues maps the values of an enum field, as stored in the
+	// database, to their names in the GraphQL enum. Values that are
+	// not mapped keep their database value as their GraphQL name.
+	EnumValues map[string]string
+	// Key is the fields of the Apollo Federation key of the
+	// schema. Defaults to the id field, when federation is used.
+	Key []string
 }
 
 // Name implements ent.Annotation interface.
@@ -37,6 +77,18 @@ func OrderField(name string) Annotation {
 	return Annotation{OrderField: name}
 }
 
+// OrderEdgeField returns an order field annotation for unique edges.
+// It orders the edge owner by the given field of its neighbour.
+//
+//	edge.From("parent", Todo.Type).
+//		Ref("children").
+//		Unique().
+//		Annotations(entgql.OrderEdgeField("PARENT_PRIORITY", "priority"))
+//
+func OrderEdgeField(name, field string) Annotation {
+	return Annotation{OrderField: name, OrderEdgeField: field}
+}
+
 // Bind returns a binding annotation.
 func Bind() Annotation {
 	return Annotation{Bind: true}
@@ -47,6 +99,106 @@ func MapsTo(names ...string) Annotation {
 	return Annotation{Mapping: names}
 }
 
+// RelayConnection returns an annotation for exposing a non-unique edge as a
+// Relay connection. When eager-loaded by field collection, the pagination
+// arguments are applied per edge owner in a single query that uses window
+// functions, and therefore requires a database that supports them (e.g.
+// SQLite 3.25, MySQL 8 or PostgreSQL).
+//
+//	edge.To("children", Todo.Type).
+//		Annotations(entgql.Bind(), entgql.RelayConnection())
+//
+func RelayConnection() Annotation {
+	return Annotation{RelayConnection: true}
+}
+
+// Type returns a type annotation. It overrides the
+// GraphQL type of the field in the generated schema.
+func Type(name string) Annotation {
+	return Annotation{Type: name}
+}
+
+// DefaultPageSize returns an annotation for setting the default page size of
+// connections. It can be used on schemas and on connection edges.
+//
+//	func (Todo) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entgql.DefaultPageSize(20),
+//			entgql.MaxPageSize(100),
+//		}
+//	}
+//
+func DefaultPageSize(size int) Annotation {
+	return Annotation{DefaultPageSize: size}
+}
+
+// MaxPageSize returns an annotation for limiting the page size of
+// connections. It can be used on schemas and on connection edges.
+func MaxPageSize(size int) Annotation {
+	return Annotation{MaxPageSize: size}
+}
+
+// Events returns a schema annotation for publishing the changes of the type to the
+// Broker registered with the generated Client.UseBroker, after their transaction
+// is committed. The changes can be consumed using the generated Subscribe method
+// of the type client.
+//
+//	func (Todo) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entgql.Events(),
+//		}
+//	}
+//
+func Events() Annotation {
+	return Annotation{Events: true}
+}
+
+// Skip returns an annotation for hiding fields and edges from the
+// GraphQL API. Skipped fields and edges are omitted from the generated
+// schema, its inputs and the Node API, and cannot be ordered by.
+//
+//	field.String("password_hash").
+//		Sensitive().
+//		Annotations(entgql.Skip())
+//
+func Skip() Annotation {
+	return Annotation{Skip: true}
+}
+
+// EnumValues returns an annotation for mapping the values of an enum field
+// to the names of its GraphQL enum, without changing the values that are
+// stored in the database. The generated MarshalGQL and UnmarshalGQL methods
+// of the enum translate between the two, and the enum is ordered by the
+// declaration order of its values when used as an order field.
+//
+//	field.Enum("status").
+//		Values("in_progress", "completed").
+//		Annotations(
+//			entgql.EnumValues(map[string]string{
+//				"in_progress": "IN_PROGRESS",
+//				"completed":   "COMPLETED",
+//			}),
+//		)
+//
+func EnumValues(values map[string]string) Annotation {
+	return Annotation{EnumValues: values}
+}
+
+// Key returns a schema annotation for setting the fields of its Apollo Federation
+// key (i.e. the @key directive), which are used by the gateway for referencing its
+// entities. Defaults to the id field. The key must identify a single entity, that is,
+// one of its fields is unique, or its fields cover the columns of a unique index.
+//
+//	func (User) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entgql.Key("email"),
+//		}
+//	}
+//
+func Key(fields ...string) Annotation {
+	return Annotation{Key: fields}
+}
+
 // Merge implements the schema.Merger interface.
 func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 	var ant Annotation
@@ -63,15 +215,51 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 	if ant.OrderField != "" {
 		a.OrderField = ant.OrderField
 	}
+	if ant.OrderEdgeField != "" {
+		a.OrderEdgeField = ant.OrderEdgeField
+	}
 	if ant.Bind {
 		a.Bind = true
 	}
 	if len(ant.Mapping) != 0 {
 		a.Mapping = ant.Mapping
 	}
+	if ant.RelayConnection {
+		a.RelayConnection = true
+	}
+	if ant.Type != "" {
+		a.Type = ant.Type
+	}
+	if ant.DefaultPageSize != 0 {
+		a.DefaultPageSize = ant.DefaultPageSize
+	}
+	if ant.MaxPageSize != 0 {
+		a.MaxPageSize = ant.MaxPageSize
+	}
+	if ant.Events {
+		a.Events = true
+	}
+	if ant.Skip {
+		a.Skip = true
+	}
+	if len(ant.EnumValues) != 0 {
+		a.EnumValues = ant.EnumValues
+	}
+	if len(ant.Key) != 0 {
+		a.Key = ant.Key
+	}
 	return a
 }
 
+// Decode unmarshalls the annotation from its JSON decoded object.
+func (a *Annotation) Decode(annotation interface{}) error {
+	buf, err := json.Marshal(annotation)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(buf, a)
+}
+
 var (
 	_ schema.Annotation = (*Annotation)(nil)
 	_ schema.Merger     = (*Annotation)(nil)
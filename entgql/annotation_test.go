@@ -26,6 +26,10 @@ func TestAnnotation(t *testing.T) {
 	annotation := entgql.OrderField("foo")
 	require.Equal(t, "foo", annotation.OrderField)
 
+	annotation = entgql.OrderEdgeField("foo", "bar")
+	require.Equal(t, "foo", annotation.OrderField)
+	require.Equal(t, "bar", annotation.OrderEdgeField)
+
 	annotation = entgql.Bind()
 	require.True(t, annotation.Bind)
 	require.Empty(t, annotation.Mapping)
@@ -34,4 +38,22 @@ func TestAnnotation(t *testing.T) {
 	annotation = entgql.MapsTo(names...)
 	require.False(t, annotation.Bind)
 	require.ElementsMatch(t, names, annotation.Mapping)
+
+	annotation = entgql.Type("Time")
+	require.Equal(t, "Time", annotation.Type)
+
+	annotation = entgql.RelayConnection()
+	require.True(t, annotation.RelayConnection)
+
+	annotation = entgql.Events()
+	require.True(t, annotation.Events)
+
+	annotation = entgql.Skip()
+	require.True(t, annotation.Skip)
+
+	annotation = entgql.EnumValues(map[string]string{"in_progress": "IN_PROGRESS"})
+	require.Equal(t, map[string]string{"in_progress": "IN_PROGRESS"}, annotation.EnumValues)
+
+	annotation = entgql.Key("owner_id", "name")
+	require.Equal(t, []string{"owner_id", "name"}, annotation.Key)
 }
@@ -0,0 +1,184 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Cardinality is the number of neighbours an edge resolves to.
+type Cardinality uint8
+
+// Edge cardinalities.
+const (
+	CardinalityUnique Cardinality = iota + 1 // at most one neighbour.
+	CardinalityMany                          // list of neighbours, or a connection.
+)
+
+// DefaultCostListSize is the default estimated size of lists and connections without bounds.
+const DefaultCostListSize = 100
+
+const errCostLimit = "COST_LIMIT_EXCEEDED"
+
+// CostLimit is a graphql extension that estimates the cost of operations before they
+// are executed, and rejects operations whose cost exceeds the budget. The cost is the
+// estimated number of nodes the operation resolves: fields that return objects cost
+// the number of their parents times their cardinality, where the cardinality is 1 for
+// unique edges, the first or last argument for connections, and ListSize for unbounded
+// lists and connections.
+//
+//	srv.Use(entgql.CostLimit{
+//		Budget: 10000,
+//		Edges:  ent.EdgeCardinality,
+//	})
+//
+type CostLimit struct {
+	// Budget is the maximum estimated cost of an operation.
+	Budget int
+
+	// ListSize is the estimated number of nodes of lists, and connections
+	// without the first or last arguments. Defaults to DefaultCostListSize.
+	ListSize int
+
+	// MaxDepth is the maximum nesting depth of the fields that
+	// return objects in an operation. Zero means no limit.
+	MaxDepth int
+
+	// Edges holds the cardinality of the edge fields by their "Type.field"
+	// name (e.g. the generated EdgeCardinality). Fields that are not in the
+	// map are to-many if their type is a list, and unique otherwise.
+	Edges map[string]Cardinality
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = CostLimit{}
+
+// ExtensionName returns the extension name.
+func (CostLimit) ExtensionName() string {
+	return "EntGQLCostLimit"
+}
+
+// Validate is called when adding an extension to the server, it allows validation against the servers schema.
+func (c CostLimit) Validate(graphql.ExecutableSchema) error {
+	if c.Budget <= 0 {
+		return errors.New("entgql: cost budget must be positive")
+	}
+	if c.ListSize < 0 {
+		return errors.New("entgql: cost list size cannot be negative")
+	}
+	if c.MaxDepth < 0 {
+		return errors.New("entgql: max depth cannot be negative")
+	}
+	return nil
+}
+
+// MutateOperationContext rejects operations that exceed the budget.
+func (c CostLimit) MutateOperationContext(_ context.Context, oc *graphql.OperationContext) *gqlerror.Error {
+	if oc.Operation == nil {
+		return nil
+	}
+	cost, depth := c.estimate(oc, oc.Operation.SelectionSet, 1)
+	if c.MaxDepth > 0 && depth > c.MaxDepth {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, c.MaxDepth)
+		errcode.Set(err, errCostLimit)
+		return err
+	}
+	if cost > c.Budget {
+		err := gqlerror.Errorf("operation has cost %d, which exceeds the limit of %d", cost, c.Budget)
+		errcode.Set(err, errCostLimit)
+		return err
+	}
+	return nil
+}
+
+// estimate returns the cost and the depth of the given selections, where
+// parents is the estimated number of nodes the selections are resolved for.
+func (c CostLimit) estimate(oc *graphql.OperationContext, set ast.SelectionSet, parents int) (cost int, depth int) {
+	for _, f := range graphql.CollectFields(oc, set, nil) {
+		if len(f.Selections) == 0 || f.Definition == nil {
+			continue
+		}
+		n := saturatedMul(parents, c.cardinality(oc, f))
+		fcost, fdepth := c.estimate(oc, f.Selections, n)
+		cost = saturatedAdd(cost, saturatedAdd(n, fcost))
+		if fdepth+1 > depth {
+			depth = fdepth + 1
+		}
+	}
+	return cost, depth
+}
+
+// cardinality returns the estimated number of nodes the field resolves per parent.
+func (c CostLimit) cardinality(oc *graphql.OperationContext, f graphql.CollectedField) int {
+	switch {
+	case f.Definition.Arguments.ForName("first") != nil || f.Definition.Arguments.ForName("last") != nil:
+		args := f.ArgumentMap(oc.Variables)
+		for _, name := range []string{"first", "last"} {
+			if v, ok := args[name]; ok && v != nil {
+				if n, err := graphql.UnmarshalInt(v); err == nil && n >= 0 {
+					return n
+				}
+			}
+		}
+		return c.listSize()
+	case f.ObjectDefinition != nil && c.Edges[f.ObjectDefinition.Name+"."+f.Name] == CardinalityMany:
+		return c.listSize()
+	case f.ObjectDefinition != nil && c.Edges[f.ObjectDefinition.Name+"."+f.Name] == CardinalityUnique:
+		return 1
+	case f.Definition.Type.Elem != nil && !isConnectionEdges(f):
+		return c.listSize()
+	default:
+		return 1
+	}
+}
+
+func (c CostLimit) listSize() int {
+	if c.ListSize > 0 {
+		return c.ListSize
+	}
+	return DefaultCostListSize
+}
+
+// isConnectionEdges reports if the field is the edges list of a connection,
+// whose size was already estimated by the connection field.
+func isConnectionEdges(f graphql.CollectedField) bool {
+	return f.Name == "edges" && f.ObjectDefinition != nil && f.ObjectDefinition.Fields.ForName("pageInfo") != nil
+}
+
+const maxInt = int(^uint(0) >> 1)
+
+// saturatedAdd returns a+b of non-negative integers, or the max int on overflow.
+func saturatedAdd(a, b int) int {
+	if a > maxInt-b {
+		return maxInt
+	}
+	return a + b
+}
+
+// saturatedMul returns a*b of non-negative integers, or the max int on overflow.
+func saturatedMul(a, b int) int {
+	if a != 0 && b > maxInt/a {
+		return maxInt
+	}
+	return a * b
+}
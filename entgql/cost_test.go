@@ -0,0 +1,36 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCostLimitValidate(t *testing.T) {
+	require.Error(t, CostLimit{}.Validate(nil))
+	require.Error(t, CostLimit{Budget: 1, ListSize: -1}.Validate(nil))
+	require.Error(t, CostLimit{Budget: 1, MaxDepth: -1}.Validate(nil))
+	require.NoError(t, CostLimit{Budget: 1}.Validate(nil))
+}
+
+func TestCostSaturation(t *testing.T) {
+	require.Equal(t, 5, saturatedAdd(2, 3))
+	require.Equal(t, maxInt, saturatedAdd(maxInt, 1))
+	require.Equal(t, 6, saturatedMul(2, 3))
+	require.Equal(t, 0, saturatedMul(0, maxInt))
+	require.Equal(t, maxInt, saturatedMul(maxInt/2, 3))
+}
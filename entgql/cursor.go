@@ -0,0 +1,184 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// CursorCodec encodes the serialized form of pagination cursors into the
+// opaque string exposed to clients, and decodes it back.
+type CursorCodec interface {
+	Encode([]byte) (string, error)
+	Decode(string) ([]byte, error)
+}
+
+// The first byte of each encoded cursor holds its format version.
+// Codecs reject cursors that were encoded in a different format.
+const (
+	cursorVersionPlain byte = iota + 1
+	cursorVersionSigned
+	cursorVersionEncrypted
+)
+
+// ErrCursorFormat is returned by codecs for cursors that
+// are malformed, tampered or encoded in another format.
+var ErrCursorFormat = errors.New("entgql: invalid cursor format")
+
+// encoding used by all cursor codecs.
+var encoding = base64.RawURLEncoding
+
+// NewPlainCursorCodec returns a codec that encodes cursors as is, without
+// signing or encrypting them. It is the default codec of the generated code.
+func NewPlainCursorCodec() CursorCodec {
+	return plainCodec{}
+}
+
+type plainCodec struct{}
+
+// Encode implements the CursorCodec interface.
+func (plainCodec) Encode(data []byte) (string, error) {
+	return encoding.EncodeToString(append([]byte{cursorVersionPlain}, data...)), nil
+}
+
+// Decode implements the CursorCodec interface.
+func (plainCodec) Decode(s string) ([]byte, error) {
+	return decodeVersion(s, cursorVersionPlain)
+}
+
+// MinSignedCursorKeySize is the minimum size of the keys of signed cursor codecs.
+const MinSignedCursorKeySize = sha256.Size
+
+// NewSignedCursorCodec returns a codec that signs cursors using HMAC-SHA256 with the
+// given key, and rejects cursors that were forged or modified by clients. The keys must
+// be at least MinSignedCursorKeySize bytes long. Cursors that were signed with one of the
+// previous keys are still accepted, to allow key rotation.
+func NewSignedCursorCodec(key []byte, previous ...[]byte) (CursorCodec, error) {
+	keys := append([][]byte{key}, previous...)
+	for _, k := range keys {
+		if len(k) < MinSignedCursorKeySize {
+			return nil, fmt.Errorf("entgql: cursor signing key must be at least %d bytes long, got %d", MinSignedCursorKeySize, len(k))
+		}
+	}
+	return &signedCodec{keys: keys}, nil
+}
+
+type signedCodec struct {
+	keys [][]byte
+}
+
+// Encode implements the CursorCodec interface.
+func (c *signedCodec) Encode(data []byte) (string, error) {
+	buf := append([]byte{cursorVersionSigned}, data...)
+	return encoding.EncodeToString(append(buf, mac(c.keys[0], buf)...)), nil
+}
+
+// Decode implements the CursorCodec interface.
+func (c *signedCodec) Decode(s string) ([]byte, error) {
+	buf, err := decodeVersion(s, cursorVersionSigned)
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) < sha256.Size {
+		return nil, ErrCursorFormat
+	}
+	n := len(buf) - sha256.Size
+	signed := append([]byte{cursorVersionSigned}, buf[:n]...)
+	for _, key := range c.keys {
+		if hmac.Equal(mac(key, signed), buf[n:]) {
+			return buf[:n], nil
+		}
+	}
+	return nil, ErrCursorFormat
+}
+
+// mac returns the HMAC-SHA256 of the given buffer.
+func mac(key, buf []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(buf)
+	return h.Sum(nil)
+}
+
+// NewEncryptedCursorCodec returns a codec that encrypts and authenticates cursors
+// using AES-GCM, and hides their content from clients. The keys must be 16, 24 or
+// 32 bytes long. Cursors that were encrypted with one of the previous keys are
+// still accepted, to allow key rotation.
+func NewEncryptedCursorCodec(key []byte, previous ...[]byte) (CursorCodec, error) {
+	c := &encryptedCodec{}
+	for _, k := range append([][]byte{key}, previous...) {
+		block, err := aes.NewCipher(k)
+		if err != nil {
+			return nil, fmt.Errorf("entgql: creating cursor cipher: %w", err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("entgql: creating cursor cipher: %w", err)
+		}
+		c.aeads = append(c.aeads, aead)
+	}
+	return c, nil
+}
+
+type encryptedCodec struct {
+	aeads []cipher.AEAD
+}
+
+// Encode implements the CursorCodec interface.
+func (c *encryptedCodec) Encode(data []byte) (string, error) {
+	aead := c.aeads[0]
+	buf := make([]byte, 1+aead.NonceSize(), 1+aead.NonceSize()+len(data)+aead.Overhead())
+	buf[0] = cursorVersionEncrypted
+	nonce := buf[1:]
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", fmt.Errorf("entgql: generating cursor nonce: %w", err)
+	}
+	return encoding.EncodeToString(aead.Seal(buf, nonce, data, buf[:1])), nil
+}
+
+// Decode implements the CursorCodec interface.
+func (c *encryptedCodec) Decode(s string) ([]byte, error) {
+	buf, err := decodeVersion(s, cursorVersionEncrypted)
+	if err != nil {
+		return nil, err
+	}
+	for _, aead := range c.aeads {
+		if len(buf) < aead.NonceSize() {
+			continue
+		}
+		nonce, sealed := buf[:aead.NonceSize()], buf[aead.NonceSize():]
+		if data, err := aead.Open(nil, nonce, sealed, []byte{cursorVersionEncrypted}); err == nil {
+			return data, nil
+		}
+	}
+	return nil, ErrCursorFormat
+}
+
+// decodeVersion decodes the given cursor and returns its content
+// if it was encoded in the given format version.
+func decodeVersion(s string, version byte) ([]byte, error) {
+	buf, err := encoding.DecodeString(s)
+	if err != nil || len(buf) == 0 || buf[0] != version {
+		return nil, ErrCursorFormat
+	}
+	return buf[1:], nil
+}
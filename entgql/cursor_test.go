@@ -0,0 +1,117 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorCodec(t *testing.T) {
+	t.Parallel()
+	encrypted, err := entgql.NewEncryptedCursorCodec([]byte("0123456789abcdef"))
+	require.NoError(t, err)
+	signed, err := entgql.NewSignedCursorCodec(newKey)
+	require.NoError(t, err)
+	codecs := map[string]entgql.CursorCodec{
+		"Plain":     entgql.NewPlainCursorCodec(),
+		"Signed":    signed,
+		"Encrypted": encrypted,
+	}
+	data := []byte("cursor")
+	for name, codec := range codecs {
+		codec := codec
+		t.Run(name, func(t *testing.T) {
+			s, err := codec.Encode(data)
+			require.NoError(t, err)
+			buf, err := codec.Decode(s)
+			require.NoError(t, err)
+			require.Equal(t, data, buf)
+
+			if name != "Plain" {
+				_, err = codec.Decode(s[:len(s)-2])
+				require.ErrorIs(t, err, entgql.ErrCursorFormat)
+			}
+			_, err = codec.Decode("")
+			require.ErrorIs(t, err, entgql.ErrCursorFormat)
+			for other, c := range codecs {
+				if other == name {
+					continue
+				}
+				s, err := c.Encode(data)
+				require.NoError(t, err)
+				_, err = codec.Decode(s)
+				require.ErrorIs(t, err, entgql.ErrCursorFormat, "cursor encoded by %s codec", other)
+			}
+		})
+	}
+}
+
+// Keys of signed codecs.
+var (
+	oldKey = []byte("0123456789abcdef0123456789abcdef")
+	newKey = []byte("fedcba9876543210fedcba9876543210")
+)
+
+func TestSignedCursorCodec(t *testing.T) {
+	t.Parallel()
+	for _, keys := range [][][]byte{{nil}, {[]byte("secret")}, {newKey, []byte("secret")}} {
+		_, err := entgql.NewSignedCursorCodec(keys[0], keys[1:]...)
+		require.Error(t, err, "keys must be at least %d bytes long", entgql.MinSignedCursorKeySize)
+	}
+
+	old, err := entgql.NewSignedCursorCodec(oldKey)
+	require.NoError(t, err)
+	s, err := old.Encode([]byte("cursor"))
+	require.NoError(t, err)
+
+	codec, err := entgql.NewSignedCursorCodec(newKey)
+	require.NoError(t, err)
+	_, err = codec.Decode(s)
+	require.ErrorIs(t, err, entgql.ErrCursorFormat)
+	codec, err = entgql.NewSignedCursorCodec(newKey, oldKey)
+	require.NoError(t, err)
+	buf, err := codec.Decode(s)
+	require.NoError(t, err)
+	require.Equal(t, []byte("cursor"), buf)
+
+	forged, err := entgql.NewPlainCursorCodec().Encode([]byte("cursor"))
+	require.NoError(t, err)
+	_, err = old.Decode(forged)
+	require.ErrorIs(t, err, entgql.ErrCursorFormat)
+}
+
+func TestEncryptedCursorCodec(t *testing.T) {
+	t.Parallel()
+	_, err := entgql.NewEncryptedCursorCodec([]byte("short"))
+	require.Error(t, err)
+
+	old, err := entgql.NewEncryptedCursorCodec([]byte("0123456789abcdef"))
+	require.NoError(t, err)
+	s1, err := old.Encode([]byte("cursor"))
+	require.NoError(t, err)
+	s2, err := old.Encode([]byte("cursor"))
+	require.NoError(t, err)
+	require.NotEqual(t, s1, s2, "nonce should be random")
+	require.NotContains(t, s1, "cursor")
+
+	codec, err := entgql.NewEncryptedCursorCodec([]byte("fedcba9876543210"), []byte("0123456789abcdef"))
+	require.NoError(t, err)
+	buf, err := codec.Decode(s1)
+	require.NoError(t, err)
+	require.Equal(t, []byte("cursor"), buf)
+}
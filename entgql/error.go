@@ -15,13 +15,112 @@
 package entgql
 
 import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/privacy"
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/errcode"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
+// Error codes of the ent errors presented by the ErrorPresenter.
+const (
+	ErrCodeNotFound         = "NOT_FOUND"
+	ErrCodeValidationFailed = "VALIDATION_FAILED"
+	ErrCodeConflict         = "CONFLICT"
+	ErrCodeForbidden        = "FORBIDDEN"
+	ErrCodeInternal         = "INTERNAL"
+)
+
 // ErrNodeNotFound creates a node not found graphql error.
 func ErrNodeNotFound(id interface{}) *gqlerror.Error {
 	err := gqlerror.Errorf("Could not resolve to a node with the global id of '%v'", id)
-	errcode.Set(err, "NOT_FOUND")
+	errcode.Set(err, ErrCodeNotFound)
 	return err
 }
+
+// ErrInvalidCursor creates an invalid pagination graphql error for cursors
+// that cannot be decoded, or do not match the order of the pagination.
+func ErrInvalidCursor(err error) *gqlerror.Error {
+	gqlErr := gqlerror.Errorf("Invalid cursor: %v", err)
+	errcode.Set(gqlErr, "INVALID_PAGINATION")
+	return gqlErr
+}
+
+// ExtendedError is implemented by errors that are presented with GraphQL error
+// extensions, such as the ent errors that are extended by the ErrorTemplate.
+type ExtendedError interface {
+	error
+	Extensions() map[string]interface{}
+}
+
+// ErrorPresenter is a graphql.ErrorPresenterFunc that presents the ent errors, and
+// the privacy denials, with their extensions (e.g. a stable error code). Errors that
+// do not wrap ent errors are presented as is.
+//
+//	srv.SetErrorPresenter(entgql.ErrorPresenter)
+//
+func ErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	return presentError(ctx, err, false)
+}
+
+// ProductionErrorPresenter is an ErrorPresenter that also replaces the messages of
+// the ent errors with generic messages that do not expose their internal details,
+// such as the database constraints or the validators of the schema. Other errors
+// are presented as internal errors with a generic message, unless they are graphql
+// errors that were already given a code (e.g. ErrNodeNotFound).
+func ProductionErrorPresenter(ctx context.Context, err error) *gqlerror.Error {
+	return presentError(ctx, err, true)
+}
+
+func presentError(ctx context.Context, err error, production bool) *gqlerror.Error {
+	gqlErr, ok := err.(*gqlerror.Error)
+	if !ok {
+		gqlErr = gqlerror.WrapPath(graphql.GetPath(ctx), err)
+	}
+	var (
+		ext        ExtendedError
+		extensions map[string]interface{}
+	)
+	switch {
+	case errors.Is(err, privacy.Deny):
+		extensions = map[string]interface{}{"code": ErrCodeForbidden}
+	case errors.As(err, &ext):
+		extensions = ext.Extensions()
+	case production && (!ok || gqlErr.Extensions["code"] == nil):
+		extensions = map[string]interface{}{"code": ErrCodeInternal}
+	default:
+		return gqlErr
+	}
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = make(map[string]interface{}, len(extensions))
+	}
+	for k, v := range extensions {
+		gqlErr.Extensions[k] = v
+	}
+	if production {
+		gqlErr.Message = publicMessage(extensions)
+	}
+	return gqlErr
+}
+
+// publicMessage returns the generic message of an error with the given extensions.
+func publicMessage(extensions map[string]interface{}) string {
+	switch extensions["code"] {
+	case ErrCodeNotFound:
+		return "Could not resolve to a node"
+	case ErrCodeValidationFailed:
+		if field, ok := extensions["field"].(string); ok {
+			return fmt.Sprintf("Invalid value of field '%s'", field)
+		}
+		return "Invalid value"
+	case ErrCodeConflict:
+		return "The operation conflicts with the current state of the nodes"
+	case ErrCodeForbidden:
+		return "Permission denied"
+	default:
+		return "Internal system error"
+	}
+}
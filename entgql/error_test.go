@@ -15,10 +15,15 @@
 package entgql_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	"entgo.io/contrib/entgql"
+	"entgo.io/ent/privacy"
 	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func TestErrNodeNotFound(t *testing.T) {
@@ -27,3 +32,46 @@ func TestErrNodeNotFound(t *testing.T) {
 	require.EqualError(t, err, "input: Could not resolve to a node with the global id of '42'")
 	require.Equal(t, "NOT_FOUND", err.Extensions["code"])
 }
+
+func TestErrInvalidCursor(t *testing.T) {
+	t.Parallel()
+	err := entgql.ErrInvalidCursor(entgql.ErrCursorFormat)
+	require.EqualError(t, err, "input: Invalid cursor: entgql: invalid cursor format")
+	require.Equal(t, "INVALID_PAGINATION", err.Extensions["code"])
+}
+
+type extendedError struct{ field string }
+
+func (extendedError) Error() string { return "ent: validator failed: pq: internal details" }
+
+func (e extendedError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": entgql.ErrCodeValidationFailed, "field": e.field}
+}
+
+func TestErrorPresenter(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	err := entgql.ErrorPresenter(ctx, fmt.Errorf("wrapped: %w", extendedError{field: "text"}))
+	require.Equal(t, "wrapped: ent: validator failed: pq: internal details", err.Message)
+	require.Equal(t, map[string]interface{}{"code": entgql.ErrCodeValidationFailed, "field": "text"}, err.Extensions)
+	err = entgql.ProductionErrorPresenter(ctx, gqlerror.WrapPath(nil, extendedError{field: "text"}))
+	require.Equal(t, "Invalid value of field 'text'", err.Message)
+	require.Equal(t, entgql.ErrCodeValidationFailed, err.Extensions["code"])
+
+	err = entgql.ErrorPresenter(ctx, fmt.Errorf("todo is private: %w", privacy.Deny))
+	require.Equal(t, entgql.ErrCodeForbidden, err.Extensions["code"])
+	err = entgql.ProductionErrorPresenter(ctx, fmt.Errorf("todo is private: %w", privacy.Deny))
+	require.Equal(t, "Permission denied", err.Message)
+
+	err = entgql.ErrorPresenter(ctx, errors.New("custom error"))
+	require.Equal(t, "custom error", err.Message)
+	require.Nil(t, err.Extensions)
+	err = entgql.ProductionErrorPresenter(ctx, errors.New("pq: internal details"))
+	require.Equal(t, "Internal system error", err.Message)
+	require.Equal(t, map[string]interface{}{"code": entgql.ErrCodeInternal}, err.Extensions)
+	err = entgql.ProductionErrorPresenter(ctx, gqlerror.Errorf("uncoded error"))
+	require.Equal(t, "Internal system error", err.Message)
+	require.Equal(t, entgql.ErrCodeInternal, err.Extensions["code"])
+	gqlErr := entgql.ErrNodeNotFound(1)
+	require.Equal(t, gqlErr, entgql.ProductionErrorPresenter(ctx, gqlErr))
+}
@@ -0,0 +1,138 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+)
+
+// EventOp is the operation of a change event.
+type EventOp string
+
+// Change event operations.
+const (
+	EventCreated EventOp = "CREATED"
+	EventUpdated EventOp = "UPDATED"
+	EventDeleted EventOp = "DELETED"
+)
+
+// String implements fmt.Stringer interface.
+func (op EventOp) String() string {
+	return string(op)
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (op EventOp) MarshalGQL(w io.Writer) {
+	_, _ = io.WriteString(w, strconv.Quote(op.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (op *EventOp) UnmarshalGQL(val interface{}) error {
+	str, ok := val.(string)
+	if !ok {
+		return fmt.Errorf("event op %T must be a string", val)
+	}
+	switch *op = EventOp(str); *op {
+	case EventCreated, EventUpdated, EventDeleted:
+		return nil
+	default:
+		return fmt.Errorf("%s is not a valid event op", str)
+	}
+}
+
+// Event is a change of a node that was made by an ent mutation.
+type Event struct {
+	// Op is the operation that changed the node.
+	Op EventOp
+	// Type is the type name of the node (e.g. Todo).
+	Type string
+	// ID is the id of the node.
+	ID interface{}
+	// Node holds the changed node, if it was returned by the mutation.
+	// It is nil for deletions and for bulk updates. Note that it may be
+	// bound to the transaction of the mutation, and its edges cannot be
+	// queried after the commit. The generated Subscribe reloads it.
+	Node interface{}
+}
+
+// Broker publishes the change events of the ent hooks generated for types
+// annotated with Events, to the subscribers of the GraphQL subscriptions.
+type Broker interface {
+	// Publish publishes the given events to the subscribers.
+	// It should not block on slow subscribers.
+	Publish(context.Context, ...Event)
+	// Subscribe returns a channel of the events that are published
+	// after it returns. The channel is closed when ctx is done.
+	Subscribe(context.Context) <-chan Event
+}
+
+// DefaultBrokerBuffer is the default number of events buffered per subscriber.
+const DefaultBrokerBuffer = 64
+
+// MemoryBroker is an in-process Broker. Events are buffered per subscriber,
+// and events that do not fit in the buffer of a slow subscriber are dropped.
+type MemoryBroker struct {
+	buffer int
+	mu     sync.RWMutex
+	subs   map[chan Event]struct{}
+}
+
+var _ Broker = (*MemoryBroker)(nil)
+
+// NewMemoryBroker returns a MemoryBroker that buffers the given number
+// of events per subscriber. Defaults to DefaultBrokerBuffer.
+func NewMemoryBroker(buffer int) *MemoryBroker {
+	if buffer <= 0 {
+		buffer = DefaultBrokerBuffer
+	}
+	return &MemoryBroker{
+		buffer: buffer,
+		subs:   make(map[chan Event]struct{}),
+	}
+}
+
+// Publish implements Broker.Publish.
+func (b *MemoryBroker) Publish(_ context.Context, events ...Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for ch := range b.subs {
+		for _, e := range events {
+			select {
+			case ch <- e:
+			default:
+			}
+		}
+	}
+}
+
+// Subscribe implements Broker.Subscribe.
+func (b *MemoryBroker) Subscribe(ctx context.Context) <-chan Event {
+	ch := make(chan Event, b.buffer)
+	b.mu.Lock()
+	b.subs[ch] = struct{}{}
+	b.mu.Unlock()
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		delete(b.subs, ch)
+		close(ch)
+		b.mu.Unlock()
+	}()
+	return ch
+}
@@ -0,0 +1,59 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryBroker(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	b := entgql.NewMemoryBroker(2)
+	ch1, ch2 := b.Subscribe(ctx), b.Subscribe(ctx)
+	events := []entgql.Event{
+		{Op: entgql.EventCreated, Type: "T", ID: 1},
+		{Op: entgql.EventUpdated, Type: "T", ID: 1},
+		{Op: entgql.EventDeleted, Type: "T", ID: 1},
+	}
+	b.Publish(ctx, events...)
+	for _, ch := range []<-chan entgql.Event{ch1, ch2} {
+		// Events that do not fit in the buffer are dropped.
+		require.Equal(t, events[0], <-ch)
+		require.Equal(t, events[1], <-ch)
+	}
+	cancel()
+	for _, ch := range []<-chan entgql.Event{ch1, ch2} {
+		_, ok := <-ch
+		require.False(t, ok)
+	}
+	b.Publish(context.Background(), events...)
+}
+
+func TestEventOp(t *testing.T) {
+	var op entgql.EventOp
+	require.NoError(t, op.UnmarshalGQL("DELETED"))
+	require.Equal(t, entgql.EventDeleted, op)
+	require.Error(t, op.UnmarshalGQL("REMOVED"))
+	require.Error(t, op.UnmarshalGQL(1))
+
+	var b bytes.Buffer
+	entgql.EventCreated.MarshalGQL(&b)
+	require.Equal(t, `"CREATED"`, b.String())
+}
@@ -0,0 +1,139 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Names of the Apollo Federation types, directives and fields that are
+// added to the schema when generating with the FederationTemplate.
+const (
+	anyScalar      = "_Any"
+	fieldSetScalar = "_FieldSet"
+	entityUnion    = "_Entity"
+	serviceType    = "_Service"
+	keyDirective   = "key"
+	entitiesField  = "_entities"
+	serviceField   = "_service"
+	typenameField  = "__typename"
+)
+
+// ErrEntityNotFound creates an entity not found graphql error for the given
+// Apollo Federation representation of an entity of the given type.
+func ErrEntityNotFound(typ string, representation map[string]interface{}) *gqlerror.Error {
+	fields := make([]string, 0, len(representation))
+	for name, v := range representation {
+		if name != typenameField {
+			fields = append(fields, fmt.Sprintf("%s: %v", name, v))
+		}
+	}
+	sort.Strings(fields)
+	err := gqlerror.Errorf("Could not resolve to a %s entity with the key {%s}", typ, strings.Join(fields, ", "))
+	errcode.Set(err, ErrCodeNotFound)
+	return err
+}
+
+// ServiceSDL returns the SDL of the given schema that is returned by the _service
+// field of Apollo Federation. That is, the schema without the types, directives and
+// fields that are defined by the federation spec, and are added by the gateway. It
+// is used by the federation.go file that is generated by the Plugin.
+func ServiceSDL(schema *ast.Schema) string {
+	sdl := *schema
+	sdl.Types = make(map[string]*ast.Definition, len(schema.Types))
+	for name, def := range schema.Types {
+		switch name {
+		case anyScalar, fieldSetScalar, entityUnion, serviceType:
+		case queryName(schema):
+			query := *def
+			query.Fields = make(ast.FieldList, 0, len(def.Fields))
+			for _, f := range def.Fields {
+				if f.Name != entitiesField && f.Name != serviceField {
+					query.Fields = append(query.Fields, f)
+				}
+			}
+			sdl.Types[name] = &query
+		default:
+			sdl.Types[name] = def
+		}
+	}
+	if sdl.Query != nil {
+		sdl.Query = sdl.Types[sdl.Query.Name]
+	}
+	sdl.Directives = make(map[string]*ast.DirectiveDefinition, len(schema.Directives))
+	for name, dir := range schema.Directives {
+		if name != keyDirective {
+			sdl.Directives[name] = dir
+		}
+	}
+	var b bytes.Buffer
+	formatter.NewFormatter(&b).FormatSchema(&sdl)
+	return b.String()
+}
+
+// federationExec is the template of the federation.go file that is generated by
+// the Plugin in the package of the executable schema.
+const federationExec = `
+{{ reserveImport "context" }}
+{{ reserveImport "errors" }}
+{{ reserveImport "fmt" }}
+
+{{ reserveImport "entgo.io/contrib/entgql" }}
+{{ reserveImport "github.com/99designs/gqlgen/plugin/federation/fedruntime" }}
+
+{{ $ent := lookupImport .Package }}
+
+// EntityResolver is implemented by the root resolver for resolving
+// the Apollo Federation entities of the _entities field:
+//
+//	func (r *Resolver) Entities(ctx context.Context, representations []map[string]interface{}) ([]ent.Noder, error) {
+//		return r.client.Entities(ctx, representations)
+//	}
+//
+type EntityResolver interface {
+	Entities(ctx context.Context, representations []map[string]interface{}) ([]{{ $ent }}.Noder, error)
+}
+
+func (ec *executionContext) __resolve__service(ctx context.Context) (fedruntime.Service, error) {
+	if ec.DisableIntrospection {
+		return fedruntime.Service{}, errors.New("federated introspection disabled")
+	}
+	return fedruntime.Service{SDL: entgql.ServiceSDL(ec.Schema())}, nil
+}
+
+func (ec *executionContext) __resolve_entities(ctx context.Context, representations []map[string]interface{}) ([]{{ $ent }}.Noder, error) {
+	r, ok := ec.resolvers.(EntityResolver)
+	if !ok {
+		return nil, fmt.Errorf("resolving entities: %T does not implement EntityResolver", ec.resolvers)
+	}
+	return r.Entities(ctx, representations)
+}
+`
+
+// queryName returns the name of the query type of the schema.
+func queryName(schema *ast.Schema) string {
+	if schema.Query == nil {
+		return "Query"
+	}
+	return schema.Query.Name
+}
@@ -0,0 +1,50 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/todo/ent/schema"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestServiceSDL(t *testing.T) {
+	t.Parallel()
+	buf, err := entgql.GenerateSchema(newFederatedGraph(t, schema.Todo{}))
+	require.NoError(t, err)
+	s, gerr := gqlparser.LoadSchema(
+		&ast.Source{Name: "query.graphql", Input: "type Query { todos: [Todo] }"},
+		&ast.Source{Name: "ent.graphql", Input: string(buf)},
+	)
+	require.Nil(t, gerr)
+	sdl := entgql.ServiceSDL(s)
+	require.Contains(t, sdl, "type Query {\n\ttodos: [Todo]\n}")
+	require.Contains(t, sdl, `type Todo implements Node @key(fields: "id") {`)
+	for _, name := range []string{"_entities", "_service", "_Any", "_FieldSet", "_Entity", "_Service", "directive @key", "__Schema"} {
+		require.NotContains(t, sdl, name)
+	}
+	require.NotNil(t, s.Query.Fields.ForName("_entities"), "the schema is not modified")
+}
+
+func TestErrEntityNotFound(t *testing.T) {
+	t.Parallel()
+	err := entgql.ErrEntityNotFound("Account", map[string]interface{}{"__typename": "Account", "number": 1, "bankCode": "b"})
+	require.EqualError(t, err, "input: Could not resolve to a Account entity with the key {bankCode: b, number: 1}")
+	require.Equal(t, entgql.ErrCodeNotFound, err.Extensions["code"])
+}
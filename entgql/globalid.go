@@ -0,0 +1,62 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// EncodeGlobalID returns the Relay global id of a node. That is, the
+// base64 encoding of its type name and its id separated by a colon.
+func EncodeGlobalID(typ string, id interface{}) string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%v", typ, id)))
+}
+
+// DecodeGlobalID returns the type name and the id encoded in the given Relay global id.
+func DecodeGlobalID(gid string) (typ, id string, err error) {
+	buf, err := base64.StdEncoding.DecodeString(gid)
+	if err != nil {
+		return "", "", fmt.Errorf("entgql: invalid global id %q: %w", gid, err)
+	}
+	i := strings.IndexByte(string(buf), ':')
+	if i <= 0 || i == len(buf)-1 {
+		return "", "", fmt.Errorf("entgql: invalid global id %q", gid)
+	}
+	return string(buf[:i]), string(buf[i+1:]), nil
+}
+
+// MarshalGlobalID marshals a Relay global id. Together with UnmarshalGlobalID, it can be
+// used as the model (entgo.io/contrib/entgql.GlobalID) of a custom scalar for arguments
+// that accept only global ids. It should not replace the bindings of the ID scalar, which
+// holds both the global ids of the nodes and the local ids of the mutation inputs.
+func MarshalGlobalID(gid string) graphql.Marshaler {
+	return graphql.MarshalString(gid)
+}
+
+// UnmarshalGlobalID unmarshals a Relay global id, and fails if it cannot be decoded.
+func UnmarshalGlobalID(v interface{}) (string, error) {
+	gid, err := graphql.UnmarshalString(v)
+	if err != nil {
+		return "", err
+	}
+	if _, _, err := DecodeGlobalID(gid); err != nil {
+		return "", err
+	}
+	return gid, nil
+}
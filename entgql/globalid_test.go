@@ -0,0 +1,58 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"bytes"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobalID(t *testing.T) {
+	t.Parallel()
+	gid := entgql.EncodeGlobalID("Todo", 1)
+	require.Equal(t, "VG9kbzox", gid)
+	typ, id, err := entgql.DecodeGlobalID(gid)
+	require.NoError(t, err)
+	require.Equal(t, "Todo", typ)
+	require.Equal(t, "1", id)
+
+	typ, id, err = entgql.DecodeGlobalID(entgql.EncodeGlobalID("Todo", "a:b"))
+	require.NoError(t, err)
+	require.Equal(t, "Todo", typ)
+	require.Equal(t, "a:b", id)
+
+	for _, gid := range []string{"1", "VG9kbw==", "OjE=", "VG9kbzo="} {
+		_, _, err := entgql.DecodeGlobalID(gid)
+		require.Error(t, err, gid)
+	}
+}
+
+func TestGlobalIDMarshaler(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	entgql.MarshalGlobalID("VG9kbzox").MarshalGQL(&buf)
+	require.Equal(t, `"VG9kbzox"`, buf.String())
+
+	gid, err := entgql.UnmarshalGlobalID("VG9kbzox")
+	require.NoError(t, err)
+	require.Equal(t, "VG9kbzox", gid)
+	_, err = entgql.UnmarshalGlobalID("1")
+	require.Error(t, err)
+	_, err = entgql.UnmarshalGlobalID(1)
+	require.Error(t, err)
+}
@@ -3,10 +3,16 @@
 // template/collection.tmpl
 // template/edge.tmpl
 // template/enum.tmpl
+// template/error.tmpl
+// template/event.tmpl
+// template/federation.tmpl
+// template/globalid.tmpl
+// template/mutation_input.tmpl
 // template/node.tmpl
 // template/pagination.tmpl
 // template/pagination_test.tmpl
 // template/transaction.tmpl
+// template/where_input.tmpl
 package internal
 
 import (
@@ -83,7 +89,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _templateCollectionTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x5f\x6f\xdb\x36\x10\x7f\x16\x3f\xc5\x4d\xf0\x83\x65\x38\x74\xda\xb7\x64\xf0\x43\x16\xa4\x45\x81\x2e\x43\xd7\x02\x7b\x1c\x68\xf2\x24\x11\xa3\x49\x85\xa4\xd2\x18\x02\xbf\xfb\x40\x8a\xb2\x6c\x37\x1b\x02\x34\x0f\xb1\x78\xf7\xbb\xbb\xdf\xfd\x1d\x86\xcd\x8a\xdc\x9b\xee\x60\x65\xd3\x7a\x78\x7f\xfd\xee\xe6\xaa\xb3\xe8\x50\x7b\xf8\xc0\x38\xee\x8c\xf9\x07\x3e\x69\x4e\xe1\x4e\x29\x48\x20\x07\x51\x6f\x9f\x51\x50\xf2\xad\x95\x0e\x9c\xe9\x2d\x47\xe0\x46\x20\x48\x07\x4a\x72\xd4\x0e\x05\xf4\x5a\xa0\x05\xdf\x22\xdc\x75\x8c\xb7\x08\xef\xe9\xf5\xa4\x85\xda\xf4\x5a\x10\xa9\x93\xfe\xf3\xa7\xfb\x87\xc7\xaf\x0f\x50\x4b\x85\x90\x65\xd6\x18\x0f\x42\x5a\xe4\xde\xd8\x03\x98\x1a\xfc\x49\x30\x6f\x11\x29\x59\x6d\x42\x20\x64\x18\x40\x60\x2d\x35\x42\xc9\x8d\x52\xc8\xbd\x34\xba\x84\x10\xa2\xc6\xe3\xbe\x53\xcc\x23\x94\x2d\x32\x81\xb6\x84\x45\xd4\x10\xb9\xef\x8c\xf5\xb0\x24\x45\xc9\x8d\xf6\xf8\xe2\x4b\x42\x8a\xb2\x91\xbe\xed\x77\x94\x9b\xfd\xe6\xe6\x46\xa0\x93\x8d\x76\x9b\xe6\x49\x35\xa8\x37\x8d\x65\x5d\xfb\xa4\x4a\x52\xa5\x98\x96\xe9\x06\x61\xa1\x63\xda\xb7\x5b\x58\xd0\x47\x23\xd0\x41\x66\xb4\x40\xd1\xa0\x8b\x0a\x21\xb9\xcf\x64\xb2\x49\x54\x25\x93\x68\x4b\x1f\x12\x30\x04\x52\x0c\x03\xc8\x1a\x16\x4c\x6b\xe3\x59\x4c\x22\x81\x22\x9a\xde\x1d\x65\x8e\x3e\x68\xff\xf1\xcb\xe7\x64\xf1\xa3\x09\xfd\x4d\x6a\x31\xea\x5e\x51\xfe\xce\xba\x4e\xea\x06\x42\x18\x06\xa8\x99\x54\x50\xee\x22\x9e\x69\x01\xfb\xac\x9b\xe1\x0e\x98\x45\xd8\xf7\xbe\x67\x4a\x1d\x00\x5f\xb8\xea\x9d\x7c\xc6\x72\xb4\xc7\xd3\x40\x39\xdf\x2d\x38\xf4\xd3\x63\xa4\xfe\xc8\xf6\x08\x4b\x25\x5d\x96\xd3\x6f\x87\xee\x15\x69\x14\x54\xd5\x31\xab\xa3\xf3\x9c\xc4\xc4\x2e\x56\xe4\xd5\x84\x7e\x86\xc7\xe4\xfd\xc7\xf0\xf3\xe7\xfc\x95\xda\x6b\x91\xa3\x7c\x46\x3b\xf7\xf1\xcf\x49\x32\x82\x17\x4f\x3d\xda\xc3\xac\xfe\x12\x9f\x29\x5a\x08\x64\xb3\x81\xfb\x71\x54\x3f\x48\x54\xc2\x81\x47\xa5\x5c\x9a\xfb\x64\x76\xb5\xeb\xa5\x4a\xdb\x63\x00\x59\x83\x56\x1d\x40\x19\x26\x80\x1b\xad\x91\x7b\x14\xa0\xd3\xb4\xed\x0e\x71\x1b\x8d\x8a\x71\xf3\x1c\x53\x52\xf7\x9a\xc3\xf2\x8c\x65\x08\xb0\x9a\x49\x85\x50\x9d\xc7\x5f\x72\xff\x72\xb4\xbf\x1f\x7f\xd7\xe0\x98\x97\xae\x96\xe8\x80\x52\xea\xbc\x4d\x15\x3a\x73\x03\x03\x29\x64\x0d\x35\x8f\x79\xe6\xfd\xa0\x1f\x71\xf4\x9a\xfd\x44\xdf\xd5\xaf\x11\xf3\xcb\x16\xb4\x54\xd1\xa6\xb8\x24\xb7\x85\x0b\x09\xe5\x27\xfc\x96\x27\xae\xff\xe8\xd0\xa6\xde\x9f\xba\x5f\x43\xcd\x69\x82\x9e\xb0\xa6\x94\x56\xa4\x08\xa4\xb0\xe8\x7b\xab\x2f\x23\x90\x40\xde\x56\xa9\x33\x26\xb1\x50\xab\x89\xce\x25\x97\x35\xd4\x11\x74\xac\x44\xae\x31\x8a\x4b\x6a\xff\x5b\xd0\x61\xb8\x82\xef\xd2\xb7\xd3\xf8\xa6\x99\xac\x8d\x85\xbf\x27\xff\xb7\xdb\x7c\x4a\x2e\x02\xcd\xcd\xcc\x48\xfa\x15\xf3\x3d\x74\x27\xd1\xab\xd4\x82\xc2\x7d\x97\x9e\xb7\x19\x98\x26\x33\x89\x53\xfc\xe9\xb8\xb1\x3d\xae\x61\xf1\xcc\x54\x3f\x1e\x33\x9a\x37\xad\x28\x38\x73\x08\xf3\x4d\x93\x13\x2c\xa2\xa4\x16\xf8\x72\x34\x7b\x37\x9e\x0b\x59\x43\xe3\x61\x21\xe1\x1a\x42\x58\xc3\x71\x9f\xca\x98\xff\x68\x3a\x3e\x46\xf1\xed\x18\xe6\x0d\x93\xf2\x97\xf4\xed\x30\x40\xc7\x1c\x67\x6a\xe4\x0c\x21\x2c\x63\x6f\x97\x63\x5d\x57\xb3\x7a\x79\xce\xed\x3a\xae\x7c\x5a\xcd\x5c\x94\xf8\x97\x8c\xe8\x65\xdb\x73\x49\xab\x8c\x0a\xd5\xb1\x58\xf3\x21\x8c\xff\x02\x39\x13\xfe\xf7\xf0\xbd\x76\x5b\xfe\x0d\x00\x00\xff\xff\x0f\x9c\x85\xe3\x90\x07\x00\x00")
+var _templateCollectionTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x5d\x8f\xdb\x36\xd6\xbe\x96\x7e\xc5\x79\x05\x5f\xd8\xf3\x7a\xe4\x49\xee\x32\x0b\x5f\xa4\xd3\xb4\x08\xb6\x4d\xdb\x4d\xb0\xbd\x08\x82\x80\x23\x1d\xc9\xc4\xd0\xa4\x87\xa4\xc6\xf1\x0a\xfa\xef\x8b\xc3\x0f\x7d\x8d\x3d\x29\xb0\x8b\x0d\x8a\x8e\x48\x9e\x8f\x87\xe7\x1c\x3e\x3c\x74\xdb\x6e\xae\xd2\x3b\x75\x38\x69\x5e\xef\x2c\xbc\xbe\x79\xf5\xe6\xfa\xa0\xd1\xa0\xb4\xf0\x13\x2b\xf0\x5e\xa9\x07\x78\x2f\x8b\x1c\xde\x0a\x01\x4e\xc8\x00\xad\xeb\x27\x2c\xf3\xf4\xd3\x8e\x1b\x30\xaa\xd1\x05\x42\xa1\x4a\x04\x6e\x40\xf0\x02\xa5\xc1\x12\x1a\x59\xa2\x06\xbb\x43\x78\x7b\x60\xc5\x0e\xe1\x75\x7e\x13\x57\xa1\x52\x8d\x2c\x53\x2e\xdd\xfa\x2f\xef\xef\xde\x7d\xf8\xf8\x0e\x2a\x2e\x10\xc2\x9c\x56\xca\x42\xc9\x35\x16\x56\xe9\x13\xa8\x0a\xec\xc8\x99\xd5\x88\x79\x7a\xb5\xe9\xba\x34\x6d\x5b\x28\xb1\xe2\x12\x21\x2b\x94\x10\x58\x58\xae\x64\x06\x5d\x47\x2b\x16\xf7\x07\xc1\x2c\x42\xb6\x43\x56\xa2\xce\x60\x41\x2b\x29\xdf\x1f\x94\xb6\xb0\x4c\x93\xac\x50\xd2\xe2\x37\x9b\xa5\x69\xd2\xb6\xd7\xa0\x99\xac\x11\x16\x12\x6e\xb7\xb0\xc8\x3f\xa8\x12\x0d\x69\x24\x49\xd6\xb6\xb0\xc8\xef\x94\xac\x78\x9d\xff\xce\x8a\x07\x56\x23\x74\xdd\x86\xa6\xe5\x68\x22\xf3\x76\x50\x96\xa4\x97\x26\x59\xcd\xed\xae\xb9\xcf\x0b\xb5\xdf\xbc\x79\x53\xa2\xe1\xb5\x34\x9b\xfa\x51\xd4\x28\x37\xb5\x66\x87\xdd\xa3\xc8\xd2\x95\xdb\xc8\xc2\x3c\x0a\x72\x8c\x8f\xb0\xc8\x3f\x5a\xa5\x59\x8d\xf9\x07\xb6\x47\xc8\xcc\xa3\x70\x9b\x22\xb1\x88\x91\x42\x3e\x85\x49\xab\x0b\x2c\x6b\x34\x64\xa6\xe4\x85\xa5\x59\x4a\x33\x3c\x48\x75\x94\xb0\x53\xa2\x34\x2e\xc2\xc1\x35\x48\xb6\x47\xe3\x03\x8c\xc0\xcb\xb5\x5b\xf4\x26\x98\x2c\xdd\xa8\xe2\xe8\xb5\x98\x05\xa6\x91\x2a\x40\x89\x27\x2c\xe1\xfe\xe4\xd6\x87\xb8\xe7\xe0\x92\x42\x28\xbc\xbf\x88\x22\xe3\x65\x06\x56\x37\x08\xd9\xd7\xaf\xf6\x74\x40\x72\x1b\x66\xba\x6e\xb4\x29\xf2\x4c\xd8\xeb\x47\xf1\xce\x81\xf0\xdb\xa4\x14\xb4\x2d\xf0\x0a\x16\x4c\x4a\x65\x19\x79\x23\x39\xb7\xdb\xfc\x6d\x3f\x67\xf2\x77\xd2\xfe\xfc\xc7\x2f\xb4\xef\x24\x21\x20\xe4\xc9\x90\xa8\xe0\xc6\xf6\xd3\x53\x4b\xf9\x0f\xdc\xe7\x2b\x39\xb7\xf8\x2b\x3b\x1c\xb8\xac\xa1\xeb\xda\x16\x2a\xc6\x05\x64\xf7\x24\x4f\xf1\xd9\x87\xb5\x41\xdc\xb8\x18\xed\x1b\xdb\x30\x21\x4e\x80\xdf\x0a\xd1\x18\xfe\x84\x94\xbe\xb6\x8d\x85\x91\x8c\xc1\x05\x6c\x7e\x2f\x2e\xdf\x11\x66\x2f\x1d\x50\x45\x77\xb7\xdb\x0b\x08\xe7\x86\x7b\x8d\x4b\x16\xbd\x60\xaf\x18\x6b\x8b\x40\xdc\x6e\x87\xe5\x21\xa5\x5b\x30\x68\xe3\xc0\x0b\x86\x34\x8e\xad\x07\xf3\x52\x59\x58\x72\x73\xa7\xa4\xf4\x15\x12\xca\xd6\x6d\x75\x15\x44\x93\xa1\x6a\x83\x71\x5a\x35\x21\xb7\x2e\x1e\xcb\x51\x80\x3e\x9d\x0e\x61\x36\xa0\xcb\xb2\x68\x89\x10\x08\x83\xbd\xe7\x1d\x33\x9f\x7a\x0a\x38\xb0\x9a\x4b\x97\xd1\x5e\x3e\xe9\x13\x5a\x0c\x08\xc9\x09\xd1\xdc\x63\xc3\x35\xba\x02\x1f\x34\x7b\x46\xc9\x9e\x7b\xa4\x72\xf0\x90\x7f\x7b\xfd\xab\x3f\xcb\xbd\x97\xcd\x15\xd0\xe4\x33\x2f\x54\x2a\xc8\x6a\xd4\xd7\x42\xb1\x92\x68\xd3\x50\xb2\x8e\x5c\x96\xea\x68\xe0\xc0\xb4\xe5\x24\xde\x1f\x36\xae\xa1\x52\x1a\x79\x2d\xaf\x1f\xf0\x14\xce\x5b\xd8\xc9\xa2\x17\xa7\x72\x3f\x68\x2e\xad\x0f\x77\x4f\x4f\x59\x9e\x05\x88\x77\x4a\x34\x7b\x79\xa7\xa4\xb1\x4c\x86\x63\xe1\x8c\x1c\xb9\xdd\xc1\xc2\xf0\x7f\xb9\xfc\x93\xec\xef\xac\xc6\x8f\x34\x76\x9a\x51\x74\xe6\xb0\xf7\x37\x4c\x65\x6b\x67\x2c\x7b\x96\xb6\x2c\x5a\x5c\x66\xb0\xe4\xb2\xc4\x6f\xc1\xe1\xcd\x0a\xb2\x35\xcc\x26\x5f\xad\x20\x5b\xc5\x70\x4f\x2a\xf8\x3f\x2e\x9c\x01\xff\xb8\x80\xa2\xf9\xd1\xf7\xf0\x39\x7c\x79\x5a\x0d\xdc\xb8\x67\x07\x22\x48\x04\x0a\x77\x4f\x98\x2a\x64\x6c\xc2\xb5\x39\xfc\x70\xa2\x2b\x8b\x35\xc2\x7a\xb6\x2d\xd8\x1e\xc5\x75\xc1\x0c\x3a\x36\x8e\x64\xec\xac\x8c\x18\x35\x58\x9d\x10\x3b\x55\x7a\x5f\x69\xc3\xf1\xad\x02\x85\xfe\xe4\x55\x06\x0e\x3d\xc3\x88\x4b\xe7\x1e\x16\x95\x0b\x4d\x08\x44\x5f\x08\x03\xc9\x90\xc2\xa2\xba\x48\xb5\x91\x4f\x2e\xb2\xd2\x94\x96\x2e\x8a\xcd\x0f\xd4\x48\xd0\x91\xf4\x52\x62\xc4\x3a\x87\xbe\x3a\xe7\xe9\xec\x1e\xc3\xc7\xcb\x49\xa7\xef\x45\x23\xf9\x63\x83\xe3\xdb\xf4\x45\x9a\x9c\x31\xdf\x8e\x99\xbf\xe3\x69\x42\x96\x13\x90\xdf\x63\xd4\x5e\x30\xc0\x08\x92\x61\xf4\x4c\xf4\x85\x8d\xf0\xaa\x57\xeb\xba\x51\x35\x05\x8b\x61\x14\xe3\xb2\x7c\xc0\x93\x89\x0a\xab\x29\xb5\x0f\x9f\xc3\x17\x55\xe2\x42\x63\x81\xfc\x09\xb5\x0f\x88\x2a\x31\xff\x47\x9c\x09\x15\xfc\xd8\xa0\x3e\x0d\xcb\x7f\xd0\x30\xe6\x61\xb3\x81\x3b\xdf\x47\x84\xa2\xb5\x28\x84\x3f\x53\x4e\xed\xfa\xbe\xe1\xc2\xb5\x94\xca\x33\xa6\x38\x01\x71\x66\xe4\x54\x2c\xdd\xd9\x33\xc4\x93\xa1\x41\xd1\x10\x9a\xbb\x3c\x6d\xdb\xeb\xf1\x51\xd9\x6c\xe0\xd3\x0e\xc1\x20\xf9\xc3\x12\x0a\xc7\x86\xfe\xe6\x16\x7c\xcf\x2d\x96\xe1\xf4\xf6\x67\x79\xc7\x2c\x1c\xd1\x75\x3f\x8f\x0d\x1a\x8b\xe5\x1a\x98\x50\x8e\xac\xed\x8e\x70\xa6\x9b\x0d\xbc\xff\x71\xe8\x9a\x06\x9a\xee\xfb\x2b\x42\xb8\x86\x46\x0a\x34\x06\x98\xb7\xed\xfb\x2a\x6e\x5c\xc5\xd0\x6d\xed\x7d\xb3\x00\x8a\xac\x2e\x31\xaf\x73\x60\x50\x34\xc6\xaa\x7d\xbf\xbd\x15\x1c\x99\x19\xf0\xf8\x5d\x86\x8c\x54\x8d\x2c\x60\x39\x49\x4b\xd7\xc1\xd5\x90\x85\xae\x5b\x4d\x03\xbe\x2c\xec\xb7\x3e\x60\x77\xfe\xef\x1a\x0c\xb3\xdc\x54\x1c\x0d\xe4\x79\x6e\xac\xe6\xb2\x5e\x4d\xcd\x40\x9b\x26\xbc\x82\xaa\xa0\xc4\x06\xa6\xcb\x7f\x46\x6f\x35\xd8\x21\xdb\xab\xbf\x91\xcc\xff\x6d\x41\x72\x41\x3a\xc9\x1c\xdc\x16\x66\x33\x79\x68\x2c\x9d\xa5\xe5\xc8\xf4\x6f\x07\xd4\x8e\x82\xc6\xe6\xd7\x50\x15\xb9\x13\x1d\xa1\xce\xf3\x7c\x95\x26\x5d\x9a\x68\xb4\x8d\x96\x73\x0f\x69\x97\xfe\xb5\x48\x4d\x90\x50\xa0\xae\x22\x9c\x39\x96\x75\xc8\x6a\x5c\x0f\x31\xc6\x72\x0e\xed\xc5\x80\xce\xea\x35\x49\x9e\x98\xa6\xe7\x4a\x92\x30\x21\x80\xfe\xdd\x2b\x25\x68\x1c\x4b\xf7\xf3\x17\x6f\x2c\x4d\x92\xd5\xe4\x09\x12\x6d\x29\xdd\x5f\x8f\xbd\xd5\x4a\x69\xf8\x1a\x11\xdf\x6e\x03\xad\xcd\xa0\x0f\xe5\x11\x24\xf3\x8f\x18\xfa\x7d\x33\xda\xcf\xca\x25\x35\x31\x47\x6e\x8b\x5d\x10\x74\x87\xbb\x0d\x2c\x36\x3c\xae\xd8\x1e\xd7\xb0\x78\x62\xa2\xf1\xf7\x50\xc0\x15\xf8\xce\x01\x5e\xd0\x23\x81\xd6\x0e\xcc\x14\x4c\xf4\x2d\x41\x50\xba\xe9\x69\x34\x71\xf7\xe6\x40\xc9\x3c\x5a\x26\xe5\xa9\xd2\x2b\x4f\x64\xbc\x82\xda\xc2\x82\xc3\x0d\x74\xdd\x1a\x7a\x16\x73\x2f\x3c\x67\x3f\x0c\xfc\xf4\xad\x77\xe3\x50\x51\x3f\x33\x6e\x71\x9e\xb9\x78\xdd\xc3\x4a\x92\xe3\x1a\x50\x3b\x36\x94\x78\x24\xdb\x6e\x4b\x5d\xf7\xa7\x6b\xee\x46\xf1\x5c\x3f\x2f\xfc\x46\x1b\xa5\xcd\x72\xe5\x97\x06\x87\x5d\xb7\x8a\xe6\x79\xe5\xcc\x8f\x0e\x14\xcd\x26\x49\xe0\x36\x0a\xe9\x40\x84\x1a\xe9\xc1\x6b\xe6\xdd\x2c\x6a\xad\xb4\xc9\x7b\xdd\x7b\x8d\xec\x21\x8e\x86\x8d\x38\xc0\x86\x76\xb2\x67\x0f\xb8\xdc\xb3\xc3\x67\x5f\x6c\x5f\xae\xc8\x8d\xdf\xd0\x1a\x04\xca\xf9\x39\xca\x83\xee\xea\xff\x5f\xf5\xc0\xa9\xe8\x1e\xd6\xf0\x34\x14\xdc\x05\xa5\xd1\xa6\xc2\xcc\xe7\x87\x2f\xb0\x85\xa7\x4b\x08\x3f\xbb\x14\x12\xc9\xc6\xeb\x3d\x8f\xad\x00\xa5\x94\x74\x8f\x51\xe5\x92\xcf\x6d\x6c\xbe\x2f\x08\x9e\xa1\xa9\x3f\xb9\xdd\xb5\x6d\xac\xd4\xe8\x6e\x49\xc4\xb2\xf4\x2c\x79\x35\x4a\xbf\xbb\xf5\xc2\x61\x71\xff\x39\x91\xe0\x7f\x0d\x7e\xe4\x0b\xe0\x9f\x54\x8c\x0e\xd2\x1a\x8e\xb9\x2f\xb1\x5e\x8d\x57\xc3\xb9\x2d\x26\x24\x33\x29\x2d\x4a\x90\x09\xdc\x43\x91\x71\x9f\xc4\xc5\xf4\x77\x4c\xc7\xe1\x5f\xf0\x3f\xe3\xbb\x35\x5c\x39\x7b\xab\xdc\xdf\x99\xfe\xf9\x60\x96\xc7\x3c\xf0\x8f\x27\xda\x79\x5e\xfa\x6a\xa5\xc3\xe3\xda\xba\xae\xfb\x1f\xc7\x75\xce\xdc\x21\x30\xab\xf3\x10\x03\x65\x9e\x1f\x4e\x08\x79\x4a\x69\xd5\xd0\xd5\x84\xf6\xa5\xeb\x9e\xf1\x46\xdf\x77\xd7\x67\x5a\xae\x5e\xfe\x1c\xa1\x9d\x7b\x8e\xbf\x48\x63\x21\x56\xcf\x59\x6c\xb8\x30\xb6\x40\xbd\x86\x2c\x97\x61\xc2\x53\xcd\xe4\xb9\x18\xce\x4f\x95\x8f\xde\x89\x17\xc2\xf5\x6c\xec\x76\x41\x3f\xfb\xac\x27\x3f\xf9\x04\x1c\xe1\xfd\x73\x9b\x4e\x38\xcb\x85\x04\xf6\xec\x04\xf7\xa3\x5f\x99\x2a\xad\xf6\xc0\x64\xf8\x15\x10\x63\xb3\x16\x59\x8b\x2e\xc4\xad\x6b\x98\xcf\xe4\x8d\xfe\xd7\xa5\x93\xc9\x67\x99\xa4\x97\x86\x08\x67\x60\x5e\x82\x21\x49\xee\x94\x90\x29\xff\x36\x79\x59\x34\xde\xc4\xed\xc5\x80\xba\x20\xbf\xff\x71\x1c\x56\xe8\xd2\x69\x45\x85\x7c\x93\xe4\xbb\xf1\xed\x38\x94\x93\xaf\xb9\x50\x70\x71\xf9\xbf\x93\xde\x69\x10\xe7\xa3\xe9\xbe\xa7\x84\x30\xa5\x83\x59\xe8\x2f\x37\x61\xb3\xa4\x50\xd3\x3b\xb1\x0b\xac\x8c\xbf\x5b\xf2\x27\x94\xb1\x06\x62\x93\x1e\x1b\x79\xd2\x8b\x4b\xa1\x5a\x1c\x51\x0c\x3d\xb7\x10\xbd\x00\xd3\x83\x62\xfe\xd7\xda\xc0\xb3\x7b\xfd\x5e\x67\x7c\xee\x5a\xf4\x75\xb5\x82\xed\x16\x6e\x5c\xe5\x5d\x08\x4d\xd2\xa5\xc1\xdb\x6d\x1a\xbb\x35\xef\x76\xb8\x3d\x23\x8c\x76\x68\xe8\xe2\xb6\x2e\x5f\xb1\xa1\x5a\x49\x87\x30\xf6\x0a\xdb\x6d\xb0\xe7\x50\x11\x5b\x48\xcb\x65\x83\x10\x60\x8c\x0e\xd5\xe5\x13\x10\x2a\xef\xfc\x7a\xdc\xc0\x77\xfa\xf2\x51\xdd\x8c\x1e\x9a\x6d\x0b\x28\x4b\xe8\xba\xf4\xdf\x03\x00\xd2\x4d\x6f\x41\xbc\x18\x00\x00")
 
 func templateCollectionTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -98,12 +104,12 @@ func templateCollectionTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/collection.tmpl", size: 1936, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/collection.tmpl", size: 6332, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateEdgeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x52\xc1\x6a\xdb\x40\x10\x3d\x5b\x5f\x31\x08\x1f\x6c\xd3\x6e\xd2\xdc\x5a\xe8\x21\x18\x1b\x0c\xa9\x4d\x9b\xf4\x54\x7a\x50\x77\x9f\xec\x25\xeb\x5d\x75\x76\x14\x62\xc4\xfe\x7b\x59\xc9\x8a\x5d\xc8\x49\x33\xf3\xe6\xbd\x79\x4f\x6c\xd7\xdd\x2c\x8a\x65\x68\x4e\x6c\xf7\x07\xa1\xbb\xdb\x4f\x9f\x3f\x36\x8c\x08\x2f\xb4\xae\x34\xfe\x84\xf0\x4c\x1b\xaf\x15\xdd\x3b\x47\xfd\x52\xa4\x8c\xf3\x0b\x8c\x2a\x9e\x0e\x36\x52\x0c\x2d\x6b\x90\x0e\x06\x64\x23\x39\xab\xe1\x23\x0c\xb5\xde\x80\x49\x0e\xa0\xfb\xa6\xd2\x07\xd0\x9d\xba\x1d\x51\xaa\x43\xeb\x4d\x61\x7d\x8f\x3f\x6c\x96\xab\xed\xe3\x8a\x6a\xeb\x40\xe7\x19\x87\x20\x64\x2c\x43\x4b\xe0\x13\x85\x9a\xe4\xea\x98\x30\xa0\x8a\xc5\x4d\x4a\x45\xd1\x75\x64\x50\x5b\x0f\x2a\x61\xf6\x28\x29\xa5\x3c\x13\x1c\x1b\x57\x09\xa8\x3c\xa0\x32\xe0\x92\xa6\x19\x29\xec\xb1\x09\x2c\x54\xea\xe0\x05\xaf\x52\xf6\x02\x5c\xf9\x3d\x68\xea\xe9\xcb\x57\x9a\xaa\x6d\x30\x88\x79\x79\xd2\x75\x34\xe5\x7e\xe8\xd5\x0f\x68\xd8\x17\xf0\x08\x9c\x39\x38\xc3\x2b\xb3\x3f\x93\x26\x75\xeb\x35\xcd\x06\x6e\x4a\xb4\xc8\x95\x57\xdb\xea\x08\x4a\x69\x4e\xb9\x85\x7a\x14\x6e\xb5\xac\x2d\x9c\xa1\x94\x66\x5a\x5e\xe9\x6c\x49\x2d\x87\xef\xbc\xd7\xb0\x35\xf9\x20\x99\xf1\xd3\xdb\xbf\x6d\x96\xf8\xf5\xbb\xeb\x08\x3e\xf3\x16\x83\xd8\xd3\xa9\xc1\x78\xe0\x03\x81\x39\xf0\x9c\xba\x62\x32\x99\x30\x62\xeb\xa4\x9f\x65\xa3\xa3\xa9\xc1\xae\x7a\xcf\xca\x8e\x57\xcc\xb3\x79\x26\xdb\x9a\x36\x71\x1b\xe4\x21\x54\x06\x66\x06\x1e\x55\xff\x93\xbd\x52\xfd\xde\x82\x4f\xef\xe6\x9b\xab\x21\xcb\x75\x8e\x9d\x77\x79\x19\x2e\xe6\xee\xde\xb9\xb7\x58\xf9\x77\xf4\x0e\xd2\x90\x41\x5a\xf6\x34\xde\x1c\x84\x2a\x6f\xae\xc4\xa6\x50\xbb\x46\x6c\xf0\x95\xa3\x94\xbe\x55\xf1\x79\x1b\x64\x9d\x1f\x59\xef\xfa\x72\x05\xcc\x6f\x57\x8a\x5e\xff\xd2\x5e\xaa\xab\xf2\x5f\x00\x00\x00\xff\xff\x07\x45\xeb\x33\x23\x03\x00\x00")
+var _templateEdgeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x58\x5f\x6f\xdb\x38\x12\x7f\x96\x3e\xc5\xac\x60\x1c\xa4\x9c\x4a\x77\xfb\x76\x5d\xf8\xa1\xeb\x4d\x0f\x01\xda\xa4\x7b\xd9\x43\x1f\x82\x60\xc1\x48\x23\x9b\x88\x42\xda\x24\x9d\xd4\xe7\xea\xbb\x1f\x86\x7f\xf4\xc7\x71\xda\xde\xed\x93\x65\x72\x38\xf3\x9b\x99\xdf\x8c\x86\x3a\x1c\xe6\x67\xe9\x52\x6d\xf6\x5a\xac\xd6\x16\xde\xbc\xfe\xf9\x1f\xaf\x36\x1a\x0d\x4a\x0b\xef\x79\x85\x77\x4a\xdd\xc3\x85\xac\x18\xbc\x6b\x5b\x70\x42\x06\x68\x5f\x3f\x62\xcd\xd2\x3f\xd6\xc2\x80\x51\x3b\x5d\x21\x54\xaa\x46\x10\x06\x5a\x51\xa1\x34\x58\xc3\x4e\xd6\xa8\xc1\xae\x11\xde\x6d\x78\xb5\x46\x78\xc3\x5e\xc7\x5d\x68\xd4\x4e\xd6\xa9\x90\x6e\xff\xc3\xc5\xf2\xfc\xf2\xfa\x1c\x1a\xd1\x22\x84\x35\xad\x94\x85\x5a\x68\xac\xac\xd2\x7b\x50\x0d\xd8\x91\x31\xab\x11\x59\x7a\x36\xef\xba\x34\x3d\x1c\xa0\xc6\x46\x48\x84\x0c\xeb\x15\x66\xd0\x75\xb4\x66\xf1\x61\xd3\x72\x8b\x90\xad\x91\xd7\xa8\x33\x98\x41\x10\x9f\x99\x6d\x0b\x6f\x17\x80\x5b\x98\xb1\x6b\xab\x34\x5f\x21\xbb\xe4\x0f\x08\x99\xd9\xb6\x4e\x41\x2a\x1e\x36\x4a\x5b\xc8\xd3\x24\xab\x94\xb4\xf8\xc5\x66\x69\x72\x38\xbc\x02\xd1\xf8\xf3\x24\x94\xb8\x15\xcd\xe5\x0a\x61\x26\x49\xe5\x8c\x5d\xaa\x1a\x0d\xa9\x48\x92\x24\x3b\x1c\x60\xc6\x96\x4a\x36\x62\xc5\x3e\xf1\xea\x9e\xaf\x10\xba\x6e\x4e\xcb\x72\xb4\x90\x05\x4d\x28\x6b\x77\x72\xf4\x9c\x26\x19\x4a\xbb\x52\x4c\xa8\x39\x01\xd1\xe2\x6e\x4e\x0b\xdb\xf6\x39\x9e\x64\x24\x8b\xd2\xce\x6b\xc1\x5b\xac\xec\xdc\xf4\xc2\x41\x69\xe1\xa2\xf6\x12\x6e\x42\xa7\xdd\xa2\x64\xff\xc2\x0a\xc5\x23\xea\x7e\xe3\x8e\xdb\x6a\x4d\x9b\x0d\x6f\x0d\xc6\xe5\xa0\x0a\x69\x63\xb5\x6d\xcf\xeb\x15\x1a\x8a\x08\x6d\xd3\x31\xd1\x80\x30\x4b\x25\x25\x56\x56\x28\x49\x5b\x33\x0c\x41\x22\x73\x96\x0e\xce\x90\xfd\xb1\xdf\x84\x4c\x0c\x7b\x4a\x13\x8f\xde\x2e\x60\xcd\xcd\x95\x7b\x0e\x82\xe1\x7c\xb3\x93\x15\xe4\x24\x49\x28\xe1\x8c\x9e\x64\x54\x52\x00\xfd\x45\x76\x6d\xf5\xae\xb2\xef\x05\xb6\x14\x80\x9c\x74\x27\x95\xfd\x02\x21\xb7\x94\x23\xca\x71\x09\xbc\xb1\xa8\xe1\x6c\xb9\xd3\x46\xe9\x12\x1a\xa1\x8d\x85\x33\x21\x6d\x09\x77\xd8\x28\x8d\xc3\x5e\xcb\xc3\x96\x77\x30\x00\xed\xba\x12\xdc\xd3\xaf\x7b\xb8\xb9\x75\x68\x2c\x74\x9d\x43\x7e\x38\x84\x0c\x94\x04\xa0\x80\xbc\xdf\x1e\x62\x53\x02\x6a\xad\x74\x01\x07\x92\x71\x29\x9e\x19\xf1\x1f\x17\x5a\xe2\xf7\x27\xbe\xc2\x6b\xfa\xdf\x07\x30\xd2\x40\xe9\x88\xc1\x1f\x08\xbb\x89\xda\x58\x43\xa7\x6f\x6e\xa3\xb5\x4f\x7c\x25\x24\xb7\x78\xb5\x21\x8b\xde\x50\xd2\xb3\x29\xfa\x11\x96\x93\xcf\xc2\xae\xe3\x49\xe7\x46\x1e\xfc\x2b\xca\xd1\xc9\x40\xad\x61\xe1\x49\xd8\xf5\x11\x92\x24\x79\x1a\xe9\x8a\x9e\x50\xee\x84\xac\xf1\x0b\x30\x78\x4d\xc1\x81\xd1\xc2\xcf\xd0\x75\x2f\xda\x19\xdc\xc7\x48\x46\x5a\x78\xe4\x1a\x9c\xd3\x2f\x79\x9c\x9e\x50\x16\xbc\xe7\xb2\x26\xbe\x5c\xbd\xf9\x08\xf9\x0b\xfd\xa1\xe8\x0d\x89\x06\x9e\x28\xb2\x91\x7c\xec\x49\xc8\x5a\x3d\x99\x9b\x6c\x42\x42\x46\xff\x7a\x5e\x67\xb7\xbf\xc0\x13\xfc\xb4\x00\x29\xda\x90\xe4\x24\x11\x0d\x48\x6a\x1c\x2e\xfb\x13\x95\xae\x96\xd8\x29\x16\x5f\xe9\x73\xad\xf3\xe2\x17\x77\x64\x31\xd5\x97\x54\x4a\xca\x12\xd4\x7d\xaf\x70\x13\x22\x10\x23\xf2\xd9\x41\xed\xeb\x86\x55\x8e\xf1\x26\x2f\x4a\x78\x2a\x23\x18\x57\x0d\xa1\x0a\x62\x01\x78\xe2\x97\x2e\xc2\x8c\xb1\x22\x5a\x14\x8d\x33\x15\x1c\xfb\xfa\x15\xd4\xfd\x00\x27\xd1\x68\x77\x5a\x52\xbd\x49\x07\x29\x6e\x74\xe9\xe4\xb7\x3b\x95\x9a\x70\xb6\x87\xfa\xfb\x0e\xf5\xfe\x64\x5d\x17\x2c\x26\x3a\xaf\xec\x97\x1f\xc7\x1f\xda\xd4\x88\x45\xa4\x5e\xa3\xd9\xb5\xae\x35\x6d\xb4\x90\x16\xb2\x9b\xdb\xb3\xec\xb8\x4d\x91\xe0\x96\x00\x0d\x72\x33\x0d\x99\x07\x99\x1d\x63\xcc\xf2\x82\xbd\x6b\x5b\x42\x57\x64\x83\x29\x2a\x3b\x64\xff\x96\x62\xbb\x8b\x3a\x83\xf1\xde\xf6\xb7\x2c\xff\xa0\xe1\x2b\xd9\xee\x7b\xcb\x7d\x2f\x8a\xde\xfa\xce\xbe\x00\xab\x77\x7f\xa5\xb5\x9e\xe8\xaa\x45\xd0\xe1\x3d\xea\xba\xa3\x16\xe7\x3d\xfd\x3f\x98\x9f\x06\xda\x5d\x98\x4b\x65\x3f\x28\x5e\x63\x9d\xa3\xee\xf5\x4e\x14\xbb\x8a\x1a\xde\x95\xbd\x91\x56\xf1\xfa\x25\x3f\x8a\x81\x12\x43\xac\x8f\x22\x97\x4c\x28\x1a\x2d\x1e\x0e\xa3\x5e\x12\xd2\x4a\x5d\xc5\xf5\x5b\x4e\x00\x3e\x72\x73\x7f\xa9\xec\x7b\x9a\x83\x1c\xe8\xc1\x16\x6a\x3d\x31\xe1\x06\x8d\xe3\x77\x3d\x2d\x25\xf3\x39\xbc\x84\xdf\x6d\x18\x37\x4a\x65\xd3\xf6\xe3\xde\x22\x34\x66\x71\xf0\x29\xa7\x9e\xec\x04\x95\x5d\xa3\xf6\xa5\xef\x07\x2e\x8c\x56\x34\x9a\x8d\x92\x06\xe9\xd5\x06\x14\x07\x81\x06\x84\x25\x40\xc2\x42\xc5\xa5\x54\x16\xee\xd0\x2b\xc4\x1a\x72\x83\x08\x34\x8b\x6c\x5b\x46\x89\xa1\x54\x16\x2c\x4d\xbe\xcf\xa9\x6f\xe5\xe3\x7f\xe4\x55\x42\x9e\x3c\xa7\x95\xc3\x48\xa0\x48\x65\x09\xc7\xb3\x58\x20\xdc\x52\x49\x63\xb9\xf4\x6a\x69\xc4\xc8\xb7\x11\xab\xab\xec\x1e\xf0\x89\xc5\x68\x3f\xb2\x62\xcb\xe2\x2b\xf4\x99\x57\xa1\x7b\x76\x45\x7a\xa2\x87\x1e\xa9\x91\xa2\x1d\xb5\xce\x2e\x1d\xbf\x38\x60\x71\xf2\xc8\x98\xb6\x93\x63\x51\xa5\xaa\xf1\x07\xeb\xac\x4b\x8f\x1b\xf3\x88\xa4\xc3\x63\x3f\x94\x3a\xf2\x53\xb1\x85\xc6\x12\x8f\xcc\xb6\x61\xb4\x1c\x47\x2c\x75\x2c\xeb\x33\x03\x1a\x07\xfa\x3a\xf7\x7a\x8e\xae\xc4\x23\x4a\x4f\x61\xe4\x2b\xd4\xaf\x48\x10\xeb\xf2\x14\xa1\xbd\xd6\xe7\xac\xee\xe9\x0c\x76\xcd\xad\xab\x14\xb7\x6c\x08\x0c\xa9\x66\x70\x61\xc1\x87\xc8\xb8\xb0\x8a\x58\x0d\xf3\xb9\xb7\xfd\x83\x94\x77\xf5\x32\x38\xc1\x0d\x50\xa1\xb8\xcb\x0f\x4b\xbf\x5b\x0b\x13\xa6\x1e\x93\xbf\xf4\x40\x8c\xd5\x42\xae\x4a\xef\x33\xe9\xf3\x33\xe5\x76\xe0\xa6\x7f\xcc\xa7\xca\xa7\xa5\xf2\x38\x99\x14\x8e\x9c\xf0\x65\x92\x4d\x8e\xb3\xec\xef\x64\xbd\x1c\xaa\xea\xe2\xb7\x50\x26\x27\xa1\xde\xe3\xde\xc0\xcd\xad\x90\x16\x75\xc3\x2b\x3c\x74\x05\xe4\x0f\x7c\x73\x33\x5a\x19\xef\x1e\x55\xf2\x74\x34\x22\x57\xf3\xfc\x6f\x13\x40\xcb\x56\xa0\xb4\x87\xca\x5d\xb5\xde\x0e\xb0\xfc\x42\x57\xf8\xfa\xcc\x8b\xd0\x84\x92\xcf\x6b\xd4\x98\x3b\xc0\x06\xce\xcc\xb6\x65\xd7\x48\x77\xa5\xc1\x66\x92\x18\xe6\xa5\x68\xf7\x42\xe6\x86\x2d\xf3\x93\xcd\x42\xb2\x8b\xdf\xc6\xfd\xa2\xf0\xfe\xd2\x6c\xd1\x97\x77\x30\x1b\x5f\xfd\xe9\x8b\xc5\x7e\xaa\xd6\xa9\x72\x68\xaa\x6d\x77\xe8\x06\xf9\x07\x7e\x8f\xdf\x8e\x5e\x8b\x32\x77\x41\x0b\x08\x1a\xa5\xe1\xcf\x12\xdc\x15\xd5\xdf\xd5\xdc\x6e\x34\xea\x55\xdf\x90\x23\xb7\xb0\x00\x39\xb2\x1a\xf0\x78\x89\x92\x70\xa5\x7d\xbf\x9a\x3a\xf0\xf5\x2b\xfc\xd4\x8f\x7c\x27\xdc\x70\x5e\x90\xd9\x12\xfe\x24\x1c\x8f\xec\x88\x93\x45\x3a\x3a\xe8\xe4\xbc\xb5\xe9\x85\x78\xd4\x6a\xe6\x73\x38\xaf\x57\xb8\xe4\xba\x16\x92\xb7\xc2\xee\x61\xad\xda\xd0\x36\xaa\xd1\x6a\x28\x7b\xa2\x2c\x34\xd4\xdb\x4c\xfc\xcc\xb0\xd2\x7c\xb3\xde\xb6\xe9\x7c\x0e\xa6\x5a\xe3\x03\x87\xbb\x3d\xc9\x0a\x0d\x99\x9b\xb5\x9c\x78\x06\x92\x3f\xf8\xae\x20\x0c\xec\xe8\xeb\x86\x17\x8b\x05\xbf\x54\xc6\x7e\x10\x0f\xc2\xb2\x94\x2e\x1f\xc7\xa8\x16\x40\xd9\xf2\xa5\x7a\x1b\x8f\x0c\xfb\x87\xf4\xdb\xdf\x11\x46\x9b\xa7\x6f\xd8\xa3\xfb\x96\xeb\x4b\x9c\xc6\x8c\x70\xa5\x7e\xd7\x2f\x18\x76\x2e\xed\x3f\x7f\xff\x10\xdf\x05\x74\x68\x46\x8e\x39\x52\x8d\x4e\xb2\x8f\x7c\xb3\x11\x72\x35\x16\x14\xcd\x44\xe2\x57\xe1\x72\xe0\xf2\xe7\x34\x2c\xa0\x15\xc6\x8e\xe6\x8c\x21\x4f\x49\x32\x71\x81\x2c\x3a\x83\xf4\x10\xbf\x94\x84\x6f\x25\x3d\x17\x58\xd4\x4c\x17\xa6\xb7\x70\x22\x66\xcf\x86\x66\xff\x30\x8c\x51\x1f\xb9\xdc\xf7\x20\xca\x1e\xc5\x00\x6a\xf2\x6f\xfc\x67\xf4\xec\x3f\x18\xa1\xac\xa1\xeb\xd2\xff\x0e\x00\xe8\x72\xe7\xa1\x2e\x13\x00\x00")
 
 func templateEdgeTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -118,12 +124,12 @@ func templateEdgeTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/edge.tmpl", size: 803, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/edge.tmpl", size: 4910, mode: os.FileMode(436), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateEnumTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\xd1\x4e\xe3\x3a\x10\x7d\x4e\xbe\x62\x88\x8a\x94\x54\xd4\xe1\xf2\x76\xef\x55\x1f\x58\x54\x58\x24\x76\xb5\x08\x76\xf7\x11\x99\x78\xdc\x5a\x75\xec\xee\xd8\x29\x42\x51\xfe\x7d\x65\x27\x2d\x29\xb0\x2b\xde\xdc\x9e\x33\x67\xce\xcc\x9c\xb4\x6d\x39\x4d\x2f\xec\xe6\x99\xd4\x72\xe5\xe1\xec\xf4\x9f\x7f\x67\x1b\x42\x87\xc6\xc3\x25\xaf\xf0\xd1\xda\x35\x5c\x9b\x8a\xc1\xb9\xd6\x10\x49\x0e\x02\x4e\x5b\x14\x2c\xbd\x5f\x29\x07\xce\x36\x54\x21\x54\x56\x20\x28\x07\x5a\x55\x68\x1c\x0a\x68\x8c\x40\x02\xbf\x42\x38\xdf\xf0\x6a\x85\x70\xc6\x4e\x77\x28\x48\xdb\x18\x91\x2a\x13\xf1\x9b\xeb\x8b\xc5\xd7\xbb\x05\x48\xa5\x11\x86\xff\xc8\x5a\x0f\x42\x11\x56\xde\xd2\x33\x58\x09\x7e\xd4\xcc\x13\x22\x4b\xa7\x65\xd7\xa5\x69\xdb\x82\x40\xa9\x0c\x42\x56\xa3\xe7\x25\x17\x42\x79\x65\x0d\xd7\xe5\x92\xf8\x66\xf5\x4b\xcf\xd0\x34\x75\x06\x03\x99\xb8\x59\x22\x4c\x24\xfc\x37\x87\x09\x5b\x98\xa6\xbe\x54\xa8\x85\x0b\x78\xd2\xb6\x30\x09\xec\x00\x7a\x52\xf5\x37\x5e\xad\x79\xa4\xb3\xfb\xe7\x0d\xb2\x3b\x4f\xca\x2c\x61\xc2\x76\xc0\xac\xaf\x9a\x81\x92\x60\xac\x0f\xc4\xcf\xdc\x5d\xd9\xc0\x8e\x8a\x51\x92\xb0\x42\xb5\x45\x0a\xb2\xfb\xf7\x44\xb2\x4f\x8d\xd2\x02\x29\x1a\xe8\xa5\x92\xb2\x84\x2f\x9c\xdc\x8a\xeb\xab\xdb\x1b\x50\xf5\x46\x63\x8d\xc6\x3b\x18\x86\x61\x03\x8a\x04\xca\x78\x24\xc9\x2b\x64\x69\x92\xc8\xc6\x54\x90\x1f\x34\xeb\x3a\xd8\xcf\xd3\x75\xc5\x48\x37\x7f\x02\x65\xd9\x4f\x52\x1e\xa9\x80\x36\x4d\x92\x64\xf7\xbb\x9f\x30\x7f\x3a\x01\xe7\xa9\xb2\x66\xcb\x6e\x1b\xeb\xf1\xb5\xf2\xb0\x89\xbc\x28\x8a\x34\x49\xba\xb4\x77\xfe\xdd\xd4\x7f\xf5\xbe\xc7\x3f\xe6\x7e\x7a\x60\x7f\x2c\x9e\x6f\xb9\x7e\x51\x68\xbb\x02\x90\xc8\x52\x3f\x8a\xf3\x74\x02\x76\x1d\x96\xbd\xe5\x9a\xe5\x2e\x5a\x2d\xe2\x94\x12\x8e\xec\xba\xa7\x25\x84\xbe\x21\x03\xb2\xf6\x6c\x11\xaa\x65\x9e\xc5\x66\xc7\xf7\x50\x37\xce\xc3\x23\x02\x87\xbe\x38\x3b\x09\x52\x51\x22\x1c\x29\x99\xbe\xb6\x3a\x1f\xaf\x3a\x74\xdc\xb5\x43\x8a\x57\x0f\xa8\x64\x3f\xb8\x56\x82\x7b\x1b\x4a\xf2\xd7\x1a\xc5\xff\x91\x7c\x34\x07\xa3\xf4\x9f\x2d\x1e\xbb\xf0\x99\x85\xac\xf1\xe0\x49\x89\x71\xe7\x2c\x9e\xed\xc5\xe7\x50\x6f\x94\x8e\x57\x8a\x49\x45\xed\x86\x68\x6e\x39\x41\x1e\x68\x65\x39\x16\xe9\xa7\xdf\x9f\xef\x6d\xf2\xc2\xc5\x92\x87\x77\x12\x79\xb8\x85\x2c\x2b\x3e\x2e\x3e\x8a\x46\x94\x87\x87\x77\x53\x33\x87\xfc\x20\x15\xb9\x51\xf1\x2c\xc5\x30\x9b\x11\x61\xb4\xb6\x7d\xfb\xfa\x1d\x00\x00\xff\xff\x0a\xcf\x5e\x8f\xec\x04\x00\x00")
+var _templateEnumTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x54\x4d\x6f\xdc\x36\x10\x3d\x6b\x7f\xc5\x44\xd8\x00\x92\x61\x73\xd3\xdc\xea\x62\x0f\xa9\xe1\xb8\x06\xdc\xa0\x46\xdc\xf6\x18\x30\xe2\x70\x97\x30\x45\x6e\x87\x94\x0c\x43\xd0\x7f\x2f\x86\x92\x36\xbb\x72\xdc\x1a\xe8\x07\x90\x9b\xc4\x79\xf3\xf5\xde\x23\xbb\x6e\x75\xb2\xb8\xf0\xbb\x47\x32\x9b\x6d\x84\xb7\x6f\xbe\xfb\xfe\x6c\x47\x18\xd0\x45\x78\x2f\x2b\xfc\xec\xfd\x3d\x5c\xbb\x4a\xc0\x3b\x6b\x21\x81\x02\x70\x9c\x5a\x54\x62\x71\xb7\x35\x01\x82\x6f\xa8\x42\xa8\xbc\x42\x30\x01\xac\xa9\xd0\x05\x54\xd0\x38\x85\x04\x71\x8b\xf0\x6e\x27\xab\x2d\xc2\x5b\xf1\x66\x8a\x82\xf6\x8d\x53\x0b\xe3\x52\xfc\xe6\xfa\xe2\xf2\xc3\xc7\x4b\xd0\xc6\x22\x8c\x67\xe4\x7d\x04\x65\x08\xab\xe8\xe9\x11\xbc\x86\x78\xd0\x2c\x12\xa2\x58\x9c\xac\xfa\x7e\xb1\xe8\x3a\x50\xa8\x8d\x43\xc8\x6b\x8c\x72\x25\x95\x32\xd1\x78\x27\xed\x6a\x43\x72\xb7\xfd\xc3\x9e\xa1\x6b\xea\x1c\x46\x30\x49\xb7\x41\x58\x6a\x38\x5f\xc3\x52\x5c\xba\xa6\x7e\x6f\xd0\xaa\xc0\xf1\xac\xeb\x60\xc9\x68\x0e\x46\x32\xf5\x2f\xb2\xba\x97\x09\x2e\xee\x1e\x77\x28\x3e\x46\x32\x6e\x03\x4b\x31\x05\xce\x86\xac\x33\x58\xb6\xd2\x36\x18\x38\x91\x0b\xfc\x36\xfc\x2d\xb9\xd1\x04\x31\x7a\x8f\xe2\xa3\xd4\x8c\xb0\x42\xd3\x22\x71\xde\xfe\x7b\xa9\xc5\x8f\x8d\xb1\x0a\x29\x8d\x36\x34\xc9\x56\x2b\xf8\x59\x52\xd8\x4a\x7b\x75\x7b\x03\xa6\xde\x59\xac\xd1\xc5\x00\xe3\x9a\x62\x8c\x22\x81\x71\x11\x49\xcb\x0a\xc5\x90\x77\x1d\xe1\x81\x4c\xc4\x90\xb8\xbd\x62\xfc\xed\x0d\x38\x59\xe3\xc0\x2c\xa6\x99\x21\x0d\xc7\x29\xba\x71\x15\x14\x47\xf3\xf5\x3d\xec\xc9\xe9\xfb\xf2\x60\x94\xe2\x01\x8c\x17\xbf\x73\x7d\x2a\xa1\x5b\x64\x59\x96\x2a\x9f\xaf\x61\x56\x61\xa4\xaf\x28\x19\x13\x1e\x4c\xac\xb6\x73\xc8\x90\xcf\x6c\x8d\x3a\xb5\xcc\xcc\x11\x6d\xd9\x44\xa6\xe3\xb0\xf8\xc0\xbd\x96\xad\x48\x8c\x4f\x88\xac\x92\x01\x53\xed\x56\x5c\x78\x17\x22\xf4\xfd\x79\xca\x1d\x87\x5b\x43\x3e\x44\x53\x7a\xdf\xe7\xfb\xc2\xe8\xd4\x58\xe5\xf8\x2f\x1d\x4d\x8b\x8e\x8b\x3c\x9c\x42\x88\x54\x79\xd7\x8a\xdb\xc6\x47\x2c\xb8\x76\xc9\xeb\xf5\x8b\x81\xf9\x5f\x5d\xfd\x97\x9a\xed\xe3\x5f\x57\x8d\x50\xaa\x30\x13\x08\x34\xf9\x1a\x4c\x0c\x47\x42\x3e\x2f\xdb\xc9\x91\x6e\x87\x03\x15\xad\xb4\x5f\xba\x76\x7d\x09\x48\xe4\x69\xd0\x20\x44\x3a\x05\x7f\xcf\xf4\xb7\xd2\x8a\x22\xa4\x95\x79\xb7\xcc\x68\x78\xe5\xef\x07\x58\x46\x18\x1b\x72\xa0\xeb\x28\x2e\x39\x5b\x17\x79\x6a\xf6\xfa\x0e\xea\x26\x44\xf8\x8c\x20\x99\x25\xe3\x36\xf9\x29\x6f\x50\xee\xd9\x1c\x2d\x10\x22\xbd\x48\xf6\xa4\xe9\x4c\xb6\x51\xd4\x93\xf9\xd2\xeb\x99\xf8\x4f\xf5\x54\xa8\x65\x63\xe3\xf9\x73\x4b\xbc\x0e\xfc\x9c\x39\x1f\x41\xf2\xd4\x46\x1d\xfa\x3f\x4f\xc2\x7f\xd9\x64\xcc\x77\xc6\x26\xed\xd3\x26\x68\x03\x26\x9b\xfa\xc8\xef\xc7\x4f\x32\x5c\x79\x7e\x44\xfe\xd7\xcb\xff\x4f\x6f\xf2\xdf\x19\xfe\xb9\x1b\xfe\xef\x5c\x82\x6f\xcd\xd0\xcf\xd8\x70\x9c\xb5\x98\x2c\x63\x34\x5f\xb4\xe9\x81\xd4\xfc\x74\x19\x25\xa3\xe7\x94\x62\x5e\xa3\xfc\x21\x81\x5f\xad\xc1\x19\x0b\xdd\x7f\x6b\xd7\xe4\xb5\x56\x12\x14\x8c\x5d\xad\x0e\x8b\x0c\xd7\x79\x2f\xdf\x53\xe7\xb1\x62\xd9\xa7\xa7\xe7\x33\x16\xf2\xbc\x7c\x79\xf1\x03\x6b\xa4\xf2\xf0\xe9\x6b\x21\x58\x43\x71\xe4\x8a\xc2\x19\xcb\x5d\xca\x71\xb7\xe1\x4d\xef\xba\xa7\x5f\x7f\x0e\x00\x59\x2c\x0b\x48\x03\x09\x00\x00")
 
 func templateEnumTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -138,12 +144,112 @@ func templateEnumTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/enum.tmpl", size: 1260, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/enum.tmpl", size: 2307, mode: os.FileMode(436), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateNodeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x5a\x5f\x6f\x1b\x37\x12\x7f\x96\x3e\xc5\x9c\xce\x29\x76\x0d\x85\x1b\xe7\x9e\xaa\xd6\x07\xf8\xe2\x04\x10\xd0\x4b\xd2\x3a\xb8\x3e\x18\x46\x4b\x2f\xb9\x12\x2f\x2b\x72\x43\x52\x92\x0d\x55\xdf\xfd\x30\x43\x72\xb5\xab\x3f\x89\x13\xf4\x9a\x97\x78\x39\xc3\x19\xce\x6f\xfe\x72\x57\x9b\x4d\x71\x3e\x7c\x65\x9a\x47\xab\x66\x73\x0f\x2f\x5f\x5c\x7c\xff\xbc\xb1\xd2\x49\xed\xe1\x0d\x2f\xe5\xbd\x31\x1f\x61\xaa\x4b\x06\x57\x75\x0d\xc4\xe4\x00\xe9\x76\x25\x05\x1b\x7e\x98\x2b\x07\xce\x2c\x6d\x29\xa1\x34\x42\x82\x72\x50\xab\x52\x6a\x27\x05\x2c\xb5\x90\x16\xfc\x5c\xc2\x55\xc3\xcb\xb9\x84\x97\xec\x45\xa2\x42\x65\x96\x5a\x0c\x95\x26\xfa\x4f\xd3\x57\xaf\xdf\xde\xbc\x86\x4a\xd5\x12\xe2\x9a\x35\xc6\x83\x50\x56\x96\xde\xd8\x47\x30\x15\xf8\x8e\x32\x6f\xa5\x64\xc3\xf3\x62\xbb\x1d\x0e\x37\x1b\x10\xb2\x52\x5a\xc2\x48\x1b\x21\x47\xb0\xdd\xe2\xda\x59\xf3\x71\x06\x93\x4b\xb8\xe7\x4e\xc2\x19\x7b\x65\x74\xa5\x66\xec\x3d\x2f\x3f\xf2\x99\x8c\x3c\x5e\x2e\x9a\x9a\x7b\x09\xa3\xb9\xe4\x42\xda\x11\x9c\x41\x14\xa9\x2a\xd0\xb8\xef\xc6\x1b\xcb\x67\x92\xbd\xe5\x0b\x09\x23\xf7\xa9\x26\xf9\x83\xcd\x06\x2a\xae\xea\xa0\x12\xac\xfc\xb4\x54\x56\x3a\xb8\xf9\xf9\x27\x70\x61\x47\x3a\x87\xd4\x22\xca\x2c\xce\xe1\xb5\x76\x4b\x2b\x81\xd7\x35\x28\x01\xfe\xb1\x91\x0e\xe6\x7c\x25\xc9\x64\x87\x2a\x70\x0d\xc8\x30\xb4\x41\x89\x0f\xf8\x3c\xb9\x84\x33\x36\xbd\xa6\xbf\x03\x45\x55\x30\xf3\x90\xd5\x52\xc3\x19\x7b\x6b\x84\x74\x39\xbc\x48\x27\x4b\xdb\x2e\x21\x53\x5a\xc8\x87\xc4\x02\x2f\x72\x36\xbd\x66\x49\x0c\xb2\x5a\xae\x67\x12\xce\x34\xaa\xc8\x1c\x7a\xa7\x65\xbe\xc8\x89\x69\xb0\x03\x23\x88\x0d\xfb\xcf\x74\x12\xb5\x93\x37\xd8\x83\x45\x18\xe9\x40\x1b\x0f\x6e\xd9\x34\xc6\x7a\x58\x2c\x6b\xaf\x1a\x74\x72\x34\x7e\xd4\xaa\x88\x30\x75\xfe\xec\x60\xa7\x16\xb4\x3d\x43\xf2\xf3\xde\x99\xd3\x61\x49\xcc\x08\x6d\x3f\xf4\x74\x81\xcb\xba\xb3\x30\x0a\x72\x76\x2a\x9f\xc3\x5a\xf9\x39\x9c\x35\x91\x05\x05\x47\x63\xdf\x7f\x9c\xbd\xe7\x7e\xde\x51\xd0\x9c\x90\x93\x77\xcf\x39\x92\xda\xcf\x0c\x53\xa6\x90\xda\x17\x42\xf1\x5a\x96\xbe\xc0\xf0\xf9\x0c\xad\x70\xe5\x5c\x2e\x78\x8f\xa5\x34\xda\x5b\x75\x8f\xac\xb3\xb0\x7b\xa6\xfc\x7c\x79\xcf\x4a\xb3\x28\xbe\xff\x5e\x48\xa7\x66\xda\x15\xb3\x4f\xf5\x4c\xea\x62\x66\x79\x33\x3f\x60\x9b\x73\x37\x57\xa5\xb1\x4d\x31\x33\xcf\xc9\x07\xd2\x5a\x63\x89\xcb\xd4\x5c\xcf\x98\xb1\xb3\xe2\xa1\x70\x8f\xba\x2c\x9c\x5c\xf0\x66\x6e\xac\x1c\xa1\x45\x45\x01\x08\xb0\x85\xb5\xe5\x8d\xa3\x30\xbd\xe7\x4e\x95\xb4\x0a\x0b\xe9\xe7\x46\xb0\x21\x05\x6d\xe0\x53\xda\x4b\x5b\xf1\x52\xc2\x66\x38\xc0\xa5\x0c\x2d\x90\x0f\x1e\xfd\x82\xff\xe7\x90\x9d\xe3\xfa\x18\xe8\x10\xf9\x70\xdb\x6a\x49\xb9\x4f\x56\x74\xa4\x82\xf3\x76\x59\x7a\x94\x38\xbd\x86\x01\x00\x74\x82\x7c\xbb\x85\xdf\xff\xeb\x8c\x9e\x8c\x94\x18\x9b\x85\xc2\x9c\xf6\x8f\xa3\xdf\x8b\x02\x28\x08\x95\x60\xc3\x01\x71\x02\xca\x51\x7a\x06\x90\x76\xa0\x86\xee\x1e\x00\x48\xdb\x90\xc4\x86\x83\x37\x4a\xd6\xc2\xc1\xed\xdd\x39\xfd\x95\x36\x56\xb4\xdc\xdb\x9a\x36\x06\x12\x1b\x0e\x5e\x8b\x99\x74\x80\x5b\xf1\xaf\x56\xa7\xc4\xe5\xbe\xd2\xb4\x95\x48\x2c\x02\x12\xf4\x99\x0a\x38\x11\x23\x1c\x61\x75\x87\x47\x30\x2c\xda\x75\xd2\xaa\xa2\x08\xc7\x4a\x56\x51\x31\xdb\xdb\xa5\xf9\xe2\xd4\x2e\x24\x41\xc6\x1d\xfa\x27\xa8\xce\xd9\x70\xf0\x1f\x5e\x2f\xe5\x9e\x90\x15\xae\xed\xc3\x12\x58\x54\xa5\xa4\x00\x62\x48\x26\x06\x84\xee\xa5\x5f\x4b\xa9\xc1\xaf\x0d\x59\xea\xa2\xa9\x84\xda\x9e\xa5\x5f\x74\x60\x51\x10\x8a\x3d\x43\xf7\x37\x1d\x58\x9a\x36\x21\x81\x61\x8c\x91\xdb\x4e\xc4\xd8\x09\xdf\x29\xe1\x20\x5b\xcf\xa5\x95\xa1\x51\x91\xc0\xc6\x28\xed\xc1\x9b\x9c\x2c\xa6\x0e\x50\x1b\xd3\x80\x59\x49\x4b\x4d\x20\x74\x00\xae\x05\x70\x21\x40\x2d\x9a\x5a\x2e\xb0\xf7\x62\x16\xc4\x8c\x88\xe9\xc4\xda\xa6\x70\xaa\xfc\xe1\x79\xad\x2c\xa5\x42\xe1\x48\xd3\xec\x97\xf4\x88\xf4\x6a\xa9\x4b\xc8\x7a\x5c\xdb\x2d\x9c\x87\xe2\x48\x40\x6d\xb7\x39\x84\x94\xf5\x0f\x70\x98\xb6\x64\xe7\x2e\x77\x63\xfe\xa2\x6f\x06\x44\xba\x84\xef\x90\x88\xcf\x83\xe9\xf5\x04\xf6\x54\xb1\xe9\xf5\x18\x49\x88\xe8\x04\x46\x3d\xbd\x23\xa2\x84\x64\x9b\xc0\x82\x7f\x94\x59\x4a\xb9\x31\xca\xa1\x3e\xa7\x59\xcc\xc6\xed\x36\x27\x7e\x8a\x9f\x1d\x3b\x3e\x76\xb9\x43\x78\x45\xe6\xd0\x66\x52\xa1\xef\x88\x42\x41\x2b\x6e\xe1\x7e\x59\xc1\xed\xdd\xfd\xa3\x97\xa1\x91\xb5\x7d\x46\x8d\xe1\xac\x8a\x80\xf6\x76\x0d\x54\x85\xbb\x02\x18\x97\x80\x01\xc2\xfe\xcd\xad\x9b\xf3\x7a\x1f\x66\xb6\xd9\x40\xc3\x5d\xc9\x6b\x38\xab\x5a\xb0\x7f\xa0\x9d\x7f\xbb\x04\xad\x6a\x82\x71\x30\x18\x58\xe9\x97\x56\xe3\x0a\xc9\xa5\xc5\xa0\x8d\xaa\x40\x38\xc0\x2d\xc5\x26\x6c\xb7\x77\x08\x3a\xad\xc5\xed\x01\xdc\x80\x6e\x95\x3a\x73\x40\x77\x40\xd9\xb0\x23\xf6\xa0\x1f\x84\x74\x9e\xc4\x64\xc9\xee\x97\x55\xc0\x78\xb0\x4d\x70\xa4\x76\x79\xf8\x90\x30\x4d\x80\x1f\x01\x50\x46\x00\xbb\x2c\xc1\x22\x5a\xe9\x1b\x84\x4b\x3d\x7b\xe8\xc4\x61\xd0\xd8\x3f\x76\xb0\x29\x32\xf4\x69\x41\x09\x1e\x44\x55\x6d\x3f\x7f\xbb\x5c\x48\xab\xca\x74\x84\xa3\x67\x60\xd3\x6b\x97\xbc\x7a\xcc\x91\x16\xb3\x7a\xf4\xf3\x52\xda\xc7\x11\x64\xc9\xaf\x41\x3d\x0e\x4d\x19\x96\x47\xfa\x77\x23\xb1\xb7\x67\x9d\xe3\xef\xa6\x90\xe0\xcb\xe9\x75\xcb\xdc\x89\x91\x78\xd8\x9b\x50\xb9\xb6\x5b\x87\x29\x99\xb7\xf6\xc8\xda\xc9\xd6\x82\xbf\xfc\x9c\x37\x25\xd7\x78\x9e\x31\x7c\x77\x0a\xbd\xce\x51\x53\xa0\x50\xba\x7c\x45\xbc\x9f\x0e\xb9\xb4\x85\x0a\x91\x56\xf5\x70\x70\x38\x68\x5f\x09\x41\x45\x94\x0a\x13\x6f\x14\x78\x43\xcf\x65\xad\xb0\xbe\x52\x2d\x0d\x05\xb1\x84\xf3\x57\xb4\x78\xba\xf6\x8d\x71\x5e\xed\xb5\x83\xfd\x21\x06\xad\xd1\x21\x64\x26\x97\x50\x52\x55\xb6\x01\x23\x25\xf2\xe1\x11\xd3\x0f\xec\xde\x0e\xdb\x35\x96\x0e\x42\xc3\x11\x16\x27\x69\x2d\xae\x4d\xf5\x8a\xd7\x4a\x4c\xaf\x43\xad\xf5\x6f\xf0\x16\xf5\x1a\x4f\xb0\x09\x77\x9f\xdd\x2c\xf5\xae\xf1\xca\x68\x6c\x32\x66\xed\xd0\xa0\x4a\xcd\x96\x14\x4d\xa9\xb7\x58\x90\x0f\xb2\x5c\x12\xdb\xd2\x21\x05\x01\xc1\x47\x5e\x83\xa1\xed\xae\x33\x84\x45\x81\xc8\x93\x9d\xeb\x76\xc1\x85\x21\xf1\x57\xe5\xe7\xc8\x15\xba\xb4\xf4\x6e\x87\x3e\x2d\x59\xe9\x4c\x8d\xa1\x99\x74\x40\xa6\x98\x64\xc4\xe5\xf9\x7d\x2d\xd1\x41\x9f\x30\x52\x73\x86\xf2\xa6\x15\xac\x79\xb8\x3c\x34\xd6\xac\x94\x90\x62\xdc\x61\x5e\xab\xba\x86\x7b\x09\x42\x5a\xb5\x92\x02\x2a\x6b\x16\x44\x5e\x6a\x4c\x00\xc7\xeb\xe7\x4a\xa0\x9c\x64\x38\x0f\x68\x38\x10\xd2\x95\x56\xdd\x4b\x01\x4a\x4f\x60\xee\x7d\xe3\x26\x45\xd1\x8e\xdb\xc2\x94\xae\x58\xa8\x99\xe5\x5e\x16\x7f\xef\x4a\x73\x2c\x04\x4c\xd7\xd2\xac\x0a\x78\x1c\xc4\xcb\x7e\xb0\x84\xaa\x9a\xa2\x25\xef\x02\xba\x69\xfd\x4e\xa2\x0c\xf4\xc0\xa5\x58\x31\x4c\x27\x68\x2f\xa1\xc2\x50\xd9\xb6\xa0\xbf\x51\x0f\x52\x1c\x22\x4f\x4f\x74\x69\x8e\x5e\xf0\x06\x38\x54\xc8\x9c\x66\xb0\xd6\x9a\x9e\x88\xcc\xc7\x16\x70\xe2\x90\x7d\xf3\xbf\xc1\xf8\x6e\xf4\xfb\x94\xbf\x14\xe8\x14\x6a\x1d\xe3\x3b\xc3\x5f\x6b\xff\xb7\x68\x44\xd9\x07\xb9\xae\xe5\x3a\xda\xe7\x32\xd3\x78\x1c\xf1\x77\xf6\xe6\x3d\x27\x84\x03\x20\xcf\xe4\x32\x94\xbc\x48\xd8\xe0\x54\x65\x2c\xfc\x36\xc6\x7c\x41\x6a\xe8\x78\xc4\x4b\x8e\x6b\x7c\x46\x3b\x73\xca\x6f\xbc\x46\xe3\x53\xc7\x9d\xbb\x82\xb0\x4f\x89\xa6\x3e\xb1\x1a\x1d\xc1\xf8\x0b\x9d\x2f\xba\xa0\x64\x94\x51\x3b\xcd\xa1\x6a\x95\x4c\x60\x66\xd9\x58\xbf\x0e\xdb\x4e\xdc\x3e\x1a\x8d\xa1\x5a\x78\x46\x45\xa8\xca\x46\x25\xd7\x98\xb3\x31\xdd\xc9\x9b\x16\xb2\x67\xab\x9c\x86\x04\xb3\xf4\xa0\x30\x42\x1f\x1b\x39\xea\x8b\x4e\xc5\x7d\xdb\xab\x84\x88\x49\xe7\x82\x68\x21\x10\x1c\xf0\x30\x1e\xdf\x3f\x92\x3c\x25\x18\x56\x8c\x54\xd9\x08\x96\xc3\xf2\xa1\x3c\xd5\x0d\x14\x76\xac\x74\xa8\x98\x19\xc0\xcb\xd2\x58\x41\xa5\xd2\x1c\x14\x95\x7e\x45\xc1\x5a\x35\x2c\x8a\xc1\xe0\xa0\xe4\x1f\x59\x1c\x83\xd4\x9e\xf5\x12\xa8\x91\x9e\x7d\x40\xfc\x73\xdc\x71\xbc\x23\xd9\xa7\x05\xc1\x38\x84\x1d\x63\xac\x1b\xc7\xd9\x6f\x41\xc6\xfe\xc4\x2e\x64\x15\x8b\x71\x16\xa2\x45\x55\x30\x75\xa9\xa5\x64\xd2\xa6\x20\x0a\xd3\xc5\xee\xa5\x01\xbb\x6a\x1a\x19\x38\xc8\x9e\xd9\xa7\x1a\x9d\x8f\x4a\xda\xdd\x4a\xe4\x79\x74\x65\x96\x0f\x07\x14\x60\x9d\xe6\xb8\x9f\x7a\xf9\x5e\xec\x7d\x7d\xc7\x2c\x49\x42\x04\x3a\xaa\x43\x29\x47\x13\xff\x34\xa4\xa1\xb7\xa4\x54\x3a\x92\x65\x3b\x28\x23\x8c\x6e\xad\x7c\x39\x8f\x1b\x37\x5f\x78\x3d\x55\x72\x27\x61\xff\x5d\x54\x70\xff\x04\xa1\xee\x0e\x10\xbd\xdb\x11\xa3\x11\x2e\x4d\x6b\xbf\xe2\x25\x33\x3b\x90\x33\xbd\x26\xdc\x59\x77\xe8\x9d\x73\xf7\xa1\x7d\xc5\x59\x9a\x1a\x87\x3c\x65\xf4\xa8\x9d\x1d\x5f\x85\xb5\x70\xab\x08\xf0\xed\x5d\xcc\x3a\x02\x3b\xa3\xdc\x3b\x5d\x3f\xb6\x23\xe9\xb1\xb9\xee\xc8\x58\x47\x3b\xd3\x7a\x2c\xfc\x5d\xb1\x42\x56\x7c\x59\xfb\xc9\x9e\xab\xbf\x58\x5d\x42\x02\x93\x07\x9e\x7d\x9a\xc0\xb3\xf5\xa8\x8d\x81\xfd\xb1\x29\x8f\xbd\xf3\x78\x9e\xb9\x53\x89\xe6\xf6\xdf\x06\x9c\xc8\xb5\xd0\x42\x8e\x05\x48\x2d\x75\xa6\x44\x68\xe7\x14\x06\x17\x93\x78\x6b\xb6\xa7\xa6\x46\x77\xfb\xe2\x2e\xe8\x61\x8c\xe5\xc3\xa3\x30\x1f\xa2\xdc\x99\x8f\xe3\x69\x36\xa4\x65\x1b\x01\x27\xe5\x2f\x26\x87\x5c\xdb\x76\x94\x0e\xdd\xd6\x52\xb7\x8b\x57\xeb\x68\x56\xb2\x22\x1f\x0e\xc8\xc2\x2e\x0b\x2d\xf4\x58\x42\x5f\x69\x59\x16\xbc\xb9\x0d\xa9\x75\xb7\x87\x26\x66\xbb\x78\xa9\xc4\x43\x8f\xb7\xc7\x72\x77\x7b\xa7\xb4\xef\x89\x6f\x3b\xf2\x91\x82\x12\xda\xb2\xa2\x1c\x6e\xbb\x32\xfa\x11\x51\xeb\x97\xa3\x7e\xdb\xed\x14\xa0\x63\x78\x07\xab\x6f\x15\xde\x52\x63\x54\x63\xb8\x28\xbd\x94\x11\xfb\x60\xf4\x2d\xfd\x87\x5c\x3c\x54\xcb\xde\x72\xab\x80\x8c\xbe\x55\xa2\xc3\xb8\x5b\x1b\x83\xca\x83\x3b\xd0\x96\xb6\xa8\xb9\x9d\x41\x11\xe0\xf4\xfe\xc5\x75\x4b\x6c\x1b\xcf\x9d\x7a\xe8\x4e\x58\x15\x47\x98\x63\x58\x75\x88\x0f\x5d\x6a\x7b\xf0\x4d\x7b\x07\x25\x60\xc4\x43\x07\x9a\x90\xf1\xd4\xd4\xc3\xe8\xd0\x6a\x3b\xe1\x99\xa7\x6a\x0b\xd6\x25\x6d\x64\xfb\xad\xba\xdb\x53\xb9\xc3\xee\x84\xb6\x80\x44\xf2\x67\x07\x0f\x1a\xd3\x82\x86\xbb\x7e\x55\xeb\x38\x3b\x68\xea\x05\xc4\xc9\x66\xb8\x43\xe0\xa0\xcb\x86\xdd\xf9\x61\x78\x1d\xed\xb8\x81\xfa\x84\xbe\x3b\xc0\x4a\x36\xb9\x84\xf8\x3d\x80\x86\x8e\xf7\xdc\xcf\x63\x51\xa3\xc0\x40\x8d\x89\xfe\x56\xae\x91\x8c\x6c\x53\x2d\xe4\x43\xa6\xe8\xed\x0f\x4a\x4b\x2c\x57\x22\xdc\x34\x43\x50\xed\x8e\xde\x9f\xd7\x10\xb6\x50\x49\x4e\xf6\xde\x13\x65\x76\xbf\xf9\x1e\x14\xdd\xe3\x05\xf6\x09\xb5\x4a\x89\x05\x6f\x3e\x5b\x5b\xce\x0f\x37\x7d\x2e\x70\x50\xde\x7e\xda\xc6\xa5\xf8\x4e\xa4\x03\xcd\x9f\x3b\x22\xec\xe5\xf9\xb7\x8c\x09\x53\xb2\x11\x9b\xca\x5f\x34\x2c\x5c\xd5\xf5\x37\xcd\x0a\xb1\x18\xd0\xf5\xb5\x75\x02\x21\xd0\xaf\x16\x61\x0c\xe8\xb8\x09\x7d\x41\x6f\xa6\xa6\xd7\x6d\xcd\x38\x0f\x5c\xa1\x5c\xf4\x4a\xc5\x9f\x30\x83\xb8\xaf\x1e\x42\x4e\x64\x4c\xf8\xd6\x7a\xec\xc6\x46\x97\xe3\x58\xf1\x77\xf7\xe2\x81\xd1\xa5\x04\x70\x8f\xba\x64\xef\x74\x89\x86\x39\xb9\x00\x80\xf3\xf6\x33\x1e\xfb\x55\xaa\xd9\xdc\x4b\x31\x1c\x0c\xe2\xfd\xc6\x9b\x85\x2a\x19\xbd\xf0\x8d\x55\x92\x12\xd5\xc3\x79\x50\x10\x12\x35\xf5\xc3\xc3\x54\x15\x76\x05\xf1\xb3\x25\xbb\x6e\xaf\x89\x4f\xb9\x93\x06\xf9\x6d\x00\x7b\xf6\x93\xe1\x22\x84\x92\xb0\xab\xcf\x4f\x39\x78\xd5\xdc\x0d\x39\xb1\x45\x28\xed\x33\x25\x8a\xec\xe2\xc7\x1f\xff\xf1\x12\x9e\xc3\x45\x1e\x85\x20\xfd\x47\x78\x01\x7f\xfc\x41\x7f\xfe\xf3\x92\x92\x3b\xd9\xb7\x2f\xf7\x33\x0e\x0e\x4e\x25\xff\x2a\x01\xcf\x56\xd1\xb9\x74\x9d\x3b\xf4\x6c\x77\x00\x8b\x4d\x1f\xdb\x54\x67\xb8\x3a\xc0\x3a\x41\xf0\x14\x9c\xa9\x06\x1e\xc1\x55\x55\xb0\x1b\xb7\x3c\x0b\x6f\x78\x48\x70\xfe\x43\xa2\x1c\x81\x34\x5e\xfd\x5b\x99\x79\x3c\x67\x18\x65\x18\x06\x17\xbb\x36\x59\xba\x26\x82\x67\x18\x5d\x97\xb0\x0b\xae\xb7\x72\x9d\xe2\x2b\xbb\xc8\x61\xdb\x71\x21\x9d\xc4\xc9\x05\xbb\x2a\xe9\xd7\x11\xc1\xcd\x17\x47\xbe\x7a\x1c\x1f\x63\xc3\x15\x35\x88\xf8\x45\xd6\x92\x3b\x99\x5d\xe4\xff\x2f\x63\xf7\x03\xb3\x3e\x11\x98\xdd\x41\x21\xe9\xbe\xf1\xc6\xca\x14\x5b\xc7\x62\xa0\xbd\xb0\xb6\xee\x8f\xbe\xaf\xff\x04\xdf\x5b\xb3\x0e\x6f\xa6\xdc\xa7\x9a\xfd\x62\xd6\xf4\x5a\x6a\x40\xef\x52\xc7\xc0\xed\x8c\x88\x48\xbb\x0e\xe2\x32\x61\x57\xed\xdf\xb1\x07\xc4\xf7\xff\xf4\x3d\x35\x56\xf1\x37\xd6\x2c\x32\xdc\x46\xdd\x27\x0b\x3f\x4d\xa0\x6f\x03\xf1\x85\x05\x71\xbd\xb3\x42\xda\x7f\x3d\x12\xe3\x95\x2b\xb3\x91\x12\xa3\x48\x8a\xdd\xa8\x17\x10\xa8\x3a\xac\x13\xb4\x9d\x43\x8e\x01\xed\xf8\xca\xe0\xc0\x2d\xec\x55\x6d\x9c\x24\x3d\x2b\x6e\x93\xff\x13\x52\x87\x9e\xc0\x93\xde\x94\x5c\xdf\xd4\xaa\x94\x19\x4a\x18\xc3\x77\xad\xef\xba\x5f\x12\x7a\xdf\x14\xda\xdf\x18\x85\x2f\x08\x45\xf8\xcc\x5f\x70\x21\x54\x78\x65\x3e\x6a\x7f\x4b\x82\x15\x3c\xfe\x5a\xa7\xff\xd2\xad\x28\x60\xc7\x1f\x7f\x28\x00\xd8\xc4\xd2\x57\x8a\x36\x10\xe3\x69\x7b\xcd\x69\x77\x9c\xff\x05\x00\x00\xff\xff\xe6\x25\xdb\x13\xb6\x25\x00\x00")
+var _templateErrorTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x54\xd1\x4e\xdb\x30\x14\x7d\x8e\xbf\xe2\x2a\xea\x43\x8b\x4a\xc2\x78\x5b\xa7\x3e\xa0\xaa\x9d\x90\x58\xa5\x09\xb4\x17\x84\x26\x13\xdf\x24\x16\xce\x75\xb0\x5d\x06\x8a\xfc\xef\x93\x9d\xb6\x6b\xd9\xd8\xd8\x60\x3c\x45\xf1\x3d\xf7\xf8\x9c\x73\x6d\x77\x5d\x7e\xc0\x66\xba\x7d\x30\xb2\xaa\x1d\x1c\x1f\xbd\x7b\x7f\xd8\x1a\xb4\x48\x0e\x16\xbc\xc0\x6b\xad\x6f\xe0\x94\x8a\x0c\x4e\x94\x82\x08\xb2\x10\xea\xe6\x0e\x45\xc6\x2e\x6a\x69\xc1\xea\x95\x29\x10\x0a\x2d\x10\xa4\x05\x25\x0b\x24\x8b\x02\x56\x24\xd0\x80\xab\x11\x4e\x5a\x5e\xd4\x08\xc7\xd9\xd1\xa6\x0a\xa5\x5e\x91\x60\x92\x62\xfd\xec\x74\x36\x5f\x9e\xcf\xa1\x94\x0a\x61\xbd\x66\xb4\x76\x20\xa4\xc1\xc2\x69\xf3\x00\xba\x04\xb7\xb3\x99\x33\x88\x19\x3b\xc8\xbd\x67\xac\xeb\x40\x60\x29\x09\x21\x45\x63\xb4\x49\xc1\xfb\xb0\xe8\xb0\x69\x15\x77\x08\x69\x8d\x5c\xa0\x49\x61\x10\x2a\x4c\x36\xad\x36\x0e\x52\x24\x57\xe9\x4c\xea\xbc\xd0\xe4\x8c\xbc\xce\xc3\xc2\xad\x4a\x03\xe3\x21\x7c\x93\xae\x86\x41\x29\x51\x09\x0b\x93\x29\x44\xea\x45\xff\x3b\xc8\x96\x5a\xa0\x0d\x6c\x49\x9e\xef\x95\x1a\xde\xda\xa8\x3f\x24\x48\xbc\x41\xdb\x4b\x47\x58\x53\x71\x12\x80\xa2\x42\x1b\x5b\x9d\x0e\x60\x69\xd6\xd0\xb5\xf7\x8f\x86\xb7\xf5\xe7\x33\xb0\x45\x8d\x0d\xcf\x58\x72\xc7\xcd\xde\x2e\x53\x68\x78\x7b\x69\x9d\x91\x54\x5d\xf5\x9f\x8e\x25\x49\xd0\x6d\x38\x55\x08\x83\xc0\x37\x86\x41\x75\xab\x96\xbc\xc1\x60\x60\xe3\x25\x88\x4e\x92\xb4\xeb\x7a\x10\x78\x9f\x4e\x20\xfe\x6e\xc0\xde\xa7\xe3\x35\x1b\x92\x88\x2e\x3d\xdb\xf9\x63\x79\x0e\xf3\x7b\x87\x64\xa5\x26\x0b\xb2\x69\x15\x36\x48\x6e\x6b\xbc\xba\x55\x59\x04\x08\x14\xf3\x20\x1b\x24\x39\x34\x25\x2f\x30\x0b\xcd\x17\x9b\x38\x00\x37\x34\x50\xeb\x20\x6e\xd7\x7d\x54\xb7\x1b\x1e\xe8\xfe\x38\x85\xf8\x32\x56\xae\xa8\x80\x21\xc2\xc1\x17\xae\xa4\xe0\x4e\x6a\x8a\x7b\x8d\x76\xb4\x0d\x47\xbb\x41\x6d\x45\x74\x1e\x3a\x96\xc4\x3c\x42\x32\x98\x85\x8c\x58\x34\x2c\xcb\x27\x27\x9d\xc8\x32\x8e\x69\x0c\xfa\xe6\xd1\x89\xb8\x8c\x5c\x57\x1f\x42\x25\x0c\x62\xcd\x3d\x8d\x78\x96\x24\x9e\xed\xa5\x69\xd0\xad\x0c\x3d\x21\xad\x4b\xc3\x4d\x4a\x27\xdb\x20\x8d\x99\x69\x81\x3f\x5c\x2e\xb8\x54\x28\xc6\x90\xc6\x4d\xd2\x49\x1f\x8e\x67\x2f\x1d\xcc\x36\xd0\xa5\x76\x8b\x70\x3d\xff\x2e\xce\x7f\x31\xb5\xd9\xe9\xe5\xe2\xf3\x1c\x3e\x69\x83\xe0\x6a\x4e\xa0\x09\x81\xc2\x73\xd4\x70\x57\xd4\x28\x20\xac\xb5\x68\xe2\x21\x09\x10\x07\x78\xdf\x62\xe1\x2c\x70\xb0\x92\x2a\xd5\xe3\xf7\x33\x38\x97\x54\xad\x14\x37\xff\x3f\x86\x99\xa6\x52\xc9\xc2\xbd\xee\x0c\xcf\x34\x17\xf8\x06\x43\x3c\x0d\x08\xe2\xea\x15\xd5\xcf\x34\x59\x67\xb8\x24\xf7\xc6\xe1\x87\x87\x76\xc8\x92\xaf\xbf\x96\x3a\x85\xe1\x4f\xaf\xcd\x90\xa4\x1a\xfd\xb6\x63\xff\x3a\x3d\x0b\xbf\x7f\xf4\x9e\xd5\xb2\x3b\xed\x3f\x37\x3c\x0e\xb8\xef\x18\xb1\xae\x03\x24\x01\xde\xb3\xef\x03\x00\x89\xdf\x44\x6d\x1a\x08\x00\x00")
+
+func templateErrorTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateErrorTmpl,
+		"template/error.tmpl",
+	)
+}
+
+func templateErrorTmpl() (*asset, error) {
+	bytes, err := templateErrorTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/error.tmpl", size: 2074, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateEventTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\x5b\x6f\x1b\xb7\x97\x7f\x9e\xf9\x14\xa7\x42\x36\x98\xf1\xca\x54\xd3\xb7\x55\xea\x05\xda\x38\xc5\x1a\x68\xe3\x76\xa3\xec\x3e\x04\x41\x41\x0d\x8f\x24\xc2\x23\x72\x42\x52\xb6\x0c\x55\xdf\xfd\x8f\xc3\xdb\xcc\xc8\x72\xda\x7f\x8b\xe4\x21\xd6\x70\xc8\x73\xf9\x9d\x2b\xcf\x1c\x0e\xb3\x8b\xf2\x8d\xee\x1e\x8d\x5c\x6f\x1c\x7c\xf7\xed\xab\xff\xba\xec\x0c\x5a\x54\x0e\x7e\xe2\x0d\x2e\xb5\xbe\x83\x1b\xd5\x30\xf8\xa1\x6d\xc1\x6f\xb2\x40\xef\xcd\x3d\x0a\x56\x2e\x36\xd2\x82\xd5\x3b\xd3\x20\x34\x5a\x20\x48\x0b\xad\x6c\x50\x59\x14\xb0\x53\x02\x0d\xb8\x0d\xc2\x0f\x1d\x6f\x36\x08\xdf\xb1\x6f\xd3\x5b\x58\xe9\x9d\x12\xa5\x54\xfe\xfd\xcf\x37\x6f\xde\xbe\x7b\xff\x16\x56\xb2\x45\x88\x6b\x46\x6b\x07\x42\x1a\x6c\x9c\x36\x8f\xa0\x57\xe0\x06\xcc\x9c\x41\x64\xe5\xc5\xec\x78\x2c\xcb\xc3\x01\x04\xae\xa4\x42\x98\xe0\x3d\x2a\x37\x81\xe3\x91\x16\x1d\x6e\xbb\x96\x3b\x84\xc9\x06\xb9\x40\x33\x81\x17\xf4\xa6\x3c\x1c\x2e\x41\xae\x40\x69\x07\xd5\x86\xdb\x45\xde\xe6\x0c\x57\x96\x37\x4e\x6a\x35\xa9\x69\x6b\x71\x38\xc0\x8a\xcb\x36\x12\x06\x83\x9f\x77\xd2\xa0\xf5\x02\x0e\x76\x67\x56\x91\xf5\x25\xa0\x12\x91\x17\xbc\x50\x5a\xa0\x85\xf9\x15\x78\x22\xef\xfc\xd3\x0b\x16\xfe\x0e\xe4\x89\xfb\x8e\xc7\x52\x6e\x3b\x6d\x1c\x54\x65\x31\x69\xb4\x72\xb8\x77\x93\xb2\x98\xd8\x47\xd5\x4c\xca\xb2\x98\xa0\x72\x6b\xcd\xa4\x9e\xd1\x4b\x23\x97\x33\x5a\xf8\xdc\x4e\x86\xaf\x50\xb9\x99\x90\xbc\xc5\x86\xce\x46\x0e\xf8\x19\x5e\xb0\xf7\x4e\x1b\xbe\x46\xf6\x8e\x6f\x11\x26\xf6\x73\xeb\x65\x2e\xce\x9f\x9d\xd1\xfb\xb2\x18\x6a\xe4\x1f\x0c\x57\x6b\x84\x17\x8a\xb4\xea\xe5\x2e\x8a\xc9\xe1\x00\x2f\xd8\x1b\xad\x56\x72\xcd\x7e\xe5\xcd\x1d\x5f\x23\x1c\x8f\x33\x5a\x56\x83\x85\x31\xcd\x67\x8f\x75\x06\x85\x6c\x08\xd7\xb2\x2e\xcb\xd9\x0c\x3e\x58\xfc\xd1\xe8\x3b\x34\x60\x70\x2d\xad\x43\x13\x6c\xb1\xd1\xfa\x8e\x7e\x71\x07\xdd\x6e\xd9\x4a\xbb\xf1\xcb\xcd\x86\xe4\xb4\xc1\x79\x10\xdc\x63\x87\x16\xb8\x52\xda\x71\x87\x82\x08\x3e\x48\xb7\x01\xd2\xfc\x73\xcb\xde\x92\x79\x2c\x38\xed\xcf\xae\xe5\x3d\x2a\x58\x7a\x6e\x0c\xde\x44\x4a\x9e\x05\x37\x08\x5b\x4e\xde\xae\x80\x0f\xfd\x80\x28\xd2\xcb\x28\x03\x0a\xe0\x2b\x87\x06\xa4\xa3\xc0\x68\xf4\x76\x2b\x9d\x43\x31\x05\xae\x84\xdf\x28\xa4\x6d\xb8\x11\x28\xc8\x21\xc3\x2e\xa3\xdb\x16\x05\x2c\x79\x73\x37\x25\x7a\xda\xd0\x3b\x92\xc8\xf2\x7b\xec\xb4\x54\x8e\xe4\x7b\x84\x07\x4c\x62\x44\x26\xa3\xb3\x0c\x16\xbd\x5c\x16\x74\x87\x2a\x68\xbc\x7c\xa4\xe3\xd0\xb4\x92\x3c\x7a\x89\x2b\x6d\x70\x80\x2b\xc9\xc9\x3d\x95\x01\x90\xd2\x64\x28\xe5\x76\x8b\x42\x72\x87\xed\x23\x2b\x57\x3b\xd5\x40\xd5\xc0\xc5\x1b\x4f\xad\xee\xe9\x54\xcb\x84\x6a\x78\xae\xe1\x50\x16\x72\x05\xbf\x4f\x41\xdf\x91\xd7\x34\x4c\x18\x79\x8f\x86\x55\x17\x3e\x2c\xec\xb5\x7f\xac\x5f\xc3\x37\xfa\x8e\x36\x17\x69\x07\x5c\xc1\xcb\xe1\x96\x43\xf8\x33\xcf\x24\x8e\x65\x71\xfc\xb2\x5b\x36\x8c\x1c\x4c\x05\x9f\x3f\x1e\xd9\x07\x8b\xd5\xa9\x4b\x06\xeb\xff\x8f\xd6\x77\xd5\xb2\xae\x47\xfe\x79\xf4\xbe\x37\x94\x01\x1e\x0c\xef\xc8\xe1\x10\xa2\x90\x7a\x05\x3c\x81\xea\x9d\x8a\x47\xdf\xf1\xc6\xa6\xf3\x06\x1b\x6d\x44\x38\x14\x68\x65\xbf\x1c\x5a\x4a\x3a\x6f\x2c\xcb\x4a\x72\xd7\x31\x57\xeb\xcc\xae\x71\x84\x4e\x8c\x4f\x16\xc4\x89\x12\x2e\xf6\x60\x1d\x37\xce\x8e\xbd\x72\x14\x17\x48\x1c\x6c\x16\x40\x45\xaf\x4c\xb6\x14\x30\x36\x07\x2c\xf6\x55\xe3\xf6\x10\xf3\x10\xc5\x28\xe5\xa3\x1a\xaa\x24\xc1\x62\x3f\x05\x34\x46\x07\x13\xbb\xf0\x44\x16\x16\x51\x38\x16\x48\xd4\xde\xfe\x68\x0c\x7c\x73\x05\x4a\xb6\xb4\xbb\x30\xe8\x76\x46\xd1\xa3\x3f\xe6\x0d\x19\xd7\xa2\xcd\x17\xfb\xc3\x62\x3f\x07\xa2\xdb\xb8\xfd\x1c\x1a\xb7\x3f\x4e\xe9\x40\xd9\x67\xce\x2f\xe4\x35\x82\xe5\x47\x5c\x4b\xf5\x1c\x36\xde\x56\xba\x23\x9c\xec\x3f\x02\x2a\x72\x39\x87\xd6\x14\x74\xe7\x2c\x5c\xd8\xcf\x2d\x5b\xec\x6f\x03\xb3\x67\x21\x14\xe6\x3e\x05\x49\x86\xb0\x92\xca\xa1\x59\xf1\x06\x3d\x6c\x99\xd7\x29\x9f\xbf\xc0\xa2\x2c\x8e\xc1\x14\x29\xce\x86\x36\x58\x6d\x1d\x7b\x4b\xdb\x56\x15\xd5\x83\x79\xf2\xee\xff\x58\x80\xd0\x68\x7d\xcd\xb4\xbb\xce\x97\xa7\x21\x8a\x11\xc0\xc9\x34\x8b\x5c\x7b\x5b\x0e\xdd\xc1\xdc\xb3\x01\x46\x01\x93\xaf\xe0\x14\x83\xb8\xed\xa3\x76\xb1\x87\x8d\x6e\x9f\x04\xdf\xc8\x15\xa6\xf0\xb0\x91\xcd\x86\xf2\x32\x1d\x4c\x6e\x20\x62\x43\x22\x0d\x68\x43\x0d\xcd\xc3\x06\xd5\x93\x06\x60\x98\xde\x47\x91\xbb\xd8\x9f\x89\xda\xc5\xbe\x2c\xc8\x4f\xe2\xbf\x13\x77\x29\x8b\xed\x2e\xbd\x02\xaa\xfa\xec\x97\x9d\xc3\x7d\x59\x74\xa8\x84\x54\x6b\x5a\xff\xf8\x29\x3e\x84\xb4\x55\x16\xb9\x38\x58\xf8\xf8\x29\x3f\xc4\xb7\x21\x3d\x8c\x4e\x90\x9a\x09\x89\x5c\xd4\x7a\x9d\x63\x11\x0c\x29\x6c\xe8\xfd\x5e\xb5\x31\xa5\x5e\xbf\xb8\x7d\x94\xf9\xcb\x22\x72\xf9\xf8\x29\xae\x7b\xa1\x62\xca\x3a\x91\x14\xb6\xdc\xf8\x42\x8e\xa0\x76\xdb\x25\xb1\x5e\x25\xb9\x93\xb4\x1e\x7f\x3e\xa8\x86\x0f\xdc\x42\x63\x90\xf7\xd0\x9f\x52\xed\x25\x54\x94\x1d\xac\x33\x52\xad\xcb\x42\x11\x92\x32\xcb\xf2\xc6\x1b\x30\x6a\xfa\xb4\xc7\xa3\x9a\x7d\x2e\x37\xa4\x8c\xe0\x52\x46\x58\xec\xeb\x48\xab\xaa\xc9\x81\xb5\x21\xce\x31\x0c\x28\x61\xb2\xf4\xb6\x2c\x1c\xdb\xee\xd8\xcf\xba\xb9\xa3\x87\xa4\xa8\xdf\x16\x1f\xca\x22\xff\x9c\x82\x63\x59\x33\x0b\x3e\x8b\x86\x54\x18\xc8\x7c\x50\x6d\x24\xf4\x6c\x4c\xa5\x70\x5a\x69\x43\xa5\xb8\xa3\x24\x13\x7a\xb9\xc8\x83\x24\x2d\x3a\x16\xdb\x9e\x5f\x83\xba\x95\x63\x3e\x62\x3b\x16\x35\x66\xac\x1e\x46\x65\xcc\xc6\xb3\x19\xfc\xaf\x6e\x5b\xea\x5c\x7c\x27\x62\x7d\x23\x72\x16\xc7\xd8\xf7\xfc\x29\x8c\x89\xde\x10\xc8\x11\x64\x7f\x03\x9c\x28\xb4\x37\x44\x4f\x3f\x2a\xc0\x85\xc8\x85\x3a\xfa\x9b\xd3\xb0\x1c\x86\xc6\x93\x52\x30\x92\x98\x0b\x71\xda\xfb\x4c\xa3\x86\x27\x11\x50\x3f\x51\x46\xe0\x8a\x2e\x4c\x63\x71\xb3\x86\x70\x05\xbc\xa3\xdf\xd5\x40\xe9\xf8\xc3\x87\x94\x3d\x04\xb3\xcd\x61\x99\x58\xce\xe3\xdf\x63\xd2\x2f\x43\x34\x88\xb4\xa4\x27\x65\x01\xdf\x54\x06\x00\xa8\x91\x0c\xdd\x61\xdf\x0e\xe7\xe3\x67\x75\xcf\x6f\xab\x41\x98\xfd\x65\x35\xf3\x69\x3b\xd4\xb4\x5f\x9d\xf6\xdc\xa3\xba\xc4\x65\x0e\xf4\xff\x14\xd4\x1c\x5a\x54\x3d\x34\x75\x56\xd9\x44\x1b\x2f\x74\x6a\xb7\xff\x4c\xed\xd0\x4f\x9f\xd5\x7a\x36\x83\x1f\x2c\x55\x84\xf7\xbf\xfd\x3c\x3d\xe9\xca\xa5\xb3\xd8\xae\xc0\xe0\x96\x4b\x65\x7d\xdf\xe7\x77\x68\x85\xb6\xbf\x08\x50\xde\x15\x68\x9d\xd1\x8f\x28\xce\xc2\xd8\x0b\xfc\x37\x70\xa4\x7b\x04\x45\xb5\x63\x2d\xb7\xee\x7d\x12\xce\x53\xaa\x5f\x83\x84\xff\xbe\x82\x6f\xc9\x24\x23\xc7\xca\xbf\x3f\xce\x87\x90\x7f\x94\x9f\x98\xfa\xe4\xf7\xf6\x8b\x7e\xfb\x60\xcf\x5c\xfe\xe7\xab\x4f\x94\x0e\x22\xdc\xd8\x22\xb7\x59\x47\x7b\x0e\xc7\xf3\xd0\x4c\xe1\x0e\xb1\x23\x4f\x0f\xe5\xf6\x0b\x79\x21\x32\xf9\xca\xf8\x7c\x49\xe7\x81\xc6\x23\x42\x10\xd2\x4b\x50\x5b\x2a\x81\xfb\xd4\xe5\x6f\xb5\x75\xe4\x63\x74\x43\xc8\xa4\xf2\x1d\xb4\x47\x89\x74\x9a\x82\x36\x70\xf9\x2a\xde\xfc\x8c\x9f\xab\x28\x0d\x76\xd7\x6c\xfa\xb3\x67\x91\x79\xaa\x55\xc6\x87\xa4\x3b\x84\xdc\xef\x21\x08\xf1\x92\xc9\xd9\x1a\x2e\xe1\x55\x82\xe0\x35\xc8\xcb\x4b\x8f\x83\x5c\x8d\x75\x27\x9f\x20\xd8\xaf\xae\x7c\xe8\xf9\x3d\xa9\x12\xc8\xb2\x28\x8e\xc3\xd2\x70\xf9\x2a\x82\xe4\xf6\xb1\x16\x0f\xf1\x89\x21\x48\xad\x59\x28\xf5\xa7\xb5\x22\x2e\x79\x60\x08\xaa\xc6\x8f\x08\x3c\x3a\x54\xd9\xc8\xd9\x43\xfd\xa7\xc6\x94\xae\x4d\x94\xb4\x1e\x9f\xb9\x89\x45\xbc\x92\x24\x55\xb3\x5a\x47\x82\x75\x8f\xe0\x49\x07\xde\xac\xd6\xf1\x96\xc9\xaa\x0b\xb7\xcf\xbd\xed\xd9\xfe\x39\xb7\xbc\xbf\xa7\x86\xd7\xed\xf3\xfd\x76\xb1\x1f\xd4\x9e\x7d\x44\x25\x56\x95\x08\x4d\xaa\x31\xc3\x98\xc1\xd1\x50\x22\x5d\x29\xb5\x89\x51\x43\x8b\xa1\x61\x21\x78\x9e\x47\x70\xbb\x73\x9c\x9e\xa7\x03\xcc\x70\x8f\xcd\xce\xa1\x78\x32\xc6\x88\x40\x8d\x84\x3b\x7f\xaf\xe9\x21\x9c\xc2\x73\x55\x8f\x31\xf6\xa4\xec\xc9\x95\xcf\xd6\x41\xb9\x1a\xae\x52\xcc\x05\xe7\xf0\x38\x92\xdb\xed\x09\xc7\xa1\xbd\xea\xd7\xb4\x38\xe8\x6b\xdc\x9e\xf9\x8a\x9b\xb8\xd5\x63\x22\xcb\xdc\xc2\xf8\x06\x26\x66\x14\x6a\x5f\xfc\xed\xf1\x99\x81\x01\x8d\x06\xa4\x20\xde\x2f\x14\xbb\xb9\x66\x0b\xea\x79\xe3\xba\x27\x41\xaf\x3a\x43\xf1\x94\x86\x0a\x93\xb7\x79\xf2\x48\x86\xe8\x77\x1e\x8f\x14\xbb\x3c\xce\x4f\xc8\x1c\x1c\x46\xc3\x08\x20\xb6\xb1\x6f\x1d\x1d\xeb\x9b\xd6\xd9\x0c\x6e\x3b\x22\x43\x96\xd4\x1d\x1a\xde\x5f\xeb\x03\x5d\xe1\xed\x1e\x28\x15\xb7\x5d\xb2\x84\x17\xea\xb6\xf3\x14\x6e\xae\x13\x05\x29\x92\x57\xc4\x03\x37\xd7\x10\x55\xa6\x69\xc9\x6c\x06\xef\xe2\x28\xb7\x9f\xa1\x89\x20\x26\xdc\xf8\x19\x15\xc5\x1e\xa5\x11\x81\x2d\x92\x28\x96\x95\x85\x3f\x73\x31\x52\x2d\x3a\xf9\xf3\x93\x96\x51\xb2\xa4\x19\xde\xe9\x0d\xfc\x64\x88\xf7\x14\xb8\x54\x1e\x9e\xe7\xf1\x74\x12\x45\xab\x70\xc8\xc1\x48\xbe\x5e\x29\xdc\x3b\xf8\x85\x82\x84\x6e\xe1\xf1\xc7\x30\xbc\xfd\x12\xfe\x44\x7b\xfd\x81\xb3\xe1\xb0\x0d\x24\xa4\x56\x35\x54\xff\xc7\xdb\x1d\x0e\x2f\xf6\x45\xb1\xdd\xa5\xbc\xb2\x65\x55\xc4\x2a\x9d\x88\x5a\x91\x03\x0f\x6f\xe7\x59\x00\x92\x90\x79\x09\x91\x62\x71\x0a\x5b\xbf\x95\xa6\xae\xc5\x3d\x37\x34\x2d\x2e\x0a\x7f\xcb\xf0\x1c\xfd\x93\x14\x74\x1b\x1c\xd8\xb6\x28\x0a\xdd\x01\x5c\xc1\x76\xc7\x6e\x3b\x2a\x92\x45\xe1\xff\xb3\x0f\xd2\x35\x9b\x20\x65\x43\xe5\x5b\x77\xec\xc6\x56\xb7\xdd\x87\x4e\x70\x87\xb7\x0a\xe1\x0f\xb8\xed\xae\xc9\xe0\xf4\x54\xcf\x69\x27\x09\x2a\xc5\x14\x70\x2f\xad\xf3\x23\xee\xed\x8e\xdd\x5c\x57\xf5\xeb\xb4\xe4\x09\x16\x85\x14\x83\xa6\x4e\x0a\x3b\x05\x29\x3c\xdf\xe2\xf8\x0c\xc7\x01\xbb\xc8\x6b\x36\x83\xc5\x89\x3f\xd2\xac\x92\xa6\xb9\xd4\xa0\x7f\xde\xa1\x91\xa3\xe6\x2d\xe5\x3c\xd6\x8b\x6a\xbd\x3d\x82\xfe\x61\x66\x59\xd5\x27\xb3\xc1\xdf\x76\x68\x1e\xab\x9a\xfd\xff\x06\x0d\x56\xdb\x1d\xcb\x43\x68\xcb\x18\xab\xd9\xcd\xb5\x25\xfc\xeb\xd7\xa7\x57\xac\xe2\xcc\xe4\x22\x29\xe8\xb5\xbc\xcf\xb3\x90\x67\x6c\xf9\xf4\xda\x76\x96\x64\x36\xf9\xf9\x8b\x75\x6f\x4d\x0a\x90\x58\x8c\xee\xb3\xbf\x25\x3f\x7b\x3d\x30\x36\x6d\x4c\x6c\x5f\xbe\xcc\x96\x78\xe3\x2f\xd5\x11\xfe\xc8\x2c\x5b\x31\x3c\x4f\x47\xa9\xe6\x70\xdb\xcd\x47\x0b\x81\x82\x98\x02\xa5\xd0\x39\x4c\x46\x22\x4c\xa6\x70\x73\x3d\xf7\x86\x64\x37\xd7\x53\x9f\x75\xc2\xe3\xb1\xfe\x33\xd1\xb2\x5b\xfe\x13\xe9\x02\x91\x7f\x22\xdd\x58\x9c\x28\x4b\xbc\x5c\x87\x0a\x12\x2a\x8c\x14\x39\x14\xbe\x9a\xa4\x52\x1c\x9f\x0b\xa9\x10\xb6\x67\x23\xf8\x2b\x08\x1b\xb8\xfd\x3b\xc2\x7a\x8f\x7e\xd2\x6e\x4c\x29\x4a\x73\x77\x31\xaa\xde\x7d\x60\xdc\xc7\x4b\xbe\x9f\x6a\xa6\x8e\xfc\xfd\x6e\x69\x1b\x23\x97\x98\x0b\x4c\x28\xc0\x0a\xdb\x54\xfa\xbe\x5c\x55\x42\x1d\xda\x72\x8a\x22\xb7\x41\xa2\x19\xda\xb1\x41\x32\xf0\xd9\x88\xdc\xc3\x13\x89\x03\x28\x7f\xb3\xd9\x05\x6b\x45\x81\xfd\x64\xcd\x60\xab\x39\xdd\x2d\x77\x36\x5e\x70\x88\x66\xf8\x5e\x30\x05\x1e\x2a\x5d\xaa\x7b\x34\x63\x40\x0b\x5b\xfe\x48\x89\x6d\x49\x5f\x4a\x53\x03\x98\x87\x8c\x67\x9a\x3c\x69\x88\x66\xdf\xe9\x71\x95\x45\x78\xd8\xe8\x14\x4b\x79\x8e\x1b\xb4\x23\xe1\xec\x9d\xec\x3a\x14\x0c\xae\x53\x3d\x8f\xb7\xd4\x96\xba\xdd\xf0\xd9\xc8\xe0\x9a\x1b\xd1\xa2\xcd\x5f\x2d\x4e\xa1\x48\x00\xd3\x28\xb4\xd5\xf4\x01\xd8\x4f\xea\xa8\x50\x4a\x0b\x42\x2b\x8c\xb5\x9a\x3e\x18\x8d\x30\x4f\x5f\x8f\xb2\xd9\xce\x37\x9b\x4f\x1a\xcc\xce\x37\x97\x59\x8e\x71\x22\xaf\xe1\xfb\x4b\x12\x09\x2e\x46\x5d\xd5\xa1\xcc\xd5\x32\x62\x73\x05\x4b\x36\xe2\x4c\x51\xd4\x6c\x68\x36\x48\x85\x82\xdf\x61\xf5\x94\x4e\x5d\x52\xdd\x5c\x6b\x20\x8d\xaa\xda\xc7\x4b\xb8\x6f\x7a\xdd\xab\x66\x43\x54\x28\xb8\xb0\x0f\xac\xc8\x90\xf6\x16\x54\x36\x43\x2b\x80\xd4\x62\x56\xb9\x48\x0f\x1b\x80\x3f\xfe\x00\x64\x14\xf2\x94\x9c\x4f\xe2\x28\x86\x28\xf5\x20\x52\xed\x30\x87\x51\x6e\x50\x5f\x0e\xe5\x0d\x41\xca\x6e\xbb\x1c\x7e\x91\x0d\xb2\xdb\x8e\xa8\x9f\x09\xe0\xc8\x81\x5c\x3c\x57\xae\xe6\xa4\x3e\x9e\xf6\x5d\x54\xfd\xa5\xa8\xd3\xeb\x2e\xd4\xcc\x5b\xd5\x3e\x26\x64\xcf\x97\xb9\x91\x1e\x21\x29\x84\x9c\xee\xbf\x93\xc3\x95\xf7\xdd\xac\xa3\x45\x1a\xa3\x0f\x0a\x58\xb3\x81\xef\x2f\x43\xbc\xcd\xf3\xe2\xf7\x97\x8d\xdb\xb3\x6b\xad\xb0\x8a\xc9\x2e\xdd\x0c\x02\x19\xba\xa8\x0e\x06\x82\xcd\xc6\x7f\x3d\x48\x1f\x0f\x06\xdf\x11\x0e\x07\x40\x25\xe0\x78\x2c\xff\x35\x00\xf8\x30\x56\xc0\x2f\x21\x00\x00")
+
+func templateEventTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateEventTmpl,
+		"template/event.tmpl",
+	)
+}
+
+func templateEventTmpl() (*asset, error) {
+	bytes, err := templateEventTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/event.tmpl", size: 8495, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateFederationTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\x5b\x8f\xdb\xba\x11\x7e\x96\x7e\xc5\x1c\xc1\x01\xa4\xc0\x91\xd3\x83\xa2\x40\xb6\xf0\xc3\x36\x37\x18\x09\xd2\x04\xd9\xe2\x3c\x18\xc6\x82\x16\x47\x36\xd7\x32\xe9\x25\xe9\xdd\x35\x0c\xff\xf7\x62\x48\xea\xb6\xbe\x24\x69\x71\x1e\xce\x9b\xac\x19\xce\x7c\x73\xfb\x38\xf2\x7e\x3f\x7a\x19\xbf\x55\x9b\x9d\x16\x8b\xa5\x85\xdf\x5f\xff\xed\xcd\xab\x8d\x46\x83\xd2\xc2\x07\x56\xe0\x5c\xa9\x15\x4c\x64\x91\xc3\x75\x55\x81\x53\x32\x40\x72\xfd\x80\x3c\x8f\x6f\x96\xc2\x80\x51\x5b\x5d\x20\x14\x8a\x23\x08\x03\x95\x28\x50\x1a\xe4\xb0\x95\x1c\x35\xd8\x25\xc2\xf5\x86\x15\x4b\x84\xdf\xf3\xd7\xb5\x14\x4a\xb5\x95\x3c\x16\xd2\xc9\x3f\x4f\xde\xbe\xff\xf2\xfd\x3d\x94\xa2\x42\x08\xef\xb4\x52\x16\xb8\xd0\x58\x58\xa5\x77\xa0\x4a\xb0\x1d\x67\x56\x23\xe6\xf1\xcb\xd1\xe1\x10\xc7\xfb\x3d\x70\x2c\x85\x44\x48\x4a\xe4\xa8\x99\x15\x4a\x26\x70\x38\x90\xc4\xe2\x7a\x53\x31\x8b\x90\x2c\x91\x71\xd4\x09\x0c\x48\x12\xef\xf7\xaf\x40\x94\x20\x95\x85\x74\xc9\xcc\x4d\xa3\x26\x15\xc7\x24\x23\x9d\x68\xbf\x87\x92\x89\xaa\x6b\x16\x34\xde\x6f\x85\x46\xe3\x30\x92\x6e\xe3\x21\x78\x7c\x05\x28\x79\x70\x31\x7a\x09\x1f\x35\xae\x2b\x21\x9d\xaa\x01\xa6\x91\xb2\xa7\xaa\x07\xe4\x30\xdf\x91\x11\xa1\xe1\x01\xb5\xc5\x27\xa8\xd8\x1c\x2b\x33\x04\x26\x39\x7c\xff\xf6\x39\x1c\x69\xb4\x2c\x9b\x57\x68\xc0\xc5\xbc\xdf\xc3\xc0\xfd\x86\xab\x31\x24\x37\xf4\x54\x07\x2c\x4a\xc0\x7b\x18\xe4\xdf\xad\xd2\x6c\x81\xf9\x17\xb6\x46\x48\x16\x1e\x46\x52\xc7\x15\x4e\x8f\x21\xf9\x4c\x5e\xeb\xc3\x3d\xe8\xef\xa5\x15\x56\xb8\x50\x99\x75\xd0\x57\xb8\xeb\xe2\x16\xbc\x1f\xd0\xd6\x08\xb9\x20\xb4\x20\xb8\xf1\x15\x43\xf8\x42\x39\xba\xfe\x3a\x69\x81\x0b\x7e\xb3\xdb\x38\xe4\x14\xe1\xe4\x9d\xfb\x35\xc8\x49\xd1\xc0\x20\x0f\x2f\x9a\x68\x7a\xd5\x59\x54\x6a\xce\x2a\xc1\xdb\x40\x82\xb5\x31\x18\xab\x85\x5c\x74\xce\xb6\xc1\xc0\x40\xac\x37\x4a\x5b\x43\x4e\xb9\x28\x6c\xd0\x18\x6c\x34\x72\x51\x30\x8b\x4e\x52\xb2\xca\xd4\x87\x35\x93\x0b\x84\x81\xa4\xf7\x35\xb6\xe0\x32\x88\x56\x24\x6a\x3b\xe3\x13\xee\x0c\xe9\xd7\x80\x48\x99\x3a\xe8\x51\xd8\x25\x0c\x56\x39\x01\xcb\xbf\xae\x16\x5f\x99\x5d\x92\x8f\xc8\x49\x07\xb7\x64\xc5\xa0\x6d\x21\xe6\x60\xf5\xb6\x3d\x1f\xa2\x70\xda\xd4\xb0\xe4\x39\xff\x20\xb0\xe2\xbe\xb4\x03\x99\x4f\xde\xf9\xc7\xd6\x6a\x27\xae\xf1\x69\x73\xed\x63\xfb\x14\x7b\x08\x90\x92\xf8\xd5\xd9\x14\x44\x09\xb9\xc8\xdf\x2a\x59\x8a\x45\xfe\x95\x15\x2b\xb6\x20\x07\x23\x7a\x2d\x3b\x2f\x12\x6f\x27\x58\xef\xda\xdc\x50\x12\xae\xc6\xb0\x72\x49\xab\x23\x6f\x8d\x3b\xf9\x49\x03\xa2\xec\x45\x77\x11\x4f\xa3\xd7\xb7\x93\x75\x03\x4d\x50\xda\x85\xca\x85\x1a\x15\x4a\x5a\x2d\xe6\x23\x7a\x71\x5f\x25\x71\x94\x2c\x84\x5d\x6e\xe7\x79\xa1\xd6\xa3\x37\x6f\x38\x1a\xb1\x90\x66\xb4\xb8\xaf\x16\x28\x47\x0b\xcd\x36\x4b\x52\xcb\xe2\x78\x34\x6a\x67\x45\xa3\xdd\x6a\xd9\xd2\x43\x33\x07\x0b\xf1\x80\x12\xae\x37\xaa\xaa\x14\x7c\x68\x09\x05\xe9\xe4\x0e\x34\x06\xda\x75\x6f\x0d\xd9\xf4\x44\x28\x34\x28\xcd\x51\xe7\x30\xb1\xf5\xa8\x79\xf3\xb7\x58\x3b\x2d\xa9\x1d\x6a\x47\xa1\x25\x91\x83\x29\x96\xb8\x66\x43\x78\x5c\xa2\x46\x3a\x42\x56\x9f\x39\xa2\x53\xc8\x8a\x25\x58\x6a\x5a\x1a\xe7\x4a\x31\x8e\x9c\x68\x98\x01\x0d\x74\x85\x30\x67\xb6\x58\xe6\x6d\x90\x76\xc9\x2c\x14\x4c\x12\x7b\xce\x83\xd5\xc0\x01\x64\xc1\xe7\x80\x7e\x18\x90\xa2\xf2\x8c\xe6\x29\x03\xb5\x56\xda\xf3\x20\xe3\xe4\xc6\x2a\x02\x06\x1f\x29\x9d\xdf\x3e\x93\x9d\x8d\x92\x06\xf3\xb8\xdc\xca\x02\xd2\x02\x5e\xbe\xad\x04\x4a\x9b\x35\xde\xd3\xc2\x3e\x01\x15\x0b\x9f\x2c\x15\xdd\xe2\x93\x1d\x1e\x85\x35\x9d\xad\xd9\x66\xea\x39\x61\x26\xa4\x45\x5d\xb2\x02\xf7\x87\x0c\xd2\xe9\x8c\xc6\x59\x0f\x3d\x98\x0c\xf6\x71\xf4\xc0\x34\x35\x43\x44\x15\xd3\x06\xc6\xb0\x66\x2b\x6c\x15\x2b\x94\xe9\x33\x07\x59\x16\x47\x11\xe5\xcc\x40\xad\xde\x71\x38\x25\x97\x59\x1c\x65\x71\x54\x2a\x0d\x62\x08\x9a\xe6\xdc\x4f\xd4\x73\xa8\x7b\x6f\x69\x08\xca\x51\x8a\x9e\x26\xb7\xb7\x64\x59\xb2\x35\x26\xb3\x3c\xf5\x36\xc9\x9f\x28\xe1\x37\xb5\x22\xc0\x51\xe4\x93\xec\xf3\x5b\xae\x6d\xfe\x9e\x82\x29\xd3\xe4\x64\x3f\xc1\x0b\x4e\x57\xf3\x5a\x18\xaa\x28\x08\x6b\xa0\xf5\x71\x05\x2f\x1e\x93\x21\x81\x44\xad\x29\x35\x13\xf9\x40\x1c\x3b\x79\x47\x3e\x0f\x75\x9c\x53\xbb\xdb\xcc\x60\x0c\x6c\xb3\x41\xc9\xd3\xf6\xdd\x10\x44\x16\x47\x07\x1f\xaa\x0b\x44\xf0\xa7\x36\x5c\xa7\xe8\x30\x53\x96\x51\x87\x26\x88\xa3\xc8\x3c\x0a\xeb\x3b\xcf\x89\x2f\x72\x4e\x54\x30\x83\xe0\x98\x47\xd6\x6c\x97\x5c\x91\x20\x22\x93\x63\x28\xf2\xe7\xf4\xd3\x6d\x98\xa3\x06\x71\x20\x87\xee\x8e\xd5\x26\x8b\xa3\x2e\x41\x44\x11\xc7\x92\x6d\x2b\xeb\xec\x7b\xf3\xdd\x14\x87\xce\x0f\x3d\x0f\xcd\x18\xd2\x00\xd2\x14\xbd\xb8\x0f\x29\x75\xc9\x38\x97\x54\xba\xa8\xb5\x86\xdf\xc6\x54\xc3\xe3\x9a\xa2\xd6\x3e\xfb\x87\xb8\x79\xef\xc0\x0e\x49\x1e\xbb\xfd\xe2\x5c\xba\x28\x13\x8e\x5a\x7f\x74\x43\x8d\x46\x70\x2e\x6b\x7d\xae\xe9\xe5\xbd\x1f\x71\xc3\x6d\xcf\x32\x4c\xb4\x90\x32\xdb\x51\x10\x92\xe3\x13\x9a\xcc\x13\x82\x41\x6b\x02\x2b\x50\x15\xcc\x89\x91\x3f\x87\xed\xff\xa3\x00\x57\x7a\x70\x23\x5a\x37\x00\x84\x51\xcf\xa8\x24\x4a\xc3\xbe\xb9\x6c\xf0\x1e\x52\x87\x3b\x64\xf4\x75\x76\xe9\xf2\xa5\x43\x03\x37\xc5\xbd\x33\x5e\x48\x4b\xd1\x55\x43\x2d\x9d\xdd\xe5\x70\xf0\x14\x23\xf8\x93\xa3\x95\x40\x19\x77\xed\x0c\x11\x60\xd7\x21\x82\xbb\xce\x20\x09\x47\xda\xb8\x7b\x85\xd9\xef\xdb\xe5\xe0\xbb\xd5\xdb\xc2\xba\x67\x38\x1c\x3e\xe1\xee\x39\x83\x4d\xef\x66\x53\x37\x4f\xab\xfa\x78\x32\x23\xef\xa7\x1a\xb3\xee\xc0\x13\x53\xe0\x61\x50\x21\xe9\x36\xa7\xeb\xe4\x44\xaf\xec\xe0\x05\x0f\x33\x71\xe7\x02\x70\x8e\x28\x29\x91\xe0\x66\x2a\x88\x57\x04\x0f\x83\x41\x25\x31\x4d\x98\x45\x4e\xbf\x75\x98\xe2\xe7\x1d\x91\xb7\xbb\x2c\x65\x51\x70\x93\xc5\x27\x23\x08\x01\x34\x53\x75\x31\xc9\xf4\x5d\xc0\xd1\xe1\xea\x65\x81\xde\x6a\x33\xbd\x23\xb8\xb5\x86\x13\xd0\x75\x24\xe4\x16\x9b\xa8\xa8\x41\xaf\xc6\x10\xb6\x84\xfc\x0f\x61\x97\xb4\xf8\x85\x5e\xf5\xb1\xd4\xc2\x2f\xf8\x48\x32\xd2\x99\x50\xd7\xa4\x77\xae\x0b\xa2\x5a\x7e\xcd\xb9\xcb\xb9\x3f\xe5\xd7\x13\xa2\x7b\x37\x0d\xbb\x2f\xca\x7e\xa0\x6f\xa9\xf4\x19\x37\x1e\xcd\xc3\xf4\x6e\x96\x05\xee\xa1\x36\xc5\xb0\xeb\x7a\x76\x77\xb5\x9b\xee\xf7\xd4\x87\xa1\x6b\x0f\x87\xee\xd0\x04\xfa\xa6\x4b\x32\x72\xe2\xa6\x91\x57\xb8\xeb\xb7\x6f\xb4\x31\xd0\x5c\x8a\xd3\x59\xb3\x85\xe5\x3d\x80\xfd\x33\x3f\x68\xfb\xce\xd5\x20\x86\x61\xc2\x6a\x94\x84\x28\x5a\x91\x6d\x01\x87\x43\xd3\x37\x7f\xda\x78\x9c\x9c\x8f\x3f\x67\x40\xa2\xb0\xd0\x77\x6e\x26\x97\x7c\x6a\x4c\xb7\x3c\xef\xe1\x52\x66\xfc\x17\x43\x48\x4b\xb3\xe6\x37\xa9\x0a\x6f\x5e\x1d\x0e\xe0\xfc\x6c\xc2\x20\x1e\xcd\xd8\xb5\xe4\xae\xee\xd1\x25\x67\x4e\x21\x3a\x39\x9f\xa7\x73\xfe\xfe\x5b\xda\x40\xc9\x86\x71\x74\x14\x69\x76\x86\x0f\x7a\xe9\xcb\xbf\x6d\x51\xef\xd2\x2c\x27\x03\x7f\xd0\xa2\x9b\x1e\x61\xf8\xb7\x4e\x37\x26\xcf\xf3\xcc\x6b\x05\x66\xef\x7d\x57\x16\xaa\xaa\xb0\x68\xfe\x38\x20\x30\x6f\xfd\x2b\x07\x37\xb0\xcf\xb3\x11\xcb\xf2\xe3\xf2\x5c\x57\x15\xe9\xfe\x2c\x0b\x51\x6c\x9f\xc2\x4d\xdd\x2c\x91\x2b\xdc\xcd\x5e\xf6\x5c\xf9\x61\x21\x65\xd3\xdc\x10\xb7\xfe\xf2\x6a\xa7\x85\x7e\xf9\x6d\xb2\x31\x3b\xfd\x1f\x7b\x84\xce\x5f\x28\x5d\xb7\x73\x6a\x2e\xfc\x69\x5a\xad\x3f\xfc\x6b\x7c\xd4\x75\xb3\x7f\x7a\xd1\x45\xb6\xfd\xcb\x32\x6d\x68\x8e\x50\xfc\xa3\xed\xad\x3f\x46\xa3\xd1\x2f\xdf\xec\xe1\x40\xbb\xa9\x35\x94\x45\x1c\xd1\x7e\x1d\x9e\x5e\xe2\xc2\xde\xf5\xcb\x7c\xf9\x00\x9d\xeb\x21\x83\x74\x15\x5c\x37\x2b\x4d\xb3\xea\x67\xfd\x7d\x6a\xb0\xca\xdf\x39\x67\x1a\x92\xc5\x7d\x15\x06\x8e\xb4\xc7\xb0\xca\xff\x23\xd7\x4c\x9b\x25\xab\x3e\x7e\xfb\x9c\x3e\x64\x71\x33\x34\xab\xb0\x13\x37\x97\xd7\xb1\x35\xaa\x78\x30\xd7\x12\x46\x5d\xdc\xc6\xf0\x77\xf7\x3d\x95\x3e\x5c\x9e\xd1\xda\x5d\x74\x12\xdc\x0d\xdd\xe2\xd3\xd9\x7c\x67\x31\x35\xd9\xaf\xa1\x14\xb2\x06\x29\xce\x83\x9c\x48\xfb\x8f\xbf\xf7\xe2\xef\x65\x37\x15\xd9\x8f\x1d\x95\x95\x62\xb5\xab\xf2\xbc\xab\x0f\xa4\x76\xc1\x55\xf9\x13\xae\xe6\x4a\xd5\x85\x9c\x9f\xf7\xf4\x2f\xa5\x2a\x64\xf2\x82\xaf\xf9\x79\x5f\x14\x39\xb5\x21\x7d\xc0\x26\x7e\xab\x0f\x1e\x83\xa5\x4b\x85\x6e\xcc\xfd\x4a\x73\x9c\x44\x68\x7a\x08\xfd\x68\x77\xff\x44\x3b\xf5\xf4\xdf\x01\x00\xad\x0f\xff\x88\x66\x17\x00\x00")
+
+func templateFederationTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateFederationTmpl,
+		"template/federation.tmpl",
+	)
+}
+
+func templateFederationTmpl() (*asset, error) {
+	bytes, err := templateFederationTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/federation.tmpl", size: 5990, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateGlobalidTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x91\x41\x6f\x1a\x31\x10\x85\xcf\xf8\x57\x3c\xad\x38\x00\x2a\xde\x34\xb7\x56\xea\x21\x4a\x68\x85\x54\x71\x48\xf3\x07\x16\x7b\x96\xb5\x6a\xc6\x64\xec\x8d\x84\x2c\xff\xf7\x6a\x77\x81\x96\xde\xec\x79\x4f\xf3\xbd\x99\xc9\xb9\x5e\xa9\xe7\x70\x3a\x8b\x3b\x74\x09\x8f\x0f\x9f\xbf\xac\x4f\x42\x91\x38\xe1\x7b\x63\x68\x1f\xc2\x6f\x6c\xd9\x68\x3c\x79\x8f\xd1\x14\x31\xe8\xf2\x41\x56\xab\xb7\xce\x45\xc4\xd0\x8b\x21\x98\x60\x09\x2e\xc2\x3b\x43\x1c\xc9\xa2\x67\x4b\x82\xd4\x11\x9e\x4e\x8d\xe9\x08\x8f\xfa\xe1\xaa\xa2\x0d\x3d\x5b\xe5\x78\xd4\x7f\x6e\x9f\x37\xbb\x5f\x1b\xb4\xce\x13\x2e\x35\x09\x21\xc1\x3a\x21\x93\x82\x9c\x11\x5a\xa4\x7f\x60\x49\x88\xb4\x5a\xd5\xa5\x28\x95\x33\x2c\xb5\x8e\x09\xd5\xc1\x87\x7d\xe3\x9d\xad\x50\xca\x50\x4f\x74\x3c\xf9\x26\x11\xaa\x8e\x1a\x4b\x52\x61\x3e\x28\x2a\xe7\x35\x5c\x0b\x0e\x09\x8b\xae\x89\x6f\x37\x1b\x07\x4b\xd5\x72\xf0\xcc\x72\x46\xdb\x38\xff\xb7\x29\x84\xde\x7b\x27\x14\xc7\x7c\x83\xf3\xd6\xff\xc2\x5b\x83\xd8\x0e\x4f\xe5\x8e\xa7\x20\x09\x15\x71\x3a\x04\xed\x42\x6d\x02\x27\x71\xfb\x7a\x28\xbc\xfb\x6a\x4c\x2d\x0d\x1f\x08\x73\xc6\xd7\x6f\x98\xeb\x5d\xb0\x14\xaf\xe4\xb9\x90\x21\xf7\x41\x32\x6a\xac\x5f\xaf\xdf\x41\xaf\x6b\xfc\x18\x07\xdd\xbe\x40\x28\xf5\xc2\x53\xa4\x57\xf2\xcd\x19\x53\x5c\x38\x3b\xed\x8c\x90\x33\xe6\xac\x77\xcd\x91\x50\x8a\x56\xb3\xb6\x67\x83\xc5\x1d\xa4\x14\xac\xee\x6c\xcb\x1b\x61\xb1\x44\x4c\xe2\xf8\x80\xac\x66\xb3\x09\x87\x69\x0a\xbd\xe1\xe1\xe8\x37\x67\x75\xd7\xa2\xfa\x84\xff\x18\x7a\xfb\xb2\x54\xb3\xf1\x30\x97\x3d\xe5\x0c\x62\x8b\x52\xd4\x9f\x01\x00\xbd\x6f\xab\x27\x89\x02\x00\x00")
+
+func templateGlobalidTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateGlobalidTmpl,
+		"template/globalid.tmpl",
+	)
+}
+
+func templateGlobalidTmpl() (*asset, error) {
+	bytes, err := templateGlobalidTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/globalid.tmpl", size: 649, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateMutation_inputTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\x5f\x6f\xe3\xb8\x11\x7f\xb6\x3e\xc5\xd4\x70\x01\xc9\x48\x98\xf4\x8a\x2b\xd0\x1c\x52\x60\x9b\xe4\x00\x03\x77\xc9\x5e\xb3\x87\x3e\x04\x41\x8f\x91\xc6\x36\x2f\x12\xa5\x90\x54\x36\xae\xab\xef\x5e\x0c\x45\xc9\xfa\xeb\x38\xd9\xdc\x93\x65\x72\x38\xfc\xcd\x70\xe6\x37\xd4\x68\xbb\x3d\x99\x7b\x17\x69\xb6\x51\x62\xb5\x36\xf0\xdd\xe9\x5f\xfe\x7e\x9c\x29\xd4\x28\x0d\xfc\xc8\x43\x7c\x48\xd3\x47\x58\xc8\x90\xc1\xa7\x38\x06\x2b\xa4\x81\xe6\xd5\x33\x46\xcc\xfb\xb2\x16\x1a\x74\x9a\xab\x10\x21\x4c\x23\x04\xa1\x21\x16\x21\x4a\x8d\x11\xe4\x32\x42\x05\x66\x8d\xf0\x29\xe3\xe1\x1a\xe1\x3b\x76\x5a\xcd\xc2\x32\xcd\x65\xe4\x09\x69\xe7\x7f\x5a\x5c\x5c\x5d\xdf\x5e\xc1\x52\xc4\x08\x6e\x4c\xa5\xa9\x81\x48\x28\x0c\x4d\xaa\x36\x90\x2e\xc1\x34\x36\x33\x0a\x91\x79\xf3\x93\xa2\xf0\xbc\xed\x16\x22\x5c\x0a\x89\x30\x4d\x72\xc3\x8d\x48\xe5\x7f\x84\xcc\x72\x33\x85\xa2\xa0\x59\x83\x49\x16\x73\x83\x30\x5d\x23\x8f\x50\x4d\x61\x06\x6e\xe1\x4c\x24\x59\xaa\x8c\x86\xb3\x73\x88\x44\x68\x68\x7c\xbb\x3d\x06\xc5\xe5\x0a\x61\x26\x69\x7c\xc6\xae\xd3\x08\x35\x4d\x4d\x1a\x73\x4b\x9a\xe3\x59\x86\x32\x82\x99\x64\x3f\x0a\x8c\x23\x4d\x4f\x8b\x4b\x2b\x6a\x65\xbf\x0a\xb3\x86\xd9\x92\x7d\xd9\x64\xc8\x3e\x3f\xae\x3e\x73\xb3\x2e\x67\xed\xb4\x58\x82\x44\x60\xe0\x67\x4a\x48\x03\x33\x76\x91\xca\xa5\x58\xb1\xcf\x3c\x7c\xe4\x2b\x84\xe9\xc9\x94\x34\xba\xbf\x81\x5b\x69\x97\xd6\xc8\xcf\x41\xa3\xd9\x19\xc2\xc0\xa8\x1c\x1b\x7b\x10\xbe\x1a\x4f\xf5\xa7\xf1\xdc\x78\xf4\x4a\x25\xe0\x7b\x93\x7d\x4e\x98\x4c\xb7\xdb\x3e\xd6\xa2\x38\xd9\x6e\x1b\x68\xa1\x28\xa6\xad\x8d\x9a\x3a\x33\xf2\xc3\xd9\x39\x3c\xe2\x46\xef\xb0\xef\x94\xdb\xf9\xae\x82\x80\xce\xfa\x18\xc4\x12\xd6\x5c\x7f\xa9\x0f\xf5\xeb\x1a\x15\x36\x4e\x7c\x72\x72\x02\x0f\x79\xfc\x78\xb1\xce\xe5\xe3\xad\xf8\xaf\x8d\x4a\x0a\xb3\x84\xbf\x80\xcc\x93\x07\x54\x14\x4e\x22\xd2\x60\xd6\xdc\x00\x57\x08\x0f\x14\x8e\x60\x52\xe0\xa0\x85\x5c\xc5\x08\x4f\x39\x56\x61\x87\xb5\x4a\xa8\x02\x4c\x1f\x01\xd7\x10\x71\xc3\x1f\xb8\x46\x0a\xfa\x44\x18\x1b\xb7\xbb\x0d\x4a\x0d\x19\x57\x3c\x41\x83\x4a\x33\x6f\x12\xa6\x52\x9b\x0e\xb8\x73\xf8\xfe\xf4\xd4\xb3\x5b\xa0\x52\x57\x49\x66\x36\xff\x26\x8b\x16\x64\x10\x41\x57\x68\x72\x25\x31\x82\x87\x8d\xdd\xa1\x0d\x04\x96\xa9\x02\xeb\x02\xb0\x2e\x70\x46\x25\xdc\x84\x6b\xe0\x71\x6c\x15\x4b\x0a\x60\x0b\x39\x5c\x73\xb9\x12\x72\x45\x53\xe5\x30\x41\xe5\x60\x36\x19\x42\x92\x13\x3a\x04\x7c\xc9\x62\x11\x0a\x03\xbe\x60\xc8\x20\x27\x97\xc0\xaf\x59\x44\xee\x4e\x15\x5c\x62\x8c\x06\x03\xe6\x4d\x9e\xb9\x1a\x02\x7d\x4e\x83\xa9\xd2\xec\x1a\xbf\xfa\x53\x94\xe6\x0c\x90\x44\x9a\x38\xa7\x81\xd7\x38\x5a\x6f\xbb\x1d\x0b\x37\x0a\x87\x50\x21\xed\x7d\x76\x0e\x65\xa2\x4c\x2f\xec\x80\x4d\x8e\x6b\x9e\x20\x4c\x17\xcd\x84\x9f\x3d\xe4\x22\x26\xf2\x21\x4d\x92\x95\xc2\x56\xae\x28\xbc\x93\x13\x68\xa8\x2c\x0a\x50\xe8\x18\x4f\x03\xaf\xfd\x5a\x82\xb4\xce\xb5\x92\xe4\x81\xed\x16\xb2\x38\x57\x3c\xae\xb7\xfd\x1f\xc4\xe9\x57\x54\x50\x14\xcc\xb3\x1e\x6c\x6b\xd6\x46\xe5\xa1\x81\x6d\x9f\x37\x56\x4f\x71\x4d\x18\xbb\xec\x24\x3a\x48\x0d\xf1\xc5\x42\x5f\x45\x2b\xb4\x22\x75\x2a\xd3\xf8\xad\xd5\x58\x8d\x93\x21\x62\x09\xa9\xa2\xa9\x9b\x8c\x02\x93\xc0\x2d\xd9\x25\x2e\x79\x1e\x13\x9b\xcd\xb7\x5b\xe7\x63\x82\x56\x12\x11\x41\xfb\xed\x77\x9d\xca\x33\x4a\xe4\x90\x27\x68\x17\x39\x07\xbd\xae\xf3\x28\x4d\x04\x71\xaa\xd9\xd4\xca\xa7\xbf\x8d\x13\x4c\xd3\x78\x74\xc6\x93\x75\x3d\xdb\x67\xc8\x7e\x95\xe2\xa9\xc1\x5e\x90\x71\x1d\xd2\xf6\x58\xa1\x5b\x5c\x3a\xa3\x67\xb8\x43\xd7\x35\x13\xad\x99\x6c\x71\x39\x6e\x6e\x43\xe1\x90\xbe\xbd\x26\xc6\xba\x8f\xd0\xa7\x1c\xc9\x63\xae\x2a\xd5\x44\xd6\x8b\x4b\x0d\x77\xf7\x87\x21\x1a\x53\xb0\x43\xb2\xcf\xc7\x85\x47\x71\xfd\x33\x05\x2f\x02\xcf\xb2\x58\x20\x11\x41\x37\x20\x53\x59\x0f\x56\x29\x52\x14\xe0\x1e\x99\xb7\xcc\x65\x08\xbe\x80\x79\x6b\x55\xe0\xf4\xfa\x09\xcc\xdb\x2b\x83\x8f\x0a\xed\xe3\xd7\x42\x8e\xc4\x26\x62\x09\xcf\xb4\x83\x60\x43\xc9\xf0\x03\x3c\xc3\x9f\xce\x41\x8a\x98\x50\x91\x7c\xe2\xe4\x7e\x76\x39\x7d\x8b\x14\xbd\xfe\xfc\x39\xb0\xf3\xf5\xde\x8d\x03\x1d\x5b\x33\xbc\x65\xe0\x4d\xda\x27\xd2\xfa\xf3\x31\x29\x70\x0c\x33\xc9\x93\x06\xf7\xf9\xed\x9c\x08\x60\xba\xb8\x9c\x36\xc4\x7b\xb1\x3c\xe0\x3b\xab\x71\xdc\x65\xf8\x0e\x97\xf5\xd6\xb4\x76\x1a\xf1\x54\xac\x0f\x30\xb4\x9f\x19\xd6\x66\x5d\x19\x2d\xca\x5a\xde\x37\x2e\x46\xe9\x8b\x48\x07\xf0\x0f\x38\x85\xed\x20\xd6\x4f\x51\x64\xb1\x46\x9a\x31\x66\x41\x16\x5e\x1b\x68\xe3\xb9\x4c\xb2\x5b\x34\xb6\xd8\xb4\xd2\xcc\x96\x55\x3c\xa6\x9b\x98\x90\xe3\x89\xe7\x06\x3a\x09\x17\xf6\xf3\xaa\xda\xc4\x17\x6d\x45\x41\x57\x94\xec\x12\xcc\x25\x68\x18\x78\x93\xf2\xb6\x00\xa1\xe7\x2e\xb8\x79\x59\xb6\x6b\xaf\x4e\xcb\x3a\x3e\x58\x3a\x5d\x69\x74\x4b\x0e\x28\x8d\x56\xf2\x0d\xa5\x71\xa7\xf9\x9d\xa5\xd1\x4f\x55\x97\x43\xa8\x5a\x26\x84\xed\x21\xae\x6f\xc8\x43\xc9\x0a\xf3\x43\x2b\x60\x87\x72\xeb\xac\x5a\xf6\xb2\xaa\xcd\x15\x17\x31\x72\x32\x18\x1e\xd2\x34\xae\xf4\x87\x34\x38\x84\x67\x68\x97\x2a\xe8\x5a\x7f\x3e\x86\x47\x06\x4b\xe9\xfc\xed\xd5\x72\xcc\x39\x7d\xca\x69\x67\xda\x5e\xe7\x68\x7a\x2d\xec\x4b\x7f\x7f\x90\x9b\x9a\x24\xd2\xd4\x51\xe6\xf6\x6b\x05\x98\x47\xd1\x10\x00\x5a\xfc\xd7\xc1\xed\x9b\x52\xff\xc2\x24\x7d\xc6\x03\x76\x51\x56\x70\x68\x23\xa7\xe2\x6f\xfd\xbd\x46\x62\x60\x6f\xa9\xdf\x25\x58\xa3\xd4\x4b\x56\xe6\xbc\x3b\xc3\x91\x7a\x5f\x2f\xed\xd6\xfb\xce\x72\x5b\xf4\x05\x4b\x9c\x4c\x6d\x88\x1f\x04\xad\x6b\xc8\x8d\x7c\x23\xbc\x1b\xf9\x56\x84\x37\xb2\x07\xf2\x46\x1e\x88\x73\x4c\x73\xd2\xb1\xbd\x5a\xd6\xd4\xfa\xc7\x50\xd6\x18\xc9\x50\x7d\x63\x23\x4c\x33\x72\xd3\xa9\xe6\xfd\x6e\xd9\xae\x12\xe7\xad\x77\xa9\xfd\x57\xa9\xc2\x6b\xab\xff\x18\xc6\x1a\xe3\x95\xda\x1f\xd8\xb3\xb7\xed\x0f\x7c\xaf\x3f\x06\xa8\x72\xc4\x23\xf8\x9a\x47\x76\xe4\xd4\xbd\xa6\xec\x96\x12\xd7\x7c\xd3\x7d\x65\x8f\xee\x9a\xa1\x0e\x52\x5f\x4b\xff\x51\x37\xa2\x1e\x01\xb8\x81\x4e\xc2\xe7\xed\xa4\xae\x73\xaf\x73\x2d\xaa\xb5\x05\x83\xf2\xad\xbb\x51\xbe\xbb\x1b\xe5\x1f\x86\xfb\x38\x95\xaf\x61\xbf\x91\x6f\x86\x7f\x23\x87\x2c\xb8\x91\x83\x46\x94\xcd\x8d\x1f\x55\x9a\x58\x5b\xb4\xbb\x63\xd6\x9c\x3b\x7a\x2d\x73\xbd\x2d\x58\x89\x67\x74\x77\x3a\xed\x5a\x3d\x75\x13\x8c\x5a\x4d\x54\x6d\x4a\x9d\x0c\xae\x53\x83\x65\x7f\xa9\xd3\x84\xa2\x1e\x1a\xbe\x64\x18\x1a\xb4\x6d\x34\x95\x93\x4a\xe0\x60\x14\x97\x9a\x87\x14\xe6\x47\xa0\x73\xea\x49\x69\xd2\x48\x3b\x37\xe6\x74\x85\x06\xa5\x59\x3d\xc5\xec\xcb\x6e\x0a\x95\x6d\x5c\x99\x35\x6e\xdc\xe1\x40\x92\xc7\x46\x64\x31\x96\x0d\xac\xce\x35\xda\x19\x5a\x14\x17\xb1\x40\x69\x82\x9e\x87\xfc\xd0\xbc\x40\x98\x4a\x83\x2f\x86\x9a\x98\xf4\x7b\x54\x39\xe0\xee\xbe\xfb\xf2\xeb\xdf\xdd\xb7\xf5\x1e\x95\xdd\x2d\x5b\x5f\xa8\x3f\x24\x28\xa7\x4b\x8a\x73\x4a\x88\x85\x28\x25\xed\xbf\x3b\x71\x0f\xe7\x0d\xe6\x70\xe7\x27\x45\x7c\x04\xcb\xc4\xb0\x2b\x52\xb6\x74\x6d\xb2\x44\x68\xed\xee\xd3\x3b\x0c\xc0\xe9\xad\x22\xc2\x17\xf8\x73\x34\x3d\x02\x41\x79\x5f\x78\x13\x97\x8a\x62\x09\xf8\x04\x33\x76\x6b\x52\xc5\x57\x8e\xb2\xa6\xfa\x29\x76\xef\x47\x2e\x3c\x2d\x3b\x24\xfc\x11\x2b\x7b\xdc\x38\xdd\x3b\x4a\x6a\xb0\x68\x03\x52\x3e\x6e\x55\xad\xcd\x5a\x05\xa1\xeb\xad\xf9\x01\xab\x63\x7b\x5e\x9b\xed\x70\x56\x11\x5b\x09\xff\x33\x8f\x1f\xfd\x4a\x0f\x91\x0c\xbb\xe5\xcf\x48\xa7\x12\x78\x1d\xd2\xa4\x7f\x27\x73\xa0\x15\x2e\x06\xb5\x6d\xd7\xea\x3c\xa3\x8e\x31\x46\x90\xca\x78\x43\x8d\xd1\xdb\x5f\x7e\x02\xed\x3c\x00\xf6\xbb\xc0\x64\x62\xa3\xa3\x6b\x76\xe3\x18\x0f\xb7\x9a\x34\xd9\x63\x87\xb3\x57\x8d\x6e\x5a\x63\xc3\x80\x96\xb5\x4a\x47\x33\x02\x50\xa9\x9a\xc5\x69\x17\xe7\x58\x7a\x6c\x7b\xcf\xb5\x70\xa5\x88\xbb\x0c\x7c\x50\x4f\x9c\x4c\xb7\x83\xbb\x37\xc1\xca\x15\xd3\x5d\xe7\xd6\x86\x8c\xed\x18\x97\xe4\x65\x67\x5a\xf4\x38\x48\x86\x7b\x69\xa6\xd1\x8e\xae\xe9\xc6\x6e\xd1\x68\x06\x1f\x01\x97\x91\x6b\x74\xeb\x06\xbf\x46\x2e\xc3\xe1\x5a\xc4\x56\xa4\xd7\x46\x2e\xe3\x41\xe1\xef\x96\x7a\xac\x1e\xab\xbc\x7e\x33\xa5\x3e\xf7\x5e\x7c\x55\xd3\x3b\x4a\xe5\xae\xf3\x1d\x6f\x5a\x5d\x6f\x06\x5f\xd6\x68\xcb\x77\xe3\x83\x80\x6d\xb1\x53\xb2\x5a\x8c\x16\x07\xb5\xfc\x05\x75\xea\x71\x99\x2a\x6c\x18\x72\x04\x5f\xd7\x22\x5c\x53\x3b\x1f\x5f\x30\xcc\xc9\x34\x21\x21\xa4\xaf\x00\xda\x7d\x8d\xd8\x81\x37\xe4\x19\xab\x41\x98\x72\xc9\x6b\xcc\x1a\x8b\xc7\x72\xbb\x94\xd6\x76\xd8\x99\x79\x93\xfd\x0c\xd9\x38\xec\x61\x72\x2c\x1d\x3e\xdf\x05\x11\x91\x86\xf5\x7f\xb7\x90\xed\x65\xcb\x49\x56\x27\x91\xd5\xc8\x3e\xdb\x0b\xd9\x40\x8e\xf4\x53\x84\x32\x44\x2c\x21\x1b\xe5\xd2\xfe\x77\x88\x6a\x55\xa4\xeb\x6d\x43\xf6\x0b\x7d\x95\xf1\x03\x66\xc5\xfc\x2c\x60\x8b\x4b\x5d\x65\xeb\xc1\x40\x5e\x63\x96\x53\x47\x2e\xd1\x8e\x59\xfa\xb7\x2f\x7b\xf8\x94\x8e\x22\xd2\x8e\x2a\x48\xc8\x0e\x53\xcf\xaa\xfd\x99\xc8\xa2\x71\x6b\xce\xcb\xc0\xb9\x3b\x6b\x89\xdc\xd7\x44\x42\x81\x6a\xd5\xde\xed\x14\x9e\xdd\x37\xe8\xc8\x7a\xa2\x3c\xf5\xda\x15\xdd\x8f\x77\x6c\x71\xb9\x70\x8b\x89\xa4\x1b\x64\x67\x0f\x3e\x60\x57\x2f\x18\x5a\xcf\xfd\xd0\xf3\xda\x80\xdb\xac\xdf\x26\x2e\xad\x07\xce\x83\x66\x27\x07\x22\x71\xed\x85\x3e\xed\x85\x69\x1c\xa3\xbd\x50\x54\xcd\xc1\xc9\xe4\xa2\x1c\xb3\x6f\x5e\xf6\xa4\x8f\x60\xda\x3a\xae\x69\x43\xa1\x63\x55\x5a\xf7\x29\x8e\xdf\xcf\xe2\x50\x7d\x1a\xf6\x1d\x6f\x3b\xc3\xdd\x9d\x7a\x84\xd7\x1d\xf7\x96\xdf\xd7\xac\x2f\x20\xb2\xcf\xfa\x7d\x34\x3b\x4a\xb1\x15\xcb\x34\x3e\x87\x96\x1b\xbd\x8b\x70\x4b\x90\x8e\x6c\xad\xea\x6f\x20\xdc\xd2\xf8\x57\x29\xab\xe1\xa3\x83\x29\x2b\x00\x5f\x48\xf3\x4d\x84\x74\x7a\x08\x1d\x9d\xee\x21\x23\x27\x12\xb2\xd2\x80\x26\x11\xd5\xf9\x44\x57\xbb\x46\x30\xd6\x5f\xa0\xbc\xed\x16\x50\x46\x50\x14\xde\xff\x07\x00\x11\x41\x4b\xd7\xb8\x21\x00\x00")
+
+func templateMutation_inputTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateMutation_inputTmpl,
+		"template/mutation_input.tmpl",
+	)
+}
+
+func templateMutation_inputTmpl() (*asset, error) {
+	bytes, err := templateMutation_inputTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/mutation_input.tmpl", size: 8632, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateNodeTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x7c\x5f\x73\xe3\xb8\x91\xf8\x33\xf5\x29\x3a\xfa\x79\x5d\xa4\x4b\x43\xce\xec\xef\xea\xaa\xe2\x8d\x52\x35\x19\xcf\xe4\x54\xb7\x37\xbb\x99\x99\x4d\x1e\x1c\xd7\x84\x26\x21\x09\x19\x8a\x94\x01\x48\xb6\xcf\xab\xef\x7e\xd5\x8d\x06\x08\x52\x94\x2d\xcf\x66\x37\x4f\xb6\x08\xa0\xd1\xdd\xe8\xff\x0d\xf2\xe1\x21\x3b\x1b\xbd\x69\xd6\xf7\x4a\x2e\x96\x06\xbe\x7d\xf9\xea\xf7\x2f\xd6\x4a\x68\x51\x1b\x78\x97\x17\xe2\xba\x69\xbe\xc0\xac\x2e\x52\x78\x5d\x55\x40\x93\x34\xe0\xb8\xda\x8a\x32\x1d\x7d\x5a\x4a\x0d\xba\xd9\xa8\x42\x40\xd1\x94\x02\xa4\x86\x4a\x16\xa2\xd6\xa2\x84\x4d\x5d\x0a\x05\x66\x29\xe0\xf5\x3a\x2f\x96\x02\xbe\x4d\x5f\xba\x51\x98\x37\x9b\xba\x1c\xc9\x9a\xc6\xbf\x9f\xbd\x79\xfb\xfe\xe3\x5b\x98\xcb\x4a\x00\x3f\x53\x4d\x63\xa0\x94\x4a\x14\xa6\x51\xf7\xd0\xcc\xc1\x04\x9b\x19\x25\x44\x3a\x3a\xcb\x76\xbb\xd1\xe8\xe1\x01\x4a\x31\x97\xb5\x80\x71\xdd\x94\x62\x0c\xbb\x1d\x3e\x3b\x59\x7f\x59\xc0\xf9\x14\xae\x73\x2d\xe0\x24\x7d\xd3\xd4\x73\xb9\x48\x7f\xcc\x8b\x2f\xf9\x42\xf0\x1c\x23\x56\xeb\x2a\x37\x02\xc6\x4b\x91\x97\x42\x8d\xe1\x04\x47\x46\xc8\x16\xf8\xb3\x12\xab\x4a\xd6\x80\x40\x35\xe4\x4a\x20\xe1\x4d\xb5\x15\x25\x5c\xdf\x23\x8e\x52\xc1\x56\x28\x23\xee\xa0\xca\xaf\x45\xa5\x27\x90\xd7\x25\x7c\xfc\xcb\xf7\xbc\xc4\xcf\x32\xf9\x75\x25\x34\x10\xba\x88\xd9\x82\x21\x9f\x4f\x41\xdc\xc0\x49\xfa\xd1\x34\x2a\x5f\x88\xf4\x7d\xbe\x12\x30\xe6\x51\x4f\x07\xad\x46\x4a\xc6\x9f\xf0\x3f\xf7\x5c\xce\x5b\x40\xbb\xdd\x28\x6a\xa7\x4e\x61\xfc\x3d\x22\xe4\x66\x8a\xba\x6c\xa9\xfa\x20\xaa\xfc\x1e\x16\x55\x73\x9d\x57\x20\x4b\x46\xda\xdc\xaf\x85\x86\x5b\x69\x96\x50\xca\xf9\x5c\x28\x14\x00\xc9\xcf\x27\x5d\xe2\x37\x5a\xd6\x0b\xd0\x46\xe1\x1f\x59\x86\x74\x59\xa8\xe7\x53\x58\xe6\xfa\x93\xe7\xad\xdd\x4c\x96\x0e\x9f\x13\x59\x7e\xba\x5f\x13\x49\xc8\xa8\xd9\x05\xfd\x3a\x49\xdf\x13\xd7\x4e\x52\x7e\xc0\x93\x57\xf2\x4e\x94\x38\xd7\x81\x0f\xc8\xf7\x0f\xa2\x00\xec\x94\x71\x0b\x80\x30\x07\x1e\x1e\x40\xe5\xf5\x42\xc0\x49\x4d\x00\x79\x47\x5e\x2f\xe7\x50\xe3\x50\x3a\xbb\x48\x71\x6d\xfa\x91\xc0\x38\xb8\xee\x27\xce\x8e\x5a\xc4\xa6\x60\xd4\x46\x38\x1c\xda\x8d\xfc\x7f\xd9\x19\x7c\x5a\x0a\xd8\xd4\x72\x2b\x94\xce\xab\x17\xb2\xb4\x58\x58\x99\xda\xa0\xaa\xcc\x1b\xc5\xfc\xc5\x1d\x50\x25\xea\xcd\x4a\x28\x59\x74\xf9\x4b\xc7\xcb\xdc\x39\x9f\x7a\x82\x5b\x86\x58\x5e\x31\x2e\x9d\xe9\x48\xed\xec\x62\x90\x25\x27\x2c\x9e\xe7\x53\x12\x85\xb8\x6e\x8c\x63\x6d\xe2\x7e\x59\x91\x4c\x3a\x28\xa4\xef\x19\x47\x94\x2d\xb9\x5a\x37\xca\x40\x8c\x3b\xbf\x38\xc8\xe5\x68\xfc\xf0\x30\xa4\x8b\x19\x3e\xae\x83\x07\x63\x0b\x87\x91\xa4\xff\x49\x38\x4f\xd6\x3c\xa5\x25\x3f\xfd\xf1\xcb\xe2\xc7\xdc\x2c\x83\x0d\xd6\x07\xe0\x24\x7d\x3c\xfb\x3a\x14\x8d\x45\x6d\x16\x4d\x2a\x9b\x4c\xd4\x26\x2b\x65\x5e\x89\xc2\x64\x3c\x65\xfc\xd4\x84\x6c\xa1\xf2\xf5\x32\x2b\x75\x95\x7d\xfe\xfc\x9c\xd9\x0b\x87\x67\xa5\xc5\x63\x88\xe8\x9b\xea\x30\x58\x7d\x53\x65\xba\x58\x8a\x55\xde\xa5\x3a\x98\x5e\x34\xb5\x51\xf2\x1a\x61\x2e\x08\xd4\x78\x21\xcd\x72\x73\x9d\x16\xcd\x2a\xfb\xfd\xef\x4b\xa1\xe5\xa2\xd6\xd9\xe2\xa6\x5a\x08\xc6\x6f\x6f\xda\x32\xd7\x4b\x59\x34\x6a\x9d\x2d\x9a\x17\xab\x4d\x65\xa4\x50\xaa\x51\x34\xab\xa9\xf2\x7a\x91\x36\x6a\x91\xdd\x65\xfa\xbe\x2e\x32\x2d\x56\xf9\x7a\xd9\x28\x31\x1e\x25\xa3\x51\x96\x01\x6a\x9c\x82\x5b\x95\xaf\x35\x1a\x47\xb4\xce\xb2\xa0\xa7\xb0\x12\x66\xd9\x94\xe9\x08\x6d\x0e\xcf\x93\xb5\x11\x6a\x9e\x17\x02\x1e\x46\x11\x3e\x8a\x91\x02\x71\x67\x50\x84\x8c\xb8\x33\x09\xc4\x67\xf8\x7c\x02\x84\x44\x32\xda\xf9\x5d\x9c\x23\x21\x2a\x02\xa8\x68\x1d\x36\x85\x41\x88\xb3\x0b\x88\x00\x20\xb0\x1d\xbb\x1d\xfc\xe3\x9f\xba\xa9\xcf\xc7\xb2\x9c\x34\x2b\x89\x0e\xc2\xdc\x8f\xff\x91\x65\x64\xd3\x41\x96\xe9\x28\xa2\x99\xe0\x2c\x20\xb8\x15\xb8\x43\xb8\x06\x00\xdc\x32\x1c\x4a\x47\xd1\x3b\x29\xaa\x52\xc3\xe5\xd5\x19\xfd\xe7\x16\xce\xf1\x87\xee\x2c\x75\x0b\xed\x50\x3a\x8a\xde\x96\x68\x30\x70\x29\xfe\xe7\xf7\x14\xf8\xb8\xbb\xa9\x5b\x4a\x43\x29\x33\xc4\xee\xd7\xcc\x21\xa7\x41\x66\x87\x7d\xda\xf2\xc3\x12\xc6\x74\x1d\xa4\x2a\xcb\x2c\x5a\x8e\x2a\xf2\x5a\xbd\x55\x75\xbe\x3a\xb4\x0a\x87\x20\xce\x35\x9e\x8f\xdd\x3a\x49\x47\xd1\x5f\xf3\x6a\x23\x7a\x40\xb6\xf8\xac\x03\x25\xcb\x78\x8a\x9c\x4b\x51\x02\x4d\x70\x24\x22\x5f\x34\x5c\x0b\x73\x2b\x44\x0d\xe6\xb6\x21\x4a\x35\x93\x8a\xa3\x7d\x4a\x9f\x3c\xc0\x2c\x23\x2e\x76\x08\xed\x2f\xda\xa3\xd4\x2d\xc2\x81\x14\x65\x8c\x8e\xed\x80\x8c\x1d\x38\x3b\xb4\xfa\xf1\xed\x52\x28\x61\xa3\x1e\xc2\x62\xdd\xc8\xda\x80\x69\x12\xa2\x98\x42\x94\xaa\x69\xd6\xd0\x6c\x85\x82\xbc\xaa\x08\x4d\x4d\x36\x3c\x2f\x4b\x90\xab\x75\x25\x56\xe8\xc7\x51\x0b\x58\x23\x58\x9d\x52\xef\x53\x1e\xf1\x87\x27\x4a\x14\x02\x9d\x16\x8d\xd5\xe9\x07\xf7\xd3\x8d\x23\x56\xe4\x36\x16\x37\x15\xf2\x57\xa3\xc5\xb7\x83\xd9\x19\x7c\x14\xb5\x96\x46\x6e\x05\x61\xa4\xbf\xc8\xf5\x1a\x3d\x1d\x4a\x8e\xf5\x7c\x48\xb9\xc1\x47\xaa\x59\x91\x2d\x08\x25\xde\x22\x48\x7e\x96\x97\x9c\x4f\xa1\x94\x85\x71\xbb\x33\xe2\x73\xde\x9f\x55\x8b\x11\x70\xee\x1c\x7d\xd7\x3c\x6d\x31\xd9\xed\x02\x80\x53\xd0\xc2\xf8\x5f\x27\x73\x1b\x7e\xb1\x37\x6f\x3d\x64\xe0\xd6\xa3\xf9\xa6\x2e\x20\xee\xf0\x66\xb7\x83\x33\x7c\x50\xdb\xe5\xbb\x5d\x42\x56\x26\x2e\xcc\x1d\xec\x1b\x2b\x22\xb1\xb5\x58\x6c\xb5\x50\xf7\x22\x1a\x9a\xc2\x29\x0e\xe2\xef\xd6\x41\xb5\x51\x4e\x14\x45\xb3\x8b\x73\xe8\x21\x90\xfe\x99\x66\xcc\x2e\xe2\x64\xe2\x16\x92\x27\x79\x4e\x44\x63\x21\xcf\x57\x26\xfd\xb8\x56\xb2\x36\x7d\x2a\xd3\xd9\x45\x0f\xfa\x23\x08\xcd\x2e\xda\xa9\xcc\xba\x88\x74\xee\x1c\xc6\x1d\x66\x8d\x69\x9e\x3d\xbc\x73\x58\xe5\x5f\x44\xec\xac\xe3\x04\x5e\x4e\x10\x70\x25\x6a\x7f\x48\xbb\x9d\xc5\x81\xa4\xad\x9d\x8f\x3f\xdb\xb9\x56\x2c\x79\xea\x6e\xd4\x32\xd2\x03\x41\x10\xdb\x5c\xc1\xf5\x66\x0e\x97\x57\xd7\xf7\x46\x38\x6c\x43\xa9\x3a\xa9\x53\x96\x2a\xa6\x94\xe1\x2c\x73\xfd\xdf\xe2\x7e\x4f\x70\x78\x52\x24\xe7\x08\x97\x1c\x12\x4c\x01\x8d\x44\xfa\x3f\xb9\xd2\xcb\xbc\xda\x63\xe9\xc3\x03\xac\x73\x5d\xe4\x55\x00\x24\xf9\x8e\x56\xfe\x6e\x0a\xb5\xac\x48\x30\x10\xa8\x12\x66\xa3\x6a\x7c\x44\x80\xed\x53\xde\x10\x05\xc7\x61\x3a\x85\x7c\xbd\x16\x75\x19\x07\x0f\x27\x70\x4a\xa3\x0e\x96\x3d\x07\x7b\x10\xf3\x94\x4d\x92\x3d\x88\x28\x8a\x10\x8d\x76\x94\x91\xf2\xa3\x64\xa6\xcf\xd9\x72\xc6\xd7\x9b\x79\xc2\x23\xbb\x64\x14\xed\x9d\x78\xe7\xd7\xde\x0f\x39\x6f\xcf\xca\xcd\x76\x4a\xcb\x21\x12\x8f\x44\x55\x93\x97\xa2\x24\xd2\xf1\x64\xfa\x7c\x44\x62\x51\x06\x34\x6a\x9d\x45\x44\xce\x07\xd8\x38\xc4\xc5\x7d\x44\x43\x41\x90\x13\x38\xa1\x44\xa5\x83\x29\xe9\x6a\x4a\x3b\x5e\x22\x2e\x12\x76\xbb\x2b\x98\xc2\x29\x3e\xe2\xbd\x02\x69\x17\xc4\xe4\x3e\x2f\xf1\xa7\x9f\xd0\x1d\xeb\x88\x5b\x9f\x17\xfe\xb9\x2c\xfb\x51\x78\x14\x1d\x42\x2e\x9d\x5d\x68\x27\x92\x43\x52\x88\x1a\x0f\xe3\xbf\x6c\x84\xba\x1f\x43\xec\x84\xd2\x66\xa4\x09\xec\x76\x31\xfa\x68\x04\x1e\x45\x1f\x05\x86\xa2\x71\x40\x58\x1b\xb6\x5b\x89\x9b\x5d\xb4\xb3\x03\x11\xef\x1b\x9d\xe0\xb4\xf6\x8c\x4a\x14\xfd\x1b\x70\xfd\x58\xe4\x35\xe2\x34\x81\xd3\x43\x3c\x0c\xd1\xf5\xf2\x72\x40\xda\x1e\x55\xda\x61\xe3\x1e\x45\x98\x09\xfe\x73\x02\x92\x32\x5e\x6b\x8d\x0e\xe1\xe2\xf7\x39\x78\xe2\x97\xff\x44\xa9\xb4\xc1\x7e\xfa\xb6\xc6\x0a\x8d\x77\x15\x83\x92\x09\xb2\x64\x0a\xa3\xdd\x20\xa5\x7b\xe7\x84\x0f\x4e\x2c\xb6\xe3\x3a\x9d\x5d\x8c\x61\xf7\x04\x85\x6e\xc5\xd4\x9d\xe4\x30\x7e\x7f\x1f\x8f\xbb\xf8\x8d\xff\x3e\x9e\x00\x6e\x91\x74\xf7\x08\x3d\x1d\xcf\x3f\xca\xdd\x85\x98\x8c\x03\xbf\x37\xb4\x45\x5d\xee\x91\x25\xd2\x9f\x6a\x79\xc3\x99\xbf\x13\x02\x4a\x78\xad\xb1\xb2\x27\x96\x5a\x2e\x7f\xa4\xc0\x93\x04\x0e\x76\xbb\xef\xa0\xee\x8b\xca\xc1\x23\x84\x69\x3f\x78\x7c\xe0\x7c\x05\xe3\x48\xb7\x75\x9f\x1d\x1e\xa7\x47\xc0\xb2\xf3\xec\xc0\x9e\xa0\x07\x8d\x9f\x24\x20\x49\xba\xd2\x5a\xb7\xc2\xfa\xe4\xda\x63\xa5\xd6\x13\x39\x40\x62\x4f\x1e\xfd\xcf\xce\xaf\xce\x0f\xa7\x8a\x14\x78\xd5\xb2\x1a\x45\xbb\x91\x2f\x01\x60\x7c\xca\xf6\xbd\x57\xee\x60\x40\x70\x72\xc3\x01\x01\x59\x1d\x56\x97\xd1\x28\x8a\x38\x60\xa7\x83\x03\xbb\x89\xcd\x6b\x3b\x51\x8e\x2d\xac\xe1\x63\x0c\xec\x9b\x39\x48\x63\x63\x7a\xcd\xfc\x02\x64\xa4\x0f\xd3\x5f\xff\x38\x9b\x60\x76\x94\xdb\x0d\xae\x73\x53\x2c\x5b\x10\x8d\x59\x0a\xc5\x15\x46\xaa\x8a\x0a\x2c\x1d\xad\x9b\xda\xaa\x41\x5e\x7b\x85\x2f\x17\xe2\x7b\x04\xaf\xe0\x96\xd2\x2d\x6d\xf2\xaa\xc2\xb2\x6d\x74\x64\x20\xdb\xf1\xab\x03\xd1\x6c\x77\xbe\xcb\xc1\xed\x09\x23\x61\xc8\x34\xdc\x69\x68\xf9\x04\xbe\x88\x7b\xcc\x85\x7d\x52\xf2\xb0\x4b\x20\x5e\xe5\xeb\xcb\xe0\x49\x38\xda\x85\x1f\xdd\xe0\x51\xe0\x0e\xf1\x69\x07\x8d\x37\x95\x14\xb5\x79\x28\xa8\xc6\xb4\x1f\x9a\xda\xe7\xbb\xc4\x1e\xa5\xf7\x15\x7f\xc3\x64\x2b\x26\x64\x35\x9c\xe9\x9b\x2a\xb5\x7e\xae\xdd\x2f\x8a\x74\x6a\x67\xe1\xe8\xac\x8e\x75\xfa\x26\xee\xd7\xad\x48\xe4\xd1\x82\x20\x99\xda\xe4\x35\xe6\x2b\x89\xa5\x35\x4d\xd3\x24\xd9\x8b\x96\xb2\x33\xf8\xa1\xae\xee\xbd\x74\xa0\x30\xe2\xff\xf3\x46\x09\xb9\xa8\x5f\xe0\x4a\x4a\x97\x6a\x21\x9c\xa4\x20\x6f\x5d\xa1\x10\xc5\x08\x64\x9b\x31\x39\xce\xa4\x73\x17\x11\x5e\x5e\xd9\x70\xed\x01\x8e\xc2\xb6\xcd\xad\x04\x0b\xfd\x5b\x0e\x7d\x1e\x1e\xac\x14\x9e\x70\x64\x49\x06\x63\x08\x66\x0f\x9c\xd5\x42\xff\x0f\x5b\xad\x20\xca\x1a\x88\xb0\x98\x88\xbf\x49\xb3\x1c\xb2\x22\xf6\xa4\x6e\xac\xc4\xb2\xd5\x0f\x75\xb3\x3d\xb4\x9b\x43\x8c\xe0\x55\x3d\x6e\xf0\xd3\x43\x2c\x99\x33\xaa\x76\xd6\x1e\x63\xe6\x7d\xce\x0c\x6e\x72\x14\x7b\x86\x23\x6a\xf2\x13\x1c\xc9\x9d\x4f\xc1\x32\xe9\x75\x55\x7d\x6d\xe0\x1b\x51\x09\x85\x92\x78\xf2\x07\x8f\xaa\x1f\xfa\x06\xda\x9f\xc5\x18\x45\xf1\x73\xc7\xfa\xd3\xa8\xdb\xd4\x82\xbe\x44\x6d\x40\x83\x5e\x07\xbb\x32\x3e\x76\x06\x1b\x64\x1e\xdb\x4e\xa0\xf9\xe2\x09\x64\x53\x86\x66\x0c\x99\x8d\x64\x4e\x7a\x29\x64\x8a\x9b\x8e\x27\x7b\x8a\x3e\xbb\x98\x90\x75\x4d\x46\x2d\x57\xa6\x96\x2b\xa7\xa7\xf0\xbb\xe6\x0b\xe3\x89\x89\x20\x73\xe1\x11\xea\x03\x66\xb9\x38\x1a\x81\x5b\x84\x3a\x46\xec\x61\x00\x13\xe0\xc3\xdc\xc2\x94\xf7\xba\xdc\x9f\x74\xe5\x79\x30\x74\x86\x03\x47\x48\x73\x91\xfa\x09\x7c\xc6\x13\xdc\xa6\x71\xcf\x82\x33\x30\x9c\x03\xd3\x43\xd0\x4e\xdf\x37\xe6\x1d\xb6\xe3\xde\x62\x31\x62\xc0\x4a\x50\x33\xc9\x8a\xe5\x6e\x14\x2c\x6e\x9d\x69\xb4\x1b\x85\x82\xda\xef\x39\xbd\x2e\xcb\xb6\xc0\x93\xaf\x25\x98\x86\x7e\x17\x64\xaa\x6d\xa9\x07\x15\x1a\xe2\x02\xce\xac\xfd\x3e\x5c\x45\xa1\xe0\xb8\x17\xb5\x34\x6b\xa3\x21\x4d\xa9\xd3\xf0\xc3\xda\xc8\xa6\xee\x17\x86\xf1\xb0\x6b\x2f\x56\x05\xcd\x54\x08\x1e\xc1\x59\x00\x68\x9f\x47\x03\xfa\x13\xf2\x8a\x38\xbf\x1b\x79\x16\xa4\x0e\x4b\xaa\x3c\xa3\x28\x09\xa5\xf0\xd9\xac\xde\x62\xcb\x6b\x76\x01\xd3\x3e\x83\x6d\x97\xb2\x2d\x54\x5b\x84\xb1\x82\xd7\xdc\x6a\xa4\x76\x2e\x17\x1b\xe5\xec\x3b\xce\x50\x20\xee\x44\xb1\xa1\x69\xb6\xfb\x86\xdc\xc2\x9f\x79\x85\xa8\xcb\xa6\x76\x75\xce\x00\x20\xce\x89\xcf\x6a\xff\x40\x27\xa3\x91\x0f\xca\xdb\x9c\x32\xcb\x00\xad\x2c\x2e\x24\x7e\x6a\x61\x74\x7b\x5a\xf4\x88\x5b\x7f\xca\x6f\x0b\xb1\x4c\x45\x4a\xb3\xc2\x0e\x28\x9e\x2b\x99\xa4\x24\x45\xe2\x66\x73\x0a\x3c\x30\xa4\x5a\xab\x66\x2b\x29\x85\xc7\x35\xd4\x2e\x85\x5b\x59\x55\x70\x2d\xc8\x88\xc9\xb0\x0e\xc8\x20\x39\xb8\xc1\x92\x7b\x18\xd8\xfe\x42\x7c\xa9\x81\x75\x2c\xa2\x76\xb2\x43\xb4\x14\x4a\x6e\x43\x44\xc3\x9e\x1e\x12\xec\xce\x2e\xa7\x3d\x73\x0d\xa5\xd0\x85\x92\xd7\xa2\x04\x59\x9f\xc3\xd2\x98\xb5\x3e\xcf\x32\xdf\x8e\x29\x9b\x42\x67\x2b\xb9\x50\xb9\x11\xd9\xff\x0b\xa1\xe9\xd4\x1d\x15\xc6\x03\xdc\xd7\x73\x8d\x3a\x62\x02\xee\xf7\x5a\x83\x39\xd0\x5a\x64\x16\x40\x83\xb1\x05\x5a\xa7\x05\xb6\x53\xb0\xdd\x2b\x0d\x76\xe9\x95\xb8\xd9\x48\xd5\x6f\x3d\x8e\x38\x9e\xe5\x56\xb0\x0d\x35\x5d\x1b\x18\x62\x91\x2e\x52\xf8\xe9\xa7\xd9\x85\x4e\x52\x78\xd7\xa0\x58\xe6\x58\x65\xb6\xd1\x2a\x1d\x40\x2c\xcb\xf3\xc4\xed\xae\xce\x2d\xa2\xd1\x9e\xbe\x89\xda\xa4\xe1\x29\x5a\x7f\xfe\x79\x50\xd7\xad\xd3\x4e\x20\xb6\xff\x04\x1a\x9d\x65\x64\xde\x3e\x7b\xbd\xde\x6c\x64\x99\xfe\x98\x2b\x2d\x62\x59\x26\xdf\x85\x36\xff\x61\x94\x65\xad\xed\x5a\x0b\x93\x52\x37\xdd\x3a\x1f\x1c\x42\xa6\xfa\xf1\x8d\x16\xaa\x37\x61\x97\x24\xa3\x8e\x8d\xf3\xff\x22\xee\x1d\x99\x8c\xe7\x1c\xf6\xf6\xa9\xe9\x98\xad\x3d\x8a\x92\x50\x7b\x1f\xbc\x91\x21\x50\x0d\x74\x34\x99\x0c\x53\x43\xae\x8f\xdb\xbb\x73\x4c\x69\xac\x51\x41\x54\xde\xa1\xc4\xec\xeb\x08\xfd\x62\xc5\xc2\xc5\xa8\x08\x39\xcc\xe5\x5d\xdb\x4d\xf1\xd4\x74\x40\xc4\x86\x8b\x7e\x07\x90\xdc\x3f\xcc\x67\x12\x1f\x9a\x5a\xc3\x6e\x65\x47\x56\x15\xdb\x1a\x10\x10\x1f\xb4\x71\x3c\xfd\x5f\xb3\x63\x37\xff\x73\x55\x36\x9b\x01\x92\xc2\x71\xfe\x97\x65\x7c\xcd\x83\xfc\x20\xaa\x0e\xb6\x90\x35\xc8\x90\x8d\xf7\xeb\xfd\x3b\x22\x76\x6d\xdf\x48\xea\x09\xdc\x2e\x65\xb1\xa4\xd9\x9c\xfe\xc9\x9a\xb3\xbc\xeb\x7b\x62\xaf\xd2\x98\x96\x74\x76\xbd\x6e\x9a\xaa\xe3\x64\x77\xa3\x7d\x9f\x59\x8b\x5b\x3e\x1d\x1d\xa3\x4b\x83\xcb\xab\xf6\xb4\x92\x8e\x08\x21\xbf\x6b\x9a\x73\x3e\x85\xd3\x60\xe0\x61\x37\x72\x91\x5d\xb3\x36\x6d\x6c\x47\x73\xf1\x90\x9a\xb5\x89\x69\x65\x82\x22\x87\x0a\x48\xbf\x02\x61\x6c\x7d\xe7\x71\x1c\x8e\x2c\x80\x0e\xbd\xf6\x5e\x45\x3f\x8f\xef\xef\xf4\x48\x7a\x29\xcb\x27\xce\x1f\x1e\xe9\xb2\x98\xfb\xf5\x04\x3e\xf7\xc3\xd0\x0b\xd1\x29\x51\xb9\x62\xd9\x50\xb8\xe6\x44\x79\x3c\x9e\x00\x16\x97\xc8\xf5\xcf\xe3\x71\x91\xd7\x28\x60\x2c\x25\xec\x60\xc8\xa1\xc8\x12\xbe\xb9\x39\x87\x6f\x6e\xc7\x6c\x22\x7b\x81\x44\x12\xc4\xcf\xfa\x56\x62\x4d\xc0\xdc\xaf\xe1\xa1\x9f\x4c\xed\x5f\xba\x88\xa2\xa8\xc0\x4b\x50\xbd\xd6\xcb\xf9\x28\x0a\x31\x1d\x4a\xe4\xf8\x42\xd1\x6e\xe7\x63\xf4\xee\x71\x44\x51\x29\xe6\xf9\xa6\x32\xe7\xa3\x10\xd4\xf1\x44\x93\x6e\x7b\xb2\x89\xeb\x8f\xd0\xed\xc3\x80\x23\x44\xca\xe1\x52\x84\x62\xe5\x1c\x50\xd2\x87\x76\xa0\x98\xee\x61\x10\x1f\x5a\xc1\xb3\x70\x8a\xb4\xc4\x80\x40\x4d\xfc\x84\x0f\x02\x15\x7a\x86\x35\x8b\x6d\x5e\xc5\xc9\xf0\x5e\xad\x1b\x47\x3c\x37\x7c\xd8\x78\x6c\xda\xa8\xa2\xa9\xb7\xd6\x85\xcd\x6a\x13\xe3\xd8\xab\x97\x13\xf8\xcf\xff\xf8\xc5\xa2\x86\xb8\x2b\x88\xbf\xd9\x26\xe4\xdc\x9b\x8d\xa1\x32\x14\x9e\xc0\x39\xd4\x4d\xfd\x22\x88\x12\x5c\x78\x40\x7e\x22\x34\xed\x1c\x6d\x06\x95\xe2\x7f\x15\xa3\xfa\x17\x96\x76\xbb\x78\x23\xcb\xa4\xcb\xbd\xee\xb1\x7c\x3d\xc1\x2d\xfe\x1d\x71\xde\x75\x62\x7b\x34\x35\xe8\x7f\xbc\x85\x60\x61\xdb\xed\xda\x8b\x2c\xae\xe4\x97\x93\xd9\xc6\x7b\x81\xb8\x85\x2c\x53\x98\xcd\x7d\xf8\x4e\xf4\xec\x07\x98\xd2\x50\x08\x8c\xc0\x86\x82\x4b\x1b\x24\x37\xf3\xd0\x81\xf8\x22\xe0\x42\x6e\x45\x4d\xb7\x51\x42\xde\xfc\x16\x68\x49\x0e\x14\x20\x2f\x8a\x46\x95\x98\x8c\x98\x66\x3f\x0c\xed\x84\xc2\x8c\xa5\xb5\x1a\x36\x22\xec\x87\x84\xc9\xd0\xc3\x81\x38\x11\x03\xb7\xd0\x30\x25\xb8\x70\x38\x79\x54\xbf\x28\x7b\xfc\x4c\xac\x53\xfd\x36\x7d\x29\xe6\x9c\x57\xc4\xf4\x1b\xdd\xdf\x4c\xbb\x04\x2f\x16\xca\xf9\x15\x5c\x36\x85\xf6\x7e\x54\xfa\xda\x36\x66\x85\x52\x13\x5f\xa1\xb5\xa6\xce\xaf\x66\x79\x47\x31\x8c\x93\x51\x44\x34\x7a\xdb\x50\xa4\x7d\xef\x9e\xf4\xd4\x0c\xb9\x38\x60\x21\x1e\xc9\x5f\x8b\xb4\x6e\xf9\xcd\xdb\x21\x94\xc1\xd8\xe2\x30\x4b\x69\x25\xc7\x87\x83\x8e\xb7\x65\x25\xb3\xd1\x39\x30\x5a\xf8\xf0\xc4\xa5\x41\x72\x5e\x8f\xba\xa7\xf3\x51\xaf\x15\xc5\x97\x4d\xf9\x69\xf7\x6a\x64\x30\x75\x0a\xe3\x4d\x3b\xb5\x63\x8a\xd7\x68\x82\x3b\x0e\xf3\x48\x8f\xdf\xf2\xb9\xe3\xb6\xbc\xcb\x0c\xab\x10\x1d\xf8\xdd\x8a\xb4\x2d\x35\xef\x51\x3d\xbb\x88\x7d\x67\x24\xe1\xa9\x4c\x62\xe7\xce\x6d\xd1\x54\x58\xbe\x46\x5b\xed\xa8\x8b\xde\xd8\x67\x54\xa1\xd4\x43\xa5\xb4\x71\x92\x0e\xf9\x78\xac\x4f\xfb\x02\xe3\x10\xe9\x03\x94\x77\x0a\x45\x1c\x3d\x84\x60\x83\xb8\x21\x5c\xfd\xa4\x39\xa7\x50\xc9\xca\x5b\x1b\x36\x78\x3d\xe9\x07\x0e\xc3\x51\x32\xce\x52\x83\x3d\x0d\x14\x5e\xdd\x6f\xb8\x1d\x30\x10\x97\x57\x87\xa4\x1a\xeb\xa4\xb2\xd4\xf4\x88\x64\xf7\xd5\x39\xdf\xef\x51\x87\x2a\x50\xfa\xf2\xe5\x55\x58\x85\x1a\x92\xb0\x90\x4f\x96\xcb\x41\x67\x8b\xb1\x79\xa0\x5d\x5c\xb8\x46\x9b\xbf\x3c\xdf\x9f\xe5\x26\x60\x13\x8c\x56\xb4\x15\x60\x9e\x32\xf1\x54\x24\x23\xec\xc5\x37\x9d\x29\xf4\xa0\x33\x85\x63\x1a\x37\x05\x2b\xa9\xd6\x1e\x5c\xf5\xb8\x89\x26\xaa\xfc\x56\x96\x77\x1e\x1c\xce\xed\x4c\xb9\xa2\x7a\x6a\x07\xbc\xcf\x54\x06\xac\xe0\xb1\x79\x9c\x4f\x54\x3a\x79\x06\x49\xb0\x4b\xcc\xfc\xe1\x84\x53\xfc\x19\x3d\x5b\xfe\x87\xb3\x95\xe1\x2a\xc7\x31\xb9\x0a\x6e\x4e\xa8\x62\x8d\x1c\xf9\x47\x3f\xf4\xa5\x2c\xaf\xbe\x03\x5f\xd0\x76\xe8\xf0\x4c\x17\xb8\x3f\x27\x74\xa2\xa5\x6d\x5a\xb2\x65\x45\x93\xe5\xa0\x96\x59\xd8\xdd\x12\x30\xa5\x90\xb2\x7b\x91\x01\x75\x0b\x51\x6c\xf5\x15\xc7\xba\x4c\x72\xcc\x3e\xa0\x03\xc4\x0d\x7d\x29\xb1\xa1\xc0\x9c\x46\x66\xca\x9a\x52\xc5\x9d\x03\xae\x2f\xe9\xcf\x55\x7b\x1f\xaa\xf3\xd8\x6f\x40\x82\x88\xec\x6b\x27\xb6\xcf\x26\x20\x29\xc3\xb5\xe9\x30\x23\x8d\x34\x78\x82\x58\xe8\x1f\x46\xfd\xbe\x0c\xfb\x55\x16\x9d\x76\xe9\x01\xaa\x38\xdd\x1e\xe2\x55\x30\x78\x17\x8e\x7a\xc4\xf9\xcc\x1d\x63\xca\xbb\x80\x35\x91\xaf\xd9\xef\x6c\xf6\xe1\x77\x93\xbf\x6c\x37\x4b\x9d\xdb\x0d\x7f\xe1\x99\xf4\xb6\x6c\x79\x77\x60\x37\xcb\x09\x77\x9e\x01\x3f\xb8\x65\x61\x07\x42\x46\x85\x87\x6d\x77\x0a\x00\x3c\x12\x55\xb5\x1c\xd8\x0b\xd7\xec\xf6\x6d\xd0\xe6\xa1\x0d\x86\x6e\x76\xf4\x88\x00\x2e\x42\xef\x82\x57\x53\xf1\xf6\xf9\x4d\x45\xc5\x4e\x7c\x4b\x81\x55\x9e\x04\x03\xf1\x77\xe3\xef\xc5\x2d\x0e\xe3\xb4\x59\x5d\x8a\xbb\x58\xd2\xcd\xba\x64\xd4\x4e\x79\x5d\x96\xe4\x1a\x69\x6d\xcb\xb9\xa4\x9b\xb4\xe0\xc1\x58\xeb\x7e\x30\x88\xd3\xc7\x45\x71\x7b\x8e\x70\xd8\xe9\x1d\xe1\x3f\xc2\x9b\x7d\x41\x1c\x26\xcb\x55\xbe\x7e\xd4\x0d\x9c\xed\xc3\x7a\x54\x7c\x09\x62\x5f\x9f\xf9\x11\xdf\xea\x72\x3c\xeb\xdb\xab\x5f\x31\x22\xd5\x1c\x92\x6a\x17\x8a\x3d\x16\x93\x62\xa3\x7a\xbc\x09\x26\x63\x54\x1a\xb2\xd7\xf7\xec\x99\x4d\x5d\xee\x1c\xe2\x6a\xb8\xe2\x00\x63\x9f\xb0\x0c\xc7\x47\xc7\x43\x26\x6e\xd8\x4b\x3a\xaf\x84\xb0\x59\xed\x36\xb2\x64\x20\x74\x96\x9b\x81\xc3\xdc\x0c\x9c\x26\x43\x0a\x4f\x74\xdf\x28\x7f\x4d\xb8\x3d\xab\x39\xe0\xc6\xa3\xc7\xe0\xec\x37\x0a\xba\xc3\xa6\xfe\x10\x3f\x07\xb8\xb9\x0b\x7c\x09\x92\x3e\xdc\x97\x0f\x26\xa8\xf0\x9c\x91\xdb\x38\x8f\xda\xf5\x7c\x66\x54\x30\x56\x6c\xe2\x3b\xe6\xfd\x5f\x10\xcb\xeb\x67\x07\xf3\x1e\x7c\xd7\xca\xed\xab\xd3\xa3\xca\x9b\x65\x83\xe2\x6b\x1f\xda\x36\x09\xbf\xe4\x21\xf9\x95\x99\xce\x4c\x7e\x81\x86\xef\x50\x1d\x50\x04\x86\x90\x00\x16\xb1\xa0\xaf\x80\x7b\x77\xff\x1f\xab\x42\x57\x81\xda\x3d\xa7\x0e\xfd\xf3\xcf\xd8\x95\xc0\x5f\x3d\x79\x73\x87\xcb\xcc\x24\xb5\x1e\x38\x2b\xa2\x0c\x3a\x4b\x9f\x55\xa3\xa6\xf4\xba\xb2\xda\xdc\x95\x6d\x47\xac\x67\x49\xbf\xec\xca\x13\x96\xb9\xfe\x51\x89\xb9\xbc\x0b\xa7\xf2\x25\xce\xf1\x46\xd6\xa6\xd5\xb2\xed\x70\x09\xf5\x27\x39\x50\x43\xed\x57\x13\xa3\xed\xf1\xf5\xd7\x2e\x21\x83\x8a\xf9\x6b\xf3\x95\xc1\xf7\x65\x2a\xde\x26\x3e\xd6\xf7\x14\x7a\x2e\xcf\x74\xf7\xf2\xeb\x21\x20\xb2\x1c\x86\xd2\x28\x0f\x08\xfb\xbf\xc1\xaa\x94\x5e\x09\xc0\xab\xd3\xb5\x50\x3d\xbe\x60\x47\x56\x96\x29\x0e\xb6\xfd\xd8\x7f\x07\xb3\xe8\x2c\xfa\x64\x31\xae\x48\xe6\x3c\x97\x15\xc4\x74\x1f\x79\x6e\xdf\x22\x87\xb2\x11\xb6\x60\xab\x37\x6b\xec\xf7\xb9\x36\x38\x7c\xa3\xd1\x28\x7c\xc3\x2d\x72\x6b\x73\x5c\x8b\x7c\x1c\x30\xc6\xa1\xec\x1a\x12\x1d\xd1\xe9\xbd\x2d\xec\x47\xf8\x45\xde\xc7\x33\xd9\x6e\x43\xd2\x17\x7b\x9f\x51\x34\x8e\xf6\x02\xc3\x00\xe0\xa1\xc2\xc8\x31\x29\xaa\xb2\x35\x80\x53\xc6\x3d\xfd\xc0\x37\x53\xb1\xa9\x68\xaf\xf4\x4d\xe0\x5a\xd6\x58\x36\xa6\x89\x8b\xf4\xaf\x28\x19\xa9\xdd\xd8\x5f\xca\xf4\xc9\x01\xce\x71\xdd\x83\xf4\xed\x9d\xa0\x5e\xdf\x04\x7a\x90\x26\x58\x24\x1a\x10\x2f\x3e\xfd\xf1\x38\x2c\x98\xe0\x25\x1d\x62\x93\xf6\x17\x02\x3b\xdb\x29\xa1\xd3\x0f\x22\x2f\xff\x9a\x57\xf1\xa9\x9d\x78\x2c\x68\x4c\xcf\xf1\xfe\xb4\x5d\x84\x29\xcd\xcb\xbd\xd9\x8f\x78\xc5\x67\x66\xdd\x41\xf5\x87\xeb\x00\x2f\xaf\x82\xba\xce\x7e\xdb\xba\x27\x26\xfe\xfa\x30\x9e\xbd\x2c\x84\xde\x97\x14\x3d\xe1\xb7\xfd\x73\xc0\x3f\x15\xbe\x1e\x97\xdb\x22\x7f\x4a\x72\x38\x2b\x09\x8a\x87\x60\x96\xb9\x81\x12\x5f\xbd\x34\x20\xee\xa4\x36\xc3\x6f\xfa\x59\xee\x89\x12\x56\xf9\xfa\x09\x69\x3c\xbe\x4e\xc7\xb7\x87\x3b\xcf\xae\x06\x64\x34\x57\x8b\x30\x9e\x0e\xae\xee\x1d\x9f\x68\x20\x0c\x1b\xaf\x92\x83\xdb\x7d\xa5\xe8\x23\x18\x8c\x29\xd3\x3f\xab\x66\xb3\x8e\x93\xf4\x4f\xf7\xf1\xe7\xcf\xe9\x7b\x71\x1b\x7b\x9d\xe8\x3e\x9c\x5d\xc4\x49\xfa\xae\xa9\xca\x38\xf9\x95\xb4\x25\x88\x28\x9d\xba\x2c\x10\x3b\x54\x97\xc3\x65\xbd\xc3\x2a\x64\x17\x1f\xbd\x1f\x8b\x66\x3f\x7f\xd9\x3f\xd1\xfe\x51\x7d\x9e\x00\x6d\xd5\x9e\x16\xa3\xed\x8b\x1e\x5c\x10\xe3\x74\x2a\x98\xd3\x8d\x8c\x0f\xa4\x3f\x6d\xad\x0d\xb8\xf0\xb6\x57\xef\x70\x24\xb9\x72\x22\xeb\x62\xe7\x3a\x64\xbf\x03\x9c\x65\x60\x9b\xa1\xae\xc5\x85\x9a\x8a\xbf\x07\xee\x76\x91\xff\xb1\xf1\xf2\xa1\xcf\x46\x50\x9c\x9e\x65\x7c\x21\xc5\x29\x9c\xa4\xae\x6e\xef\xce\x56\x3e\x37\xf8\xe2\x30\xd8\x0b\x68\x74\x63\xad\xc4\x1b\xe6\xb5\xb8\xe5\xe5\xa6\x09\xdf\xa0\x47\x7d\x7f\xdf\x18\x7c\x25\x39\x37\xc1\xf5\x38\xa9\x21\xaf\x74\xc3\x68\x8b\x12\x6e\x97\xa2\x86\x1c\xed\x47\xeb\x45\x57\x74\xbd\x25\xaf\xf9\x4b\x2e\x7c\x63\x66\x48\xf7\x7b\xdc\x18\x52\xff\x04\xe5\xa5\x51\x74\x6c\xed\x25\x0d\xdf\x7c\xb6\x10\xba\xad\xe7\xa4\x3d\x1d\xee\x81\x11\x9b\x38\x75\xf9\x34\xd0\x94\xc6\x5b\x72\x48\x24\x4f\x81\x95\xac\xe5\x0a\x1f\xf3\x38\xad\x77\xaf\x9b\xbb\x23\x0b\x88\x63\xee\x34\xc8\x07\xed\x3b\xd0\xb9\x7b\x99\xbc\xc0\x3b\xe0\x8f\xee\x3f\x05\x23\x57\x22\xfd\x28\x8a\xa6\x2e\xad\x41\x1f\x6a\x9e\x77\x0c\x7b\x1f\x49\xf7\x3e\x3c\xad\x7e\x3e\x92\xee\x70\x6c\x37\x37\x19\xdc\x3f\x4e\x2c\x9e\x17\xee\xde\x23\x97\xf6\x0a\x5f\xd3\xed\x23\x1c\x96\xfa\xf8\x4c\x1e\xe1\x43\x57\xb5\xce\x0e\x82\xc5\x32\xd8\x28\x6a\x89\x0a\xef\x89\x45\x4d\x5d\x08\x00\xc0\x6f\x52\xa4\x3f\xd4\x05\x16\x8b\xb5\x58\x01\x00\x9c\xf9\x4f\x54\xa4\x7f\x13\xf8\x7d\x23\x81\x06\xdd\xb6\xb8\x21\x37\xcd\x4a\x16\x36\xc4\x45\xdb\x64\xe5\x9b\xc8\xfd\x24\x57\x02\xdf\xd7\x5f\x6c\x72\x85\x0f\xaf\xef\x41\x8b\x55\xca\x45\x4f\xe2\x9b\x81\x33\x8b\x49\x02\x61\x79\x7b\xdf\x99\x95\x6a\x0b\xfc\x15\x8f\xf4\x82\x6f\x4a\xf8\x13\xec\x30\xd7\xc7\x61\xdd\xab\x12\xc3\xc1\x18\xcd\x69\xeb\x1f\x26\xfd\xde\xab\x45\xa9\xb6\x07\x6a\xd1\xc3\x81\x8d\x6d\xd7\xd8\x6c\x2a\x8b\x5f\xfd\xe1\x0f\xff\xff\x5b\x78\x01\xaf\x12\x06\x82\xe5\xe2\x3f\x4e\xc9\x7b\x3a\x8a\x09\x58\x96\xd1\xb7\x6f\x4c\x70\xeb\x50\xa2\xd3\xbf\x87\x65\xbe\x15\x70\x8d\xaa\x63\x8d\x8e\x35\x46\xc1\x9d\xdb\xdc\xbd\x1b\x45\xd5\x91\x90\x14\xa4\x44\x05\x2a\x5e\xaa\xed\xc4\x76\x56\xf8\xdc\x5d\x58\x97\xd0\xa5\x39\xb6\xdf\x4c\xd7\x00\xa6\xa7\xa7\xac\x68\xb2\x2e\x44\x6c\x52\x84\x8c\xa1\xf6\x1f\xa7\x81\xae\xbb\x77\x76\x06\x38\xb6\xcf\x32\xef\x0f\x98\x35\x7f\x80\x97\xf0\xf3\xcf\x07\xb9\x74\x5c\x74\xd8\xbb\x2a\xf6\x8c\xe8\x90\x7b\x1f\x58\xad\xef\xc6\x87\x28\x0f\x1d\xf3\xd1\x6a\x4f\x0a\x33\xba\x27\xcc\x22\xdf\xd8\x6f\xa9\xcc\xa5\xd2\x06\x8a\xbc\xaa\xec\x67\xa3\x8a\xbc\x58\xba\xc3\xbb\xcd\x15\x7d\xa9\x64\x4f\xf6\x9d\xd4\x1d\x23\xf7\x54\x62\x1e\x10\x65\xbc\x6b\xc9\x22\x60\x3b\x5f\x26\x25\x15\xb5\x22\x9d\xa4\x7e\x55\xd2\x36\xc2\x3a\xd4\x33\xe1\x1d\xb6\x1c\x90\xa2\xbe\xf8\x30\x87\x60\x9e\x53\xd6\xc8\x3d\x21\xef\xb7\xa1\x75\xd6\x2d\xfb\x88\x3b\x4a\xac\xab\xbc\xe0\x92\x12\xb3\xaa\xa9\xc5\x10\x8f\x5a\x97\xf5\x4b\xb8\xf4\x95\x84\xe1\x45\xcb\x90\x2e\xf5\x08\x5d\x28\xd1\xc6\xdf\x1a\x62\xe9\xb8\x17\x66\x02\x58\x96\x9f\xf7\x48\x45\x09\xd2\x26\xaf\x90\xe6\x2c\x83\x37\x4d\x5d\x6c\x14\x7d\x5b\x0c\x65\xc8\x7e\x06\x44\x0b\x25\xf3\x4a\xfe\xaf\xff\xac\x1a\x78\x8b\x6c\x85\x4c\x2f\x73\xbe\x89\xc6\xdb\x11\x26\x43\x5c\x54\xcf\xe2\xe2\xc4\xa2\x36\xc4\x9b\x43\x0c\x36\x29\x3a\x92\xf4\xa2\x89\xdd\x0d\x20\x30\x29\x3a\x92\x69\x8b\x34\x46\xef\xce\x93\xc4\xaf\x12\xd8\x05\x86\x96\x04\x17\xdd\xc4\xeb\x82\xee\xd7\x21\xaa\x13\x78\x75\x74\x70\x6c\x6f\x1f\x59\x10\x1f\x44\x25\x72\x2d\xe2\x57\xc9\xf3\xf5\x03\x5f\x80\x22\xe2\x07\x0d\xd1\xbe\xc6\xec\x79\x92\x8e\x78\x05\x04\x86\xae\xdd\xa1\x80\x5f\xb7\xf3\x1b\xd9\x01\x3e\x48\x8e\x70\xde\x37\xb7\xf1\xa0\xc9\x62\xd2\x5b\x8f\xea\x4f\xfa\x5f\xa2\x2d\xf8\xba\x0d\x66\x6c\xf8\x3e\xe7\x87\xe6\x56\x87\x99\x9a\x4b\x12\x71\xec\xc2\xba\xe6\xb8\x54\x5b\xff\x3f\xb7\x01\xf8\x03\x03\xf4\x95\x24\x2e\xe4\xbf\x53\xcd\x2a\xc6\x65\x14\xd4\xc5\xf6\x8b\x5c\x29\x3a\x7f\x7a\xc0\x0b\x7f\x50\xa5\x50\x7f\xba\xa7\x89\xaf\x75\x11\x8f\x65\x39\xe6\xa1\xa1\xac\x0e\xb7\xb6\xcf\xc3\x8c\x0e\x91\x9c\x80\x6a\x6e\x8f\x4f\xaf\xac\x04\xe1\x92\xf4\x4d\xd5\x68\x41\x8c\xc7\x1c\xaf\xe7\x3b\xf7\x8f\x02\x31\xc5\x4a\xde\x47\xfc\x84\x63\x8c\x10\x26\x70\xea\x4f\x35\xcc\x72\x3a\xf9\x4e\x76\x06\xff\xd5\x54\x6c\x48\xd8\xb2\xf8\xd8\xa6\x99\x3f\x9a\xe4\xf0\x17\xbc\x6c\xf4\xe9\x02\x08\xfb\xd2\x18\xbf\xee\x1a\x7c\xfb\xd1\xce\xca\xec\xdb\x9e\x99\x45\x6c\xec\xbb\x01\x5c\x54\x8b\x07\xbe\xb7\x88\x1f\x55\x4b\xfc\xe7\xf1\x7c\x2d\x9a\x2e\x90\xc4\xc3\x1f\x32\x4c\xb8\xa8\xc7\xaf\xcb\x0c\x05\xb9\xf8\x55\x26\x25\x4b\xf1\x54\x5c\x3e\x10\x98\x1f\x62\xc7\xa1\x28\x3d\x3a\x84\xc3\x59\x27\x76\xec\x34\x6d\x3a\x67\xd4\xe7\x21\xbf\x52\xf6\xdb\x31\xf1\xfd\x01\x02\xdc\x2d\xd2\x27\xb8\xd8\x63\xa0\x85\x79\x80\x8b\x13\x9b\x9e\xb6\x69\xb3\x2c\xbb\x65\xab\xbd\x4c\x15\x59\x4a\x49\x81\x4b\xab\x53\xb8\xb0\xe9\x8a\xc6\xd7\x67\x9a\x1a\x7d\x18\xe6\x68\x29\xfc\xa4\x45\x3f\x7f\xe5\x24\xdd\xbd\xb2\x2d\xb1\x2e\xb6\xae\x64\x21\x4d\x75\xef\xbb\x48\x07\xe8\x8f\x4b\xe8\x9c\x60\x02\xed\x7b\x37\x5e\x3f\x11\x00\xe6\xcf\x2e\x47\xe3\x8b\x12\x87\xb3\x2f\x38\x2d\x83\x18\xf5\x69\x99\x20\x7d\x73\x7a\x95\x97\xa5\x44\x14\xf2\xea\x37\x10\x8b\x76\x33\xf7\xdd\x30\x64\xa6\x7b\x8b\xd4\xfb\x25\x36\x51\x87\x68\xf9\xbf\x01\x00\xb1\x3e\xf7\x9a\xe4\x56\x00\x00")
 
 func templateNodeTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -158,12 +264,12 @@ func templateNodeTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/node.tmpl", size: 9654, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/node.tmpl", size: 22244, mode: os.FileMode(436), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatePaginationTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x3a\x7f\x6f\xdc\xb8\x72\x7f\x4b\x9f\x62\x4e\x70\xfc\x24\x57\x91\x93\x43\x5b\xe0\xf6\x75\x1f\x60\xd8\xc9\xd5\x40\x5e\x92\x3b\xbb\xef\xfe\x08\x82\xb3\x2c\x51\xbb\x6c\xb4\xe4\x86\xe4\xda\xc9\xdb\xec\x77\x2f\x86\x43\x4a\x94\x56\x6b\x3b\x41\x8b\x1a\x48\x6c\x91\xf3\x7b\x86\xc3\xe1\x90\xdb\xed\xe9\x49\x7c\x2e\xd7\x5f\x15\x5f\x2c\x0d\xfc\xfc\xe2\xe5\x2f\xcf\xd7\x8a\x69\x26\x0c\xbc\x2e\x2b\x76\x2b\xe5\x27\xb8\x14\x55\x01\x67\x6d\x0b\x16\x48\x03\xce\xab\x3b\x56\x17\xf1\xf5\x92\x6b\xd0\x72\xa3\x2a\x06\x95\xac\x19\x70\x0d\x2d\xaf\x98\xd0\xac\x86\x8d\xa8\x99\x02\xb3\x64\x70\xb6\x2e\xab\x25\x83\x9f\x8b\x17\x7e\x16\x1a\xb9\x11\x75\xcc\x85\x9d\x7f\x73\x79\xfe\xea\xed\xd5\x2b\x68\x78\xcb\xc0\x8d\x29\x29\x0d\xd4\x5c\xb1\xca\x48\xf5\x15\x64\x03\x26\x60\x66\x14\x63\x45\x7c\x72\xba\xdb\xc5\xf1\x76\x0b\x35\x6b\xb8\x60\x90\xac\xcb\x05\x17\xa5\xe1\x52\x24\xb0\xdb\xe1\x8c\x61\xab\x75\x5b\x1a\x06\xc9\x92\x95\x35\x53\x09\x1c\x01\x21\x3d\x07\xde\x80\x60\x70\x54\x5c\x19\xa9\xca\x05\x2b\xde\x96\x2b\x06\x89\xfe\xdc\x5a\xe4\x68\xbb\x85\xa6\xe4\x6d\x48\x15\x14\xfb\xbc\xe1\x8a\x69\xb8\xfa\xed\x0d\x68\xc2\x73\xac\x9e\x03\x13\xf5\x80\xb6\x34\x90\x2e\x4b\x7d\xdd\x89\x50\xc9\xb6\x65\x95\x15\x2f\x7b\x9c\x45\xc3\x59\x5b\x43\x80\x33\xe6\xc3\x57\x6b\xa9\x0c\xa4\x48\xe7\x39\xa8\x52\x2c\x18\x1c\x09\x98\xcd\xe1\xa8\x78\x2b\x6b\xa6\x2d\x8f\x28\xd9\x6e\xe1\xa8\x38\x97\xa2\xe1\x8b\xe2\x7d\x59\x7d\x2a\x17\x0c\x76\xbb\x53\x1c\x16\xc1\x40\x42\x74\x1c\xf5\x2c\xa4\x9f\x30\x61\x16\xb2\xe0\xf2\x94\x09\x73\x5a\xf3\x12\x45\x3a\x45\x4b\xc5\x51\xb2\xe0\x66\xb9\xb9\x2d\x2a\xb9\x3a\xfd\xe5\x97\x9a\x69\xbe\x10\xfa\x74\xf1\xb9\x5d\x30\x71\xba\x50\xe5\x7a\xb9\x07\x76\xc7\x3e\x99\x72\x89\x30\xeb\x52\x69\xa6\x4e\xef\x7e\xc6\x0f\xa6\x94\x54\x63\xd0\x15\x5f\x96\xbc\x65\xa2\x92\xa7\x2b\xbd\x58\x97\xd5\xa7\xd3\xbb\x7f\x4b\x50\xbc\xd3\x53\x78\xa7\x6a\xa6\x2e\x6c\x90\xa0\xe9\x28\x0c\xb4\x8d\x9f\xda\x8f\x6a\x8c\xa8\xfb\x25\xaf\x96\x60\x24\x48\xc4\x80\x12\x5a\xae\x0d\x06\x15\x37\x6c\xa5\x8b\xd8\x7c\x5d\xb3\x31\x35\x6d\x14\x17\x8b\x38\xae\xa4\xd0\xd6\x0a\x7b\x0c\xcf\x74\x05\x7a\xcd\x2a\xde\x70\xa6\xa1\x14\x50\xea\x8a\x89\x9a\x8b\x05\xf1\x29\xe2\x68\x1f\x61\xc4\x05\xe6\x90\x9c\x5d\x9d\x27\x13\xe4\x2f\xd8\x90\x3e\xd4\xec\x11\xfa\x16\x63\xc4\x60\x0e\xc9\xc5\x2b\x64\x40\x26\xfb\x47\xd9\xf2\x1a\xa3\x11\x8d\x44\xd6\xe8\x4c\x05\x77\x65\xbb\x61\x45\xdc\x6c\x44\x05\xa9\x1c\x51\xca\x3a\xdc\x34\x03\xeb\x2b\xd8\xc6\x11\x6f\x40\xc2\x4f\xf3\x09\xcb\x1c\x1f\x4f\xcd\x58\x11\xb7\x71\x14\x29\x66\x36\x4a\x40\xb3\x32\xc5\x2b\x24\xd6\xa4\xc9\x33\x8d\x09\x04\xd7\x4d\x89\xa2\xf0\x7a\x84\x9b\xe4\x20\xb3\x38\xda\xc5\x1e\x59\xf0\x36\xde\x59\xb5\xae\xac\xb3\x80\xaf\xd6\x2d\x5b\x31\x61\xb4\x25\x4c\xa3\x4c\x01\x17\x86\xa9\xa6\xac\x1e\x50\x8e\x60\xd3\xcc\xf9\x1d\x65\x74\x5c\x68\x20\x95\x99\xe3\xf5\xf7\x52\xe9\x65\xd9\xfe\xfa\xdb\x9b\x90\x9f\x0b\xf5\xc2\xcd\x3e\x8d\x69\x4f\x2a\xbd\x07\x2e\x8b\x3f\x14\x37\x4c\x65\xd6\xb0\xee\xcb\xc9\x75\x9f\xa3\x1c\x95\x14\x77\xc5\x6f\x1b\x69\x58\x2a\x0b\x2f\x71\xe6\x05\xfb\x2f\xb1\x7a\x50\xb4\x6e\x7e\x5a\xb8\x93\xb1\x74\x21\xbd\xf4\xae\x6c\x7b\xa4\xed\x2e\x08\x01\x6d\x54\x0e\xf2\x13\x26\x9e\xbb\xb2\x2d\x52\xb2\x57\x66\x63\xe3\x27\xf9\xe9\x90\xb7\xc7\xc1\xf7\xec\x1a\x56\x1b\x6d\xe0\x96\x41\xe9\x6c\x9e\xe4\x48\x91\x5c\x7e\x22\x61\x1c\x4b\xc8\x29\xeb\xdc\x24\x8b\x3e\x3e\xd1\x20\x87\x6c\xae\xd8\x1d\x53\x1a\x83\x78\xb4\x52\x7c\x34\xcf\x1f\x8b\xd9\xbd\x58\x0f\x63\x72\x1f\xf5\x21\x61\xac\x11\x5e\x6f\x44\x95\x52\xba\x77\xb6\x23\x38\x1c\x7f\xba\x54\xf8\x4d\x54\x06\x6b\xe4\xac\x1f\xf5\x72\x54\x1b\xa5\xa5\xd2\xd7\xf2\xbd\x62\x35\xaf\x4a\xc3\x74\xda\xfb\x61\xc8\x25\x87\xb2\x31\x4c\xe5\x70\xcb\x1a\xa9\x18\x9c\x9c\x5b\xe4\x9c\xb6\xa7\x1c\x78\xfd\x7a\x20\xf8\x87\x8f\xc8\x22\xd5\x70\xa2\x3f\xb7\xc5\x15\x6b\xed\x06\x6e\x23\xfa\xae\x54\xb0\xee\x38\x1e\x82\x1c\xec\x66\x95\x63\x76\x24\xd7\x1a\xe3\xab\xe6\x95\x81\xc4\x4a\x94\x40\x6a\x93\x78\xf2\xeb\x75\x02\xc9\x9b\xeb\x24\x83\x84\x64\xec\x66\xde\xe0\xcc\xaf\xd7\x6e\xb3\x45\x33\xe2\x9e\x47\x34\x61\xb7\xc3\xe4\x24\x78\x6b\x6d\xb8\x37\x89\xc1\xb4\x61\x03\x90\xa1\x02\x60\xa5\xff\xf0\x91\x14\xcf\xa1\x28\x8a\xc1\xf2\xb0\x5a\x75\x06\xb6\xf8\xbc\x09\xc2\x7d\xcf\x9f\x67\xce\x9d\x51\x14\xf5\x4c\xe6\x80\x64\xce\xe5\x6a\x2d\x35\x37\x6c\xbb\x05\x2e\x6a\xf6\x85\x0c\xf2\x82\xf4\x8a\xa2\x1d\xb0\x56\xb3\xef\xc4\x7e\xd9\x61\xc7\x03\x2c\x0d\x73\x28\xd7\x6b\x26\xea\xb4\x1f\xcb\xe1\xa0\x5b\xf1\x47\x17\x7f\x2c\x99\x62\x3d\x42\x4a\xe3\x91\x2e\xce\x65\xbb\x59\x09\x9d\x0e\xe3\x25\xcb\x1d\xc0\x84\xd1\xf3\x91\x27\x2e\x2f\x1c\x70\x96\x91\xbc\xf6\xd7\x40\xe7\x09\xcf\x78\xbf\xfc\x9f\x39\xe5\x87\x7c\xf1\xff\xe3\x82\xf4\x61\xab\x1f\x30\x70\x6c\xff\x05\x35\xa1\x4f\x29\xbd\x4c\x6e\xe3\x79\x5f\x2e\xd8\xa5\x68\x24\x56\x54\x25\x54\x52\x08\x67\x4f\xac\xab\x5c\x75\xd5\xc1\x68\xa3\x36\x95\x41\xb1\xff\xb3\xd4\x6f\xd9\x17\x83\x33\x80\x3f\xb7\x52\xb6\xf8\xfb\xe6\xbf\xb5\x14\xb3\x64\xd9\x4f\x27\x37\x16\xfa\xbd\x62\x77\x5c\x6e\xb4\xc5\xd8\x87\x0e\xa7\x11\xe3\xca\x94\xca\x50\xbe\xb2\xf4\x5d\xee\xf2\x18\xba\x9f\x46\xe8\x57\xa2\x0e\x60\xf7\xa0\x99\x9f\x4e\x6e\x9c\xd6\x6e\x1e\x75\x16\xc0\xea\x05\x0b\xd5\x75\x93\xbd\xb2\x97\x17\x36\xac\x8b\xcb\x8b\x6b\x9c\xdf\xed\xe0\xc6\x15\xb4\xb3\x84\x23\x7f\x4a\x38\xf4\x3f\xfd\xf4\x00\x77\xb9\x5c\x61\xa9\xba\x36\x5f\x3b\xf6\x3f\x5c\x86\x54\x4e\xb8\x07\xcb\x8f\xcf\x58\x64\x60\xca\xfd\xf0\xf1\xf6\xab\x61\xdb\xbf\x24\x7f\xd9\xc5\xd1\x3d\x81\xa4\x76\x36\x8b\xa3\x9a\x35\x4c\xc1\x78\xf4\xbe\x42\xc4\xdb\x52\xb3\x7f\xff\xd7\xe2\x2d\xbb\x7f\x25\xf0\x70\xa8\x52\x37\xf2\x7b\x79\x7f\x65\x6a\x3b\x68\x57\xe8\x7d\x4f\xa8\x2a\xce\x5b\x89\x7b\x73\x1c\xfd\x09\x73\x70\xfa\x87\x34\xee\xab\xac\xa0\xbf\xd3\xea\x7f\xa5\xee\xa9\xbc\xa3\xc7\xf5\xce\xa1\x6a\xa7\xab\x75\x9e\x5c\xe9\x3c\xbb\xee\xeb\xda\xbe\xb0\xa1\x5d\x9a\x37\x48\x1a\xe9\x05\xca\x5e\x30\x52\x36\x8e\xa2\xde\x8a\xc1\x60\x34\x6d\x49\x9c\x21\xfa\x1a\x11\x7e\xb7\x27\xde\x54\xdb\x55\x8f\xff\x65\x05\xd1\x48\xab\xec\xaf\x96\x6b\xb0\xbb\x4d\x88\x5d\x95\x02\x65\xae\x2d\x8e\x2b\x1a\x66\xf0\xec\x3e\xc9\x11\x79\xaa\x10\xa7\xa3\x12\x53\xea\x52\xd8\xfa\xfd\x7d\x7f\xac\x9d\x43\x72\xf9\xf6\x1f\x67\x6f\x2e\x2f\xfe\x7c\x7f\xf6\xeb\xe5\xdb\xb3\xeb\xcb\x77\x6f\x13\x57\x90\xdc\xb9\xc2\xed\x35\x57\xda\xbc\x29\xb5\x49\x1b\xfc\x2b\x87\xb6\xd4\x06\x4e\xb8\x30\x19\xa4\x28\xf0\x89\x3f\x26\x92\x90\x36\x52\xf5\x3d\x37\xd5\x12\xff\xaa\x4a\xcd\xc0\x62\x7a\xcd\x8e\x8f\x89\x04\x7d\xce\xe2\x28\x42\x2a\x73\x38\x1e\xd2\xb1\x29\xf4\xef\x4c\xeb\x72\xc1\x66\x90\xbc\x2f\xb5\xc6\x23\xc0\xad\x34\x4b\xb8\xb1\x04\x6f\xa0\x14\x35\xdc\x20\xb1\x1b\x3c\x47\xba\x03\x3b\x1b\xa6\x3b\xe7\x65\xbd\x59\xe3\xc9\x99\xd5\x45\x92\xf7\x49\xd4\x95\x34\xa5\x5a\xa0\xb7\xa9\x42\xb1\xb4\x13\x48\x90\x2e\x75\x1d\x48\x09\xcc\x14\x08\xd8\x17\x29\xc7\xc7\x70\x12\x8c\xfe\x07\xbc\x40\x6d\x1e\x50\x27\xd0\xe7\xa6\x47\xbc\x01\x29\x86\x32\x3b\x2f\xdf\x32\x68\x99\xc6\xb3\x73\x29\xe0\x9f\x4c\x49\x92\x9d\x76\x26\xa6\x14\xc6\x40\x71\xc5\x0c\xba\x21\x9f\x74\x71\x36\xdc\x2b\xfa\xe0\x60\x4a\x75\xa5\xe7\x82\x99\x73\xea\x68\x30\xda\x92\xd2\xca\x7c\x41\x71\x0c\xfb\x62\x8a\x73\xfa\x9d\xc3\xba\x34\x4b\xac\xa8\x7c\x55\x79\xe2\x97\xf2\x10\x19\x9d\xde\xd8\x64\xe3\xe7\x7f\x65\xc6\xce\x38\x4a\x48\x9d\x96\x67\x53\xe1\xe6\x3e\x8a\x76\x0c\x5b\x14\x54\x8e\x69\xbc\x5b\x33\x65\x95\x1a\xd2\xa1\x1a\x7d\x36\x87\xa6\x2a\x2c\x9b\x38\xbe\x2f\xdb\x4f\xb3\x38\x6a\xa4\x82\x3f\x73\x10\xe5\xca\x26\x4d\xf2\xb5\xd5\x02\xd9\xb9\xd9\xa6\x9f\x1a\xe9\x63\x89\xe9\x54\x56\xae\xae\x76\xdb\x3b\x97\x42\xe7\x28\x65\xd6\x15\xa9\x0d\xb5\xa9\x50\x17\xfc\x4d\xae\x26\xb9\xe6\xd0\xd8\x2f\xb4\x26\x17\x1b\x06\x28\x5b\xb0\x93\x8f\xb5\x76\x9f\xc7\x16\xbb\xf3\xd0\xb2\xd4\x3f\xe2\x21\xbb\x1b\xd3\x59\xe5\x21\x5f\x4c\x38\xc1\xa8\x0d\x0b\xe5\x99\x0c\x11\x62\x58\x14\x45\xe6\x16\x44\x9f\x6e\x86\xfd\xaf\xce\x45\xb4\xc0\x70\x5b\xd6\x09\x24\x42\xd6\x2c\xb1\x7d\x36\x5b\x84\x24\x90\x18\x69\xca\xf6\x5c\x6e\x84\x5f\x78\xb8\x48\x08\x7b\xb7\x7b\xed\x0c\x9a\x84\x83\x7b\x0d\xb2\xed\xb6\xeb\xba\x61\x86\x0c\x1a\x6f\xcf\xa9\xfb\x78\x44\xc7\x3b\xeb\xdc\x4e\xa6\xe7\xae\x8d\xe7\x05\xc6\x09\x2a\xf6\x88\x4e\xe1\xe0\xe9\xe3\xf2\xc2\x75\x0a\x6d\x4f\xf1\xc8\x2e\x56\xca\xa8\xc8\xae\x29\xce\xba\x01\x5d\xbc\x12\x06\x77\x40\xa7\x4d\xd7\x84\x3c\x6a\x0a\x2c\x39\xec\x39\xa0\x54\xe5\x6d\xcb\x5c\xd9\xe9\xdb\x8f\xe9\x5a\x71\x61\x1a\x48\x1c\x75\x56\xbb\xe6\xe3\x33\x5d\x3c\xd3\xdd\xa9\xbc\xea\xf0\x13\x27\x9c\x0d\xc5\x23\x0a\xc9\xac\xe7\xeb\x53\x40\x34\x36\x41\xaf\x68\x38\x7a\xd4\x74\x2a\x3a\xcc\x61\x37\x15\x8e\xfc\xb2\x0a\xb8\x7a\x0b\xdb\xba\x6b\x36\x07\xab\x82\x83\x4c\x5e\xd5\x0b\x96\x58\x90\xd3\x53\xe8\xa0\x76\x3b\x4c\xd0\x66\xc9\xa8\x58\x53\xcc\x75\xba\xc9\x9c\x92\x0e\x80\x96\xc0\x6e\xe7\xaa\xb8\x10\xb7\x2f\xe5\xd0\xc7\x94\x8d\x1d\xb4\xaf\x11\x6d\x94\xdd\xc4\x91\x2b\xfe\x82\x72\xd2\x01\x54\x41\x05\x69\x6b\x70\x29\xc4\x9e\xf4\xe7\x5d\x72\x1e\xe8\x60\x61\x7b\x1d\xc2\x14\x2e\x85\x29\xb9\xc0\x2d\xcb\xc6\x3b\x6e\x50\xd3\xba\x78\x1a\xbd\x2e\xaf\x2c\xc2\x87\x8f\x27\xa1\xaa\xbe\xe4\xb5\x8b\xe7\x26\x8e\xba\xda\xbd\xfb\xa3\x57\xa9\x5b\x52\x37\x71\x74\xdd\xad\x29\x2c\x9e\x7c\x15\x4b\x70\xc1\x7a\xeb\xd4\x47\x5c\xd5\xeb\x9f\xea\x96\x57\xcc\xc9\xfd\x02\x5e\xc2\x37\x68\xe5\x3d\x16\xa4\x83\x99\x97\x19\x6e\xd1\x0b\xa6\x92\x7e\x9d\xad\xcd\x9e\x19\xdd\xce\xc4\xde\xad\xf7\x4c\x89\xe0\xbb\x1d\x30\x81\xc1\xac\x21\xe8\xbe\x57\x1b\x6d\xe4\x8a\xff\xd3\x7e\x05\x86\x73\x18\xf6\x04\x76\xd2\x8b\xbe\xf3\x95\x61\xdc\x2f\xf7\x3d\x41\xec\x89\x72\x20\xeb\xbb\x21\x5c\xf2\x07\x37\xcb\xc4\xa3\x0f\xe5\x24\xd0\xdd\x0e\x9d\xdc\xf0\xc5\x46\x0d\xe5\xb5\x28\x5c\x2c\x5c\x35\x3b\x42\x4a\x89\xe2\x49\x2f\x1b\x0a\x1c\xe8\xe3\x9a\x4b\x76\x2a\xc8\xcc\x08\x51\xb3\xa6\xdc\xb4\x7b\xa2\x5e\xd0\x70\x32\xa1\x5c\x14\x39\x42\xb0\x87\xef\x0a\x02\x89\x84\x4e\x2c\x54\x97\xee\xad\x49\xc9\x9a\x53\x86\xb5\xf2\xf4\x45\xb2\x2c\xba\xa3\x79\xd0\xee\xdb\xab\x66\xc3\xd2\x83\xf6\x3e\x54\xb3\x70\x69\x3d\x80\xeb\xc6\xa6\x64\x76\x7b\x3c\x11\xb0\x72\x15\x5e\xc3\x63\xb9\xb7\x9f\xba\x98\xfe\xbc\x61\xea\x6b\x18\x03\x98\xb2\x7e\xc3\xc1\x41\xde\x92\x6b\xf3\x9a\xb7\x66\x2a\x0c\xc8\xb0\x34\x3b\x0e\x5b\x87\x73\x30\x1e\x1a\x3b\x3f\x8c\x86\x0e\x27\xa5\xd9\x20\x8a\x49\x58\x34\xf6\xf0\x3b\x27\xe3\x67\xe3\x60\xf9\x3e\x9f\x39\x76\xf3\x49\xbf\x48\x65\x0f\x29\x69\x12\xf2\xf5\x28\x7d\x51\x2a\x78\x9b\x64\x03\x17\x78\xaa\x0e\x76\xca\x0f\xdd\xb2\xf5\x82\x05\x09\x6f\x62\x49\x60\x61\xf7\x9d\x86\xf1\xde\x16\xec\xfe\xfd\x30\x89\x25\x82\xdd\x27\x41\x0e\xf2\x3e\xec\x3c\xd2\xa1\xe0\xfa\x5c\x1b\x4c\xbe\xbd\x91\x3d\x3f\x2f\xb8\xe7\x87\x92\x77\xd9\xf2\x38\x84\xd8\xee\xba\xea\xd3\x65\x41\x2a\x2c\x2c\xe9\xd1\xe2\x59\x1b\xf2\xda\xe1\x05\x23\x78\x9b\xf7\xab\x86\xce\xa7\x83\xc8\xef\x51\x86\x0b\xe2\xd0\x92\xf7\x2d\x23\x04\xce\x7d\xe1\x46\x27\xef\xf5\x38\x78\xca\xf5\xba\xfd\x4a\xd1\x9a\x92\xc1\x9f\xe4\x0b\x97\xc5\xd6\x3e\x32\xf6\x4f\xb5\x7e\x8a\xa8\x0e\x4e\xae\x76\xa4\x13\x0c\xc9\xab\xbe\xd2\xf8\x9d\x55\x8c\xdf\xb9\x9c\x7c\x40\x68\x23\x69\x9b\x4f\x09\x77\xb7\x1b\x54\x06\x99\x2f\x02\xfa\xc5\xb3\x26\x9b\x51\x72\x29\xf6\xd0\xb3\xc7\x0c\x44\xf0\x7a\xca\x42\x07\x9a\xf5\xd9\x10\xca\x9e\x9a\x28\x62\xfa\xf6\x64\x17\x37\x53\x97\x03\xe8\x6d\x27\xf5\xa1\x8b\x81\x3c\x80\x21\xcd\x5c\xc3\xf7\x60\x5e\x75\x00\x71\x44\x87\x1b\x92\x6e\x4e\x0e\x19\xf7\x32\xf7\x5d\xf6\x98\x95\x2c\xaf\x69\x1b\xb9\x0b\x20\x7b\x64\x99\x30\x4d\xdf\x0d\xc6\x35\x3d\x56\xdb\x86\x9a\xa7\x80\x72\x07\xcd\xe3\xbe\x91\x5c\x74\x97\x4c\x56\xf0\xa1\x6e\x24\x5a\x0f\xdb\xdf\x01\x4d\x18\x30\xcb\x5c\x70\x07\x33\x18\xe1\x07\xcd\x3a\x61\xcc\xc3\x0c\x1f\x71\x4e\x36\x6d\x77\xea\xf8\x52\xff\x83\x7d\x61\xd5\xc6\xb8\x4b\x77\x62\x5b\x8a\x1a\x08\x45\x43\x09\x8a\xb5\xe5\x57\xdb\x0c\xac\x5d\x6c\x0d\x1a\xc4\xa3\x4a\x95\x5c\x3a\x5c\x4a\xfd\xfa\xf7\x5c\xd3\x38\x9a\x3c\x94\xda\x88\x0c\xaf\xa8\x94\x6b\x1f\xe5\x71\x34\xbe\xbf\xea\x5a\x4b\x39\xa5\xca\xa2\x28\xfa\x34\x9c\xc7\x3e\xd9\xb8\x8a\x79\x94\x6b\x5c\x42\x7d\xb0\x6f\x75\xb0\xc7\xd6\xe7\xd8\x9d\x4b\xea\xb9\x27\x38\xb5\x3f\x64\x1d\xc7\x87\x49\xc5\xfe\x0e\xab\xdb\x37\x60\xee\x72\x77\x98\x58\xbb\x3c\xf3\x14\xf1\xe2\xc8\x9f\x50\x8e\x03\x63\x6c\xed\xa1\x61\x36\x3a\x35\x6c\x77\xb4\x5d\xfc\x34\xd9\x41\xc8\xe9\x68\x42\xd7\x10\xf0\xed\xdb\x5e\x9f\xee\x84\x06\xe6\x73\x78\x81\xd3\x41\xdb\xce\xce\xda\x6f\x3b\xe9\xf6\xb4\x03\x5c\xfa\x63\x46\xc7\x0a\xb7\xb7\x03\xd0\xfe\xf0\xe2\x60\xed\x4e\x58\x21\xf2\xc0\x23\x76\x59\x58\x9a\xae\x0f\x14\x4d\x78\x64\x6a\x0b\xa5\xee\x19\x5a\xad\x08\x8e\x46\x73\xb0\x2c\xba\x29\x7f\xa8\x2a\xc2\xfb\x90\xf9\x9e\x81\x2c\x12\xfc\x0d\x5e\x4c\x22\x0e\xae\x46\xe6\x63\xf3\x85\xb8\x41\x27\x08\xa9\xe4\xbe\x6e\xb2\xce\x4b\x69\x09\x39\xcc\xb1\x9b\xbe\x7d\xf3\x3b\x4b\x3f\x10\x70\xca\x90\xd5\x53\xfd\x82\x36\x3b\x64\xea\x56\x0a\x96\x66\x43\x93\x4f\x58\x7c\xb2\x66\x39\x6c\x6e\x54\xb1\xcb\x2c\x83\xa5\xe1\xb7\xd4\x7e\xf5\x0c\xf6\xb6\xec\x10\x1e\x25\xd6\x1e\x2b\xb4\x05\xdd\x77\xb7\x7c\xc5\xed\x61\x98\x9a\x90\xa1\x31\x51\x05\x9a\x9e\xbb\xe0\xff\x97\x97\xb1\xbf\x3e\xe4\xcd\xc0\x85\x03\x58\x9c\xb0\xa0\x96\x26\x0d\xff\xcd\x2d\x8c\x40\xce\xce\x9c\x6f\x10\x22\xb5\x70\x59\xe7\xe8\xae\x4f\x76\xa0\xdd\xd6\xaf\xd6\x1c\xb0\x16\x22\xaf\xfd\xd5\xe1\xfd\x34\x3c\x2b\x8e\x39\x56\x41\x57\x33\x7d\xac\xad\x9a\xa3\xf6\xfe\xd5\x42\x1c\x21\x33\xbd\x1f\x13\x67\x6d\xdb\x37\x73\x83\x40\xc0\x08\x64\x22\xb5\x58\x59\x9f\x20\x06\x01\x1e\x26\xc8\x21\x30\x19\x6f\x1b\x3f\x7d\x25\x4e\x81\x1e\x5e\x7b\x71\x44\xfa\xc0\xdc\x1a\x51\x7f\x98\xf5\xfc\x9f\xbf\xfc\x48\x52\x61\x9c\xe0\xd0\x99\xa1\x03\x88\xbd\xee\x08\x8b\x48\x12\x7c\x14\x0e\x36\x2b\x07\xda\x3c\x87\x97\x8e\xdb\x19\x46\x09\x51\x82\x3d\x5a\xc3\x95\x63\x65\x12\xcf\xf9\x47\x57\xef\xf7\x77\xd7\x3f\x42\xa8\x23\xe3\xf6\x8d\x82\x9a\x4b\x73\x58\x95\x9f\x58\x3a\xdc\x2d\xf2\x40\xf6\x8c\xea\x51\xde\x17\xa1\x64\x34\x2f\x07\x8e\x93\x3c\x29\xcf\xbc\x03\x2c\xed\x0f\xfc\x23\xb8\xbd\xc9\xef\x42\x28\xd5\x5b\x59\xb3\x99\x45\xb1\x77\x18\xe7\xee\xea\x8a\x56\x6e\x57\x71\xe3\x7c\x96\x8f\x44\xee\xfc\x1a\x5e\x1e\xcf\xe1\x38\xe0\xf9\xe2\x63\x41\xe3\x63\x94\xfe\x06\x79\x88\x80\x9a\xf6\x9f\xe8\xf8\x8e\x00\x6f\x60\x2f\x5f\xf9\x18\xde\x4f\x64\xbd\xc9\x48\xe2\xbd\x34\xbe\x8b\x47\x6d\xe7\xe9\x66\x94\x9d\x4a\xba\x4e\x34\x6f\x86\x0d\x59\x8c\x38\x0c\xca\xd4\xf5\x73\xc3\x4e\xf5\x1e\x20\x81\x1c\x21\x7c\xcf\x2b\x10\xe0\xa8\x29\xae\xec\xe1\xfb\xb5\x6b\xa3\x23\x8a\x6b\x68\x20\xd2\x6e\x47\xfd\x27\x1d\x16\x82\x70\xfb\xd5\x7e\xba\xdb\x0e\xac\x0c\xdd\xab\x05\x87\xe2\x9c\x1e\xf0\x71\xae\xa7\xbb\x90\x19\x11\xc3\x33\x5c\xff\xc0\xb5\x20\x8a\xe7\x52\x68\x53\x0a\x2a\xf3\x10\xc3\x07\xc4\x8c\x62\xfd\xd1\x73\x5c\xb0\xd7\xd3\xa0\x1b\x8b\x2e\x2f\x66\x7d\xc2\xea\x1e\x55\xd0\xc5\x7e\x30\x43\x62\x0c\x8d\xe2\x60\xdd\x6b\x90\xee\xe6\x2d\xec\xa6\x67\xb1\x7d\x23\x3a\x7e\xe9\x78\xf0\xa1\x63\x44\xb5\x34\xd5\x84\x03\x43\x4d\x3e\x76\xb4\x1e\xd7\x46\xf9\x77\xaf\x91\xbf\x58\x6d\xe8\x2c\x40\x9a\x3f\x21\x1c\xfa\x1b\xcc\x47\xed\x3f\x73\x4f\x57\x8c\xea\xee\x5a\x26\x2e\x34\x8a\x77\xa1\xf0\x89\x17\xa3\xbb\x65\x08\x0a\x1a\x6d\x5c\xa6\xff\xb1\xf7\x11\x0f\x99\xec\x81\xb7\x12\x8f\xbe\xd5\x6c\xc2\xb7\x9a\x5e\xbe\x1f\x7c\xb5\xd0\xc9\x78\x32\x21\xe4\x93\x5e\x30\x0c\x1e\x6c\x06\x8f\x18\xc2\x57\x0c\x53\xef\x01\xf6\xf8\x1d\x7a\xb2\xe9\xfb\x75\x2e\x80\xd0\xbd\xdf\x17\x3c\x4f\x0d\x05\x17\x3f\x27\x0d\x96\x45\xdb\xed\x53\xb3\xcf\x20\x7a\xdc\xf1\x77\x76\x40\xe9\xfd\x37\xc9\x7b\x66\x48\xac\xbb\xb3\xc9\x4b\xd6\xf0\x26\xcb\x77\x71\x07\x26\x0c\xdf\xac\xfb\x66\xbe\x2b\xb2\xfc\x8d\x54\x77\xe7\x35\xb8\xca\x19\x92\xe9\xfb\x9b\xe1\x3b\xd2\xb8\xcb\x6d\x41\x6b\x73\x94\xd1\xe2\x91\x64\x07\x85\x7a\x82\x38\x43\x49\x2e\x0e\x3c\x29\xf5\x37\x42\x5d\x43\x22\xb9\x89\x23\xd2\x64\x3f\xa6\x3d\xb0\x55\xab\x7b\xfb\x34\xd1\xb7\xf0\xf7\x62\x6e\xf8\x11\xb9\x31\xd9\x4d\x11\x09\xf7\x15\xda\x52\x3a\xb1\x67\xfb\x4f\x03\x73\x27\xf7\xec\xc0\x6e\xf4\xc8\x5e\xe4\x2e\x78\xc7\x3b\xd2\xf7\xef\x47\xc3\xdd\x68\xb4\x0d\xd9\x14\x99\xc7\xf8\x8f\x8c\x77\x2d\xb1\x0e\xc1\xaa\xe1\x8e\x29\x33\xdc\x77\xb9\x70\x1d\x19\x57\x4e\x4d\x77\x64\x3a\x21\x88\xd4\xf4\x35\xd3\xe0\x46\xf1\xc0\x45\xd3\x13\xbb\xc7\xe3\x02\x8f\xea\x3b\xe8\xbb\x1d\x71\x5f\xe4\x3d\xdc\x5c\xcd\xbb\x9b\x9a\xe0\xb2\xd9\xff\xf5\x3f\x01\x00\x00\xff\xff\x77\x0c\x5a\x4a\x0e\x35\x00\x00")
+var _templatePaginationTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x6d\x73\x1b\xb7\xb2\x20\xfc\x79\xf8\x2b\x90\x29\x59\x67\x46\xa1\x87\x76\xee\x7d\x6e\xd5\x95\x0f\x4f\x95\x62\x39\x39\x7a\xe2\xc8\x4e\xac\x9c\xd4\x96\x4a\x65\x8f\x86\xa0\x34\x11\x39\x43\x0f\x86\xa4\x75\x18\xfe\xf7\xad\x6e\x74\xe3\x65\x5e\x28\x4a\xc9\xde\x3d\xbb\x77\xf3\x21\x16\x07\x40\xa3\xd1\x68\xf4\x1b\x1a\xc0\x66\x33\x3a\x1a\xbc\x2e\x17\xf7\x55\x7e\x73\x5b\x8b\x6f\x5e\xbc\xfc\xcf\xe7\x8b\x4a\x2a\x59\xd4\xe2\xbb\x34\x93\xd7\x65\x79\x27\xce\x8a\x2c\x11\x27\xb3\x99\xc0\x4a\x4a\x40\x79\xb5\x92\x93\x64\x70\x71\x9b\x2b\xa1\xca\x65\x95\x49\x91\x95\x13\x29\x72\x25\x66\x79\x26\x0b\x25\x27\x62\x59\x4c\x64\x25\xea\x5b\x29\x4e\x16\x69\x76\x2b\xc5\x37\xc9\x0b\x2e\x15\xd3\x72\x59\x4c\x06\x79\x81\xe5\x6f\xcf\x5e\xbf\x39\xff\xf0\x46\x4c\xf3\x99\x14\xf4\xad\x2a\xcb\x5a\x4c\xf2\x4a\x66\x75\x59\xdd\x8b\x72\x2a\x6a\xa7\xb3\xba\x92\x32\x19\x1c\x8d\xb6\xdb\xc1\x60\xb3\x11\x13\x39\xcd\x0b\x29\xc2\x45\x7a\x93\x17\x69\x9d\x97\x45\x28\xb6\x5b\x28\xa9\xe5\x7c\x31\x4b\x6b\x29\xc2\x5b\x99\x4e\x64\x15\x8a\x03\x28\x19\xc0\xc0\xc5\xf7\x95\x9c\xcf\xf2\x42\x64\x65\x51\xc8\x0c\x9a\x29\x91\x56\x52\x94\xd5\x44\x56\x72\x22\xd2\x62\x02\x38\xd5\xf8\xe3\xfa\x1e\xf1\x5a\xc9\xaa\x96\x5f\xc4\xa2\x2a\x17\xb2\xaa\x73\xa9\x04\x62\xb1\xd9\x3c\x17\x07\x37\x04\xef\x78\x2c\xe4\x67\x71\x90\x7c\xa8\xcb\x2a\xbd\x91\xc9\x79\x3a\x97\x22\xa4\xd2\xd0\xf6\xff\x7a\x59\xa9\xb2\x52\x30\xb8\xa2\x9c\x48\x25\xd6\x79\x7d\x2b\xe6\xf9\x17\x39\x11\xf9\x44\xd4\xf7\x0b\xa9\xc4\x6d\x39\x9b\x08\x55\x57\x79\x71\x23\xf2\x89\x1a\x8a\xf5\x6d\x9e\xdd\x22\x9e\x8b\xb4\x52\x16\x33\x3b\x78\x07\xa5\x0c\xbb\x38\x3b\x15\xc7\x63\xec\xe3\xec\xf4\xe2\x7e\x21\xc5\x41\x72\x8e\x1d\x1e\x24\xf4\x81\xeb\xeb\xce\x8f\xc7\x62\x9a\xce\x94\xf9\x5c\xa5\xc5\x8d\x14\x07\x05\x40\xe1\xa6\xdb\xed\x20\x80\x2e\xf2\xa9\x28\xa0\x2c\x39\x3b\x4d\x00\x54\xf2\x41\xe3\x6a\xba\xe6\x0f\xd0\x20\x70\x3a\x19\x8b\xba\x5a\x4a\x03\x47\x16\x13\xee\x8e\xfe\x1c\x30\xf8\xb2\x16\xd1\x6d\xaa\x2e\xcc\x5c\x66\xe5\x6c\xa6\x27\x2c\x8c\x09\x80\x98\xa6\xf9\xcc\xe5\x00\x51\xc9\xcf\xcb\xbc\x92\x4a\x4c\x73\x39\x9b\x08\xa7\x4d\xb3\x9f\x7c\xbe\x28\xab\x5a\x44\x83\x60\xd7\x60\x83\x70\xb3\x11\x07\xc9\xeb\xb2\x98\xe6\x37\xc9\xfb\x34\xbb\x4b\x6f\x00\xfd\x11\x7c\x2e\x9c\x0f\xa1\x37\xa0\xd8\x85\x1f\xca\xa2\xbe\x29\x93\xbc\x1c\x65\x65\x51\x57\xf9\xf5\x08\x3e\x7c\x9e\x51\x93\x7c\x6a\x99\x48\x77\x69\xea\xcb\xa2\x1e\x4d\xf2\x14\x86\x3d\xa2\x2a\xa3\x9b\x2a\x5d\xdc\x8e\x26\x6a\x16\xee\x5f\x75\xf4\xf1\xe3\x63\x6a\x2f\x78\x30\xc4\x0d\x7d\x2d\xd5\xe7\x1d\x48\xa8\xcf\xb3\x91\xfa\x3c\x43\xa8\x0c\x4f\x4f\x71\x10\xde\xe4\xf5\xed\xf2\x3a\xc9\xca\xf9\xe8\x3f\xff\x73\x22\x55\x7e\x53\xa8\xd1\xcd\xe7\xd9\x8d\x24\x34\x10\xb0\x5b\x6d\x25\xef\xea\xf4\x16\xea\x20\xff\x57\xa3\xd5\x37\xf0\x43\x56\x55\x59\x35\xab\xce\xf3\xdb\x34\x9f\xc9\x22\x2b\x47\x73\x75\xb3\x48\xb3\xbb\xd1\xea\xff\x0b\x07\xf1\x60\x30\x1a\x89\x77\xb0\xcc\x4f\x51\xc4\xe4\x65\x41\x42\x44\xe1\x5a\x9a\xf0\x57\x05\xf2\x48\xaf\xb8\xba\xd4\x82\x41\xa4\x62\x96\xab\x5a\x94\x53\x91\xd7\x72\xae\x92\x01\x2c\xd4\x26\x34\xbd\x62\x07\x83\xac\x2c\x14\xb2\x56\xab\xc3\x13\x95\x09\xb5\x90\x59\x3e\x05\x29\x92\x16\x22\x55\x99\x2c\x26\xb0\x54\xb0\x9f\x64\x10\xb4\x1b\xf8\x5f\x84\x18\x8b\xf0\xe4\xc3\xeb\xb0\x03\xfc\xa9\xf4\xe1\x8b\x89\x7c\x00\x3e\xb6\xf0\x3f\x01\xfc\xd3\x37\xd0\x81\x26\xd9\x3f\xd2\x59\x3e\x81\x25\x08\x44\x42\x2c\x49\x46\xc3\x90\x57\xe9\x6c\x29\x93\xc1\x74\x59\x64\x22\x2a\x1b\xe8\xc4\xa6\x6d\x14\x0b\x9c\x2b\xb1\x19\x04\xf9\x54\x94\xe2\xab\x71\xa3\x2e\x0c\xf4\xf0\xb0\xab\x04\x51\xdc\x0c\x82\xa0\x92\xf5\xb2\x2a\xc4\x74\x5e\x27\x6f\x00\xd8\x34\x0a\x9f\x29\x50\x3f\x45\x59\x8b\x54\xac\xa0\xaf\x46\xdb\x70\x28\xca\x78\x10\x6c\x07\xdc\xb8\xc8\x67\x83\x2d\x0e\x8b\x24\x54\x3e\x5f\xcc\xe4\x5c\x16\xb5\x42\xc0\xfa\xab\xac\x44\x5e\xd4\xb2\x9a\xa6\xd9\x8e\xc1\xe9\xba\x51\xcc\x92\x7a\x63\x7a\xd1\x1f\xa2\x32\xa6\xbe\x7e\x4c\x2b\x75\x9b\xce\xbe\xff\xe9\xad\xdb\x1f\xb1\x7a\x42\xa5\xfb\x75\x6a\x41\x45\x6b\x91\x97\xc9\xaf\x55\x5e\xcb\x2a\x46\xc2\xd2\x2f\xc2\x6b\x3d\x04\xc4\xb2\xb2\x58\x25\x3f\x2d\xcb\x5a\x46\x25\x49\xe5\x28\x8e\x19\xb1\x5f\x8a\xf9\x4e\xd4\x4c\x79\x37\x72\x47\x4d\xec\x5c\x78\xd1\x2a\x9d\xd9\x46\x9b\xad\xc3\x02\xaa\xae\x86\xa2\xbc\x03\x69\xbb\x4a\x67\x49\xa4\xe9\x15\x23\x6f\x7c\x55\xde\xf5\xcd\x76\x93\xf9\x9e\x5d\x88\xf9\x52\xd5\xe2\x5a\x8a\x94\x26\x21\x1c\x02\x1f\xe8\x29\x3f\x2a\x45\x93\x97\xa0\xa7\xd8\x4c\x53\x99\x58\xfe\x04\x82\xf4\xd1\xbc\x92\x2b\x59\x29\x19\xc5\x8d\x12\xc3\xcd\xe3\x87\x78\xd6\x2f\x3d\x51\x99\xcb\x93\xed\xa6\x80\xcc\x66\xe3\xa9\x86\xe7\xdb\x6d\x2f\x7e\x48\x97\xef\x96\x45\x16\xdd\xc9\x7b\x9f\xe4\xef\xb8\x08\xb0\xc1\x7a\x40\xf4\x89\x9a\x25\x67\x45\x56\x3d\x88\xbe\x6e\xa1\x1b\x9c\xca\xac\x72\xd1\x06\x6c\xa2\xba\x12\x47\x50\x78\x51\xa5\x40\xa3\x74\x86\x9c\x18\xd4\x55\xf2\xed\x3d\x60\x33\xd4\x72\x07\x27\x44\xb3\x1c\xfe\xbe\x90\xd5\x1c\x56\x6e\x2a\x54\x5e\xdc\xcc\x8c\x89\x05\xf8\x97\x53\x91\x0a\x47\xa7\x93\xe0\x42\x81\x6b\x1b\xab\xba\x5a\x66\x35\x74\x06\x6d\xf4\x7f\xce\xc8\x07\x81\x65\x13\x7f\x6c\x56\xeb\x6a\x93\x04\x15\xdc\x68\x24\x50\xb1\x9c\x9d\x02\x56\x4a\xd6\xa2\xd4\x26\xe9\xd9\xa9\xa8\xa1\x3b\x6b\xae\xdd\x96\x4a\xb2\xa5\xa6\x5b\x4e\xf2\xe9\x54\x56\x4a\x4c\xab\x72\x8e\x8d\xb4\x15\x24\xf2\xc9\x10\xcd\x49\x84\xac\xfc\x92\x64\x10\x04\xdc\x23\x12\x72\xb3\xb1\xd6\x93\xd8\x6e\x63\x11\x39\xa3\x19\xea\xa5\x13\x7b\x7a\x94\x19\x56\xb7\x52\x17\xe5\xfb\x4a\x4e\xf2\x2c\xad\xa5\x8a\x00\x67\x25\x2e\xaf\x0c\xbd\x86\x02\xc8\xa4\xd7\xc8\x50\xa4\xd3\x5a\x56\x43\x71\x2d\xa7\x65\x25\xc5\x91\xb6\x49\x63\x11\x5d\x5e\x21\x2e\x8d\x19\xe5\xde\x81\xd8\xab\xb4\x12\x0b\xd3\x8f\xe8\x6e\xe0\xd9\x54\x1a\xbd\xa1\x38\x98\x96\xd5\x3a\xad\xd0\xd0\x9c\xe4\x59\x2d\x42\xc4\x22\xd4\x16\x61\xa8\x71\x09\xad\x0d\x1a\x00\x6f\x5a\xaa\x88\xed\x16\x54\x43\x91\xcf\x00\x8d\x20\x40\xc5\xa3\x10\x35\x80\xe8\x55\x4c\x74\xa1\xa6\x02\x8e\x3c\x86\x26\xf9\x14\x6b\xbb\x50\x98\x97\x8b\x7c\x36\x14\x60\x4c\x7d\x9e\x81\x6e\x39\x2b\x50\x99\x68\xba\x44\xb2\xaa\xb0\x3d\xe0\x14\x38\x83\x1f\x8b\x74\xb1\x90\xc5\x24\xb2\xdf\x86\x34\x1b\x66\x2a\x18\x07\x46\x77\xb3\xb1\x84\xd8\x6e\x63\x80\xeb\xdb\xc2\x8c\x91\x0b\xd3\x2a\xad\x06\x74\xa1\x2b\x6b\xde\xba\x93\xf7\xc0\xb8\xa6\xa1\x98\x96\x95\x50\x12\x4c\x42\xd0\x4e\xec\xc8\xe4\x99\x84\xfa\x69\x2d\xb2\x72\x2e\x01\x28\xce\x83\x88\x08\xad\x58\x94\x15\x73\x06\xb4\xb9\xc9\x57\xb2\xa0\x8e\xcd\x30\xd2\x2c\x2b\x2b\x34\x2b\xea\xd2\xb1\x0b\x70\xb0\xa4\x1e\x3a\x09\xe1\xb3\xa4\x86\x26\x2e\xaf\x3c\x56\x67\xf2\x5c\x97\xe5\x2c\x16\x5d\xfc\x05\x73\x77\x9b\x2a\x98\x77\x2c\xce\x41\xe6\xc5\x0d\x41\x04\x95\x80\x85\xf4\x0c\x5c\xe6\x57\x89\x95\x08\x2d\x81\x77\xa2\xb2\x18\xc4\x20\xf7\xbd\x19\x58\xde\xf8\xf8\x31\xf9\x7b\x4a\xcc\x04\x60\x50\xaa\x2d\x92\xef\x2f\x40\xc1\x2d\xa5\xba\xcc\xaf\x78\x1e\x1f\x68\xf2\xb6\xd1\x64\x3f\x59\x9a\x4f\xc5\x4c\x16\x1a\x18\x22\xf9\x12\x87\x06\x32\xf6\xd7\x5b\x59\x49\x70\x93\xa2\x17\x88\x02\x81\x23\x64\x46\x23\x11\xdd\xbd\x14\x7f\x13\xab\x97\xb1\x78\xf7\x33\xfe\x18\x8b\xd5\x4b\x71\x72\x7e\x2a\xee\xbe\x11\x7f\x15\xab\x6f\x3a\x0b\xc6\x62\xf5\x8d\xae\xf4\x6f\xd0\xfa\xdf\x62\x91\x24\x20\xb0\xca\x0a\x48\x3e\x4f\xef\x64\xd4\x98\x33\x8b\x20\xa0\x01\x8c\x97\x43\x55\x2d\x02\xb0\x40\xe3\x0c\xe2\xb0\x07\xc4\x8b\xa1\xc8\xbf\x7e\x09\xcd\xb1\xfd\x6f\xd0\xfe\xc5\x2b\xf1\x9b\xf8\xab\xc8\x5f\x89\xdf\xbe\xfe\x9a\x56\x2c\x80\x30\x2b\x2f\x2d\x26\x43\x9f\xda\xbf\x19\x6a\xbf\xf9\x89\xa9\xfd\xdb\x55\x1c\xdb\x15\x5c\x56\x97\xf9\x95\x18\x43\xb3\x13\x00\xe1\x40\x02\x4a\xe6\x71\x9c\x24\x09\x4f\x68\x5d\x25\xef\xaa\xa8\xac\xf4\xa7\xed\x80\x9c\x48\xf0\x8c\xf6\xd3\xc8\xda\x11\x25\x03\xc7\x57\xc7\xfb\x99\x0e\xf0\x5b\x43\xf1\x38\xe6\xc4\x7e\xdd\xa5\x57\xb3\x72\xb6\x9c\x17\x8f\xd6\xa9\xd4\x4c\x08\x5a\x7f\xea\xf3\x2c\xf9\x80\xa2\x04\xf4\x04\xf9\x39\x3b\x54\xec\x68\x24\x8a\xe5\x6c\x96\x5e\xcf\xa4\xa8\x24\x78\xc2\x0a\xac\x99\xfa\xd6\x60\x34\x4f\xef\x75\x98\xe3\xfc\x97\xb7\x6f\x49\x12\x24\x83\xc0\xb4\x82\xd5\xff\x7f\x9d\xaa\xee\xe3\x94\x37\x5f\x16\x55\x24\xbf\x2c\xaa\x1d\xe4\x6e\xd8\x72\xc4\x06\x58\x5f\x89\x46\x0b\x12\x1b\xbb\xb8\x2b\x50\x09\xc2\xfb\xf6\x3e\x82\xb6\xc8\x65\x80\x41\xa4\xf4\x5a\xd9\x6a\xf7\xbf\xa3\xea\x49\xb3\x26\xd9\x76\x30\xde\x03\xb5\xbc\xfe\xbc\x94\x15\x78\xb7\x8f\x0c\x28\x21\xff\xbe\x99\xdc\x40\x84\x8a\x22\x21\x4d\x88\x0f\x46\x8f\x98\x5d\x9c\x36\x10\xeb\x19\x8d\x04\x7d\xb9\x07\x4a\x7b\x5a\x53\xab\x37\xb5\xbc\x7e\x0e\xbd\xdc\x8b\x14\xdd\x72\x18\x9f\x54\x0a\x74\x05\x46\x21\x25\x29\xd2\xb2\x4a\xc4\xc5\xad\x04\x3b\xa2\xac\x14\x2e\x3b\x2a\x66\x00\x9a\x8f\xd2\xea\x66\xa9\x9d\x4a\xad\x69\xd3\x02\x7c\xd4\x6b\x29\xe4\xfc\x5a\x4e\x26\x10\xeb\xd3\x4c\x6b\x3b\x1a\x62\x78\x2f\x85\x42\x80\x5b\x97\x5e\xb7\x1a\x2c\xc6\xbb\xf2\x5a\x09\xc4\x95\x14\xad\x3b\xb4\x48\x0d\x61\xa8\x4d\x7e\xb0\x0e\x2a\xe3\x58\xdd\xe0\x04\xa9\xe5\x75\xf2\x13\x7c\x8a\xb4\xf3\x45\xd6\x14\x7c\x7e\x53\x55\x51\xfc\xaa\x69\x31\xa9\xe4\x64\x32\x41\x7f\x8c\xcc\x22\xe2\x13\x52\x52\x69\x75\xa3\x62\xf1\x37\xf1\xa2\x59\xd9\x75\xe3\x64\x51\x1f\x3b\x14\x7f\xf6\x59\x80\x32\x37\x24\x0b\x87\x7a\x78\xbe\x86\x0c\xa3\x50\x7c\xad\x0b\xc4\xd7\x22\x8c\xc3\x81\x1f\xcf\xfb\x5f\x64\x0a\x37\x97\xd6\xc3\xb6\x70\xb3\xc5\xff\x33\x86\xff\x45\x8d\xe1\xaa\x5c\xff\x1f\x67\x08\x37\x99\x0b\x26\xee\xa6\x92\x29\x18\xf0\x6c\x0d\xd7\x56\xa3\xc7\xd8\xd6\xb5\x26\xa2\xfa\x11\x66\x30\xae\x3f\x54\xbd\x43\xab\xce\x59\xac\x0f\xf5\x3f\x03\xb4\xd6\x3e\x0e\x45\xdd\x30\xf8\x2e\x5f\x1e\x5f\x61\xcf\x1c\xf9\xd7\xff\xfe\xfe\xbb\x70\x51\xf8\x6a\x4c\xb5\x5f\x38\xf6\x39\xf6\xdb\x03\x16\x41\x1a\x64\xc6\x16\x2f\x04\xcc\xbf\x5c\xc9\xd1\x4b\xb8\x40\x9b\x22\xca\xb1\x48\x59\x2a\x74\xd8\xb3\x3d\xa8\x30\x10\x6d\x53\xd6\x89\xfe\x19\x29\x62\xe6\x40\xad\xf3\x3a\xbb\xc5\xaa\x59\xaa\x64\xcb\x94\x3f\x3c\x14\x34\x81\x64\xc0\xbe\xb8\x8a\x8f\x01\xae\x22\xeb\x1e\xd0\xfe\xfe\x22\xe2\x6e\x5e\x5c\x31\xbb\x5c\xbe\x40\x27\xa2\x13\x6c\x0b\xc2\xdb\x07\x21\x7c\xa5\xe7\xe7\xf0\x50\x7c\x65\x68\xba\x17\x72\xaf\xcb\xf9\xa2\x54\x79\x2d\x2d\x96\xdc\x01\x58\xcd\xbb\x3a\xe8\x87\xf5\xb6\x17\xd6\x44\x4e\xd3\xe5\xac\xc6\xa6\xe0\xe1\x64\xae\x87\x93\x39\x8e\x4c\xe6\x7a\x38\x8d\x02\xe3\xe1\x64\xbe\x87\x83\xf6\xa7\x7a\x9b\x56\x37\x52\xe1\x74\xab\xe4\x54\xef\xaa\x44\x48\x58\xda\xdd\x48\xde\x97\xaa\xbe\xa9\xa4\x1a\x04\x0d\xaf\x08\x97\xa7\x59\xeb\x43\xf1\xa2\xc9\x4b\xbb\x99\x29\x58\x00\x2c\xa8\x6d\xe5\x05\xd1\xe1\x32\xb7\xf3\x06\x7f\x9a\x89\x89\x87\x0e\xe3\x0f\x85\x3b\x02\xec\x10\x44\xfd\x42\x8c\x5d\x41\x1f\xc0\x4e\x54\x5e\x2c\x25\x96\x6f\x8d\x5f\xb5\x73\x1c\xec\x9d\xed\x76\xcf\xa0\x37\xe3\x89\x31\x56\xe2\xf0\x90\x51\xff\xed\xaa\x81\x4a\x87\x43\x07\x34\x3c\x53\xe7\xcb\xd9\xcc\x0c\x1e\x5c\x38\xec\xdc\x33\x4c\x7b\x5b\xbf\xf9\xc9\x69\x69\xc8\xe6\x00\x71\x06\x5e\x56\x16\x00\x04\xaa\xa0\xf7\xa6\x63\xb8\x88\x99\xfd\x82\x6d\x93\x67\x8d\x7f\x48\x4b\x9e\x03\x9d\xde\x2c\x7a\xca\xe9\x41\xad\xa4\x3d\x17\xf2\x94\x10\x79\x08\x4b\xd2\x8c\x03\xec\xb2\x12\x33\xa9\x50\x7d\x15\x8e\x72\xc2\xaa\x10\x72\x45\xa5\x0e\xbb\xb4\xa5\x50\xcb\xec\x56\x43\x95\x5f\x72\x55\x27\xae\xc3\xe5\xef\x7c\x03\x38\xd4\x83\x33\xe2\x7f\xaa\x94\x17\x82\xf8\xfd\xc3\x4f\x6f\xc9\xbe\xc5\xba\x42\xcd\xd3\xd9\xcc\xaf\x09\x9f\xcb\xfa\x16\xe3\xf4\xb8\x56\x58\x0d\x76\x31\xb5\xb1\xc2\x68\x8c\xae\xf6\xa3\xd1\x0e\x45\x37\x6b\x93\x56\xf4\xf9\x14\x16\x11\xae\x20\xf8\xfa\xf6\x02\x4d\x5a\x82\x03\x45\xc1\x42\x8c\x91\x3d\xbe\xbf\x40\x05\x61\x65\xb3\x16\x50\xdc\xd3\xb1\xd5\x97\x0b\xc2\x94\x50\x8c\xa9\x2a\xfe\x60\x46\xb6\x52\x12\xbf\x38\x48\x3a\x80\xc0\x6c\x69\xb7\x75\x2a\x00\x5e\xe7\x65\xed\x70\x3d\x77\xf6\x30\x70\x62\xc3\x26\xb2\x1d\x2b\x09\x78\xd8\x91\xa0\xbd\xa3\xdc\x36\xcc\xeb\xd1\x48\xbc\x4f\x6f\xe4\x59\x31\x2d\x75\x30\xc1\xe6\x4f\x60\xce\x02\xc5\x12\x4c\x1d\x1b\x4a\xf8\x7b\xaa\xce\xe5\x97\x1a\x4a\x20\x46\x8f\xf3\x06\xff\x7e\xfa\x4d\x95\xc5\x71\x78\x6b\x8b\xc3\x4f\x58\xfb\x7d\x25\x57\x79\xb9\x54\xf0\xa9\xa3\xb6\x5b\x0c\x2d\x3e\xd4\x69\x55\x6b\xe3\x14\xc0\xb2\x09\xcf\x2d\x94\x2d\x86\xda\x6f\x0a\x32\x64\x85\xe8\xaa\x2d\xb9\x38\xfc\x44\xab\x98\xca\x61\xcc\x85\x90\x93\x1b\xe9\x0e\x97\x0a\xed\x60\xcf\x4e\x11\xaa\x35\xc0\x31\x98\x2f\x3e\xd1\x46\xf4\x71\x98\x03\x12\xff\x60\x73\x0f\xff\x20\x4c\x9c\x4a\xab\x61\x39\x87\x6d\xe6\x45\x7d\x0f\xd5\xd1\x46\x13\xb4\x56\x44\xbb\x7a\xe9\x57\x1f\x8d\x44\x95\xae\x31\xb8\xa2\x57\x29\x6c\x85\x83\xcb\x39\x2d\xf5\x46\x86\x8d\x6e\x24\xe2\xac\xe6\x28\xca\xfa\x56\x16\x5e\xe0\x43\x21\xa8\xa5\xd9\x01\x9c\xe8\x0d\x8e\xef\x61\x6b\x90\xe5\x00\x36\xca\x11\x06\xf7\x42\x99\x29\xd9\x2c\x87\x84\x22\xf8\x96\x25\x83\x00\x30\xa2\xb0\x91\xa6\x2b\x49\x0c\x57\x2c\x7a\xa6\xb5\x98\xa7\x75\x76\xcb\x52\xd1\xb3\xaa\x47\x23\xf4\xc1\x67\xa9\xaa\x51\x77\x42\xef\xe9\x6c\x9d\xde\x2b\x8e\x03\xe9\x65\x40\x82\x27\xca\x78\x96\x63\x82\xbd\xdb\x27\xc4\x7d\x10\xcf\x10\xb7\x2e\x5f\x3e\x15\x99\x0e\x86\x80\x53\x0c\x6d\x1c\xdb\x1a\xfd\x26\xd7\xd1\xa5\x01\x69\xe4\x9f\x7d\x16\x93\x52\xea\xcd\x6a\x1c\x5b\x33\x81\x87\xab\x85\x43\xee\x83\xbd\xb5\xed\x80\x83\xc0\x59\x82\x2c\xa3\x62\xe8\xde\x1a\x16\xcf\x5f\xee\x83\x07\x78\xd9\xcf\x26\xd4\x0f\x3b\x30\xf2\xcb\x42\x66\xb5\x9c\x88\x67\x93\x70\xe8\xf7\xe1\x9a\x2e\xcf\x41\xf7\x6f\x07\xe4\x69\x3a\x86\x82\x47\xa8\xa6\xb5\x43\x36\xfc\xca\xda\x3a\x0c\x1c\xf1\x25\x60\x46\xfb\x32\x4e\x2b\xdd\x57\x47\xf0\x0f\x4c\x19\xd8\x35\x63\x2b\x49\x5d\xba\x28\x5e\x25\x14\xa7\x7b\x45\xb5\x5c\xef\x36\x9f\x18\xff\x18\x0b\xa3\x2c\x39\x3b\xdd\xcf\x15\xae\x2a\xa3\xf8\xe9\x7b\x03\xe1\x7c\x12\x6b\xc7\xb4\xcf\x81\x6d\xd4\xc7\xae\x5d\x57\x56\x2f\x1e\xfa\xc7\x5b\x0d\x4b\xc5\x6b\x80\xfc\x4f\xbd\xa0\x5a\x9c\xad\x9b\x46\xf0\xbf\x8c\x9d\x77\xcd\xf5\xaf\xe1\x93\xb3\xf9\x7e\xbd\x9c\x1a\x4a\x90\x0c\xe1\x14\x84\x28\x8b\x07\x1d\xe4\xa0\x41\x78\x2c\xa5\x23\x5a\x84\xb7\xc6\xf5\x58\x3c\x5b\x87\x08\xda\xf0\x6c\x96\x54\xe9\x1a\x3f\x89\x31\xa1\xfe\x06\x9b\x44\xd7\xcb\x69\xfc\xea\x4f\xea\x88\x9a\x59\x72\x4e\x24\xd6\xd6\xff\x3c\x24\x04\x7b\x48\x4c\xc2\x1d\x83\x7c\x18\x25\x58\xcb\x4a\x8a\x0c\x75\x31\x86\xef\xbe\x2f\xb5\x0c\x04\x63\x47\x47\x8f\x6e\xd3\x95\x04\x93\xcb\xed\x4b\x87\xf5\x66\x72\x5a\x83\x81\x95\xab\x8e\xa9\x9b\xc8\x7d\xa7\x0e\x28\xca\x56\xc7\xef\xbf\x6b\xea\xc2\xef\x30\x74\xc9\x07\x74\x08\xb6\xfe\x44\x43\x0f\x59\x72\x4a\x3d\x41\xbb\x5d\x33\xbd\x3b\xf8\xb3\x35\x2d\x1d\x0e\x32\x99\x1f\x30\xb3\x43\x91\xc5\xaf\x9e\x0a\xbb\x35\x9b\x3f\xee\x4c\x51\xa1\x52\x2f\x41\x05\xf5\x03\x05\x03\x61\xb2\x9d\x64\x4f\x98\xce\xb4\xb2\xfc\xc0\xea\x0a\xe8\x63\xf8\x42\xeb\x2e\xab\x11\x6d\x73\x9c\xcd\xeb\x65\x3e\xab\x71\xf2\x6d\x18\x4a\xf5\x31\x89\xd7\x5b\xaa\xc4\x62\x96\xe6\x1c\x46\x72\xb8\x81\x99\x61\x57\x7e\xcf\xb4\x35\xe3\x76\x1e\xb2\x84\x24\x00\xd1\xf7\x5c\xae\xdf\x43\x4f\x0e\x23\x45\x71\x7b\x52\x02\x4b\x5f\x58\x04\x28\x24\x94\x13\xaf\xd6\x9b\x37\x72\xd2\x64\x75\xf0\x14\x74\x6b\x5a\x44\xb9\x12\x60\x85\xe8\xaa\xc6\x13\x40\x83\x57\xdb\x4e\xcb\xe2\xae\x28\xd7\x85\x50\x59\x3a\x4b\x2b\x74\xb6\xdb\x09\x4b\x21\x98\xe0\x61\x6b\x2b\x73\xfb\x70\x72\x13\x52\xe6\x0f\xe6\x35\x39\x6c\x03\xe6\x8f\x11\x24\x86\x4b\xac\xb6\x1e\x3a\x52\xa3\x83\x77\x3a\x56\xb9\x8b\x52\xb4\xea\x4b\x8c\x32\x69\x51\x7b\x27\x45\x3d\xbb\xb0\x29\x70\x36\x07\x8a\x32\xa0\x32\x31\x26\x39\xb6\xa9\xd2\xf5\xb1\x50\x5d\xeb\x8b\x19\xd8\xb3\xc5\xbc\x31\x19\xfe\x37\x75\x7b\x46\x9b\x61\xa2\x6c\xcc\xd5\xa2\xb8\x43\x9e\x19\x56\xce\x9c\x6f\xe3\x3e\x39\xd1\xc9\xc7\xae\x9c\xf0\xe0\xd0\x88\xb4\x44\xe5\x54\x6b\x57\x0d\x68\x09\xef\x58\x5d\x3c\xa0\xc6\x74\xd2\x90\x3c\x40\xbd\x12\x9a\x03\xdd\x0a\xf6\xc9\xcd\x84\x9b\x49\x25\x2b\x28\x73\xac\x20\xaa\xde\x5c\xc2\xae\x26\xe8\x58\xab\x4c\x19\xb4\x47\xb6\x1d\xc2\x52\x27\x9e\x4a\x23\x5a\xdf\xdb\x71\x8e\x45\x78\x76\xfe\x8f\x93\xb7\x67\xa7\x1f\xdf\x9f\x7c\x7f\x76\x7e\x72\x71\xf6\xee\x3c\xa4\x7d\x93\x15\xa5\xc1\x7d\x97\x57\xaa\x7e\x9b\xaa\x3a\x9a\xc2\x5f\x43\x6d\x63\x1f\x61\x76\x05\x08\x68\x71\xc4\x49\xb7\x9a\xfd\x50\x2c\x35\xbc\x68\x6c\xc9\x68\x1f\x1e\x6a\x10\x5f\x19\x6f\x17\xa0\x8c\xc5\xa1\x0f\x07\x47\xf7\xa3\x54\x2a\xbd\x91\xc7\x22\x7c\x9f\x2a\x9c\x8d\xeb\xb2\xbe\x15\x9f\x10\xe0\x27\x94\x3f\x9f\x00\xd8\x27\x51\x97\x3c\x83\xd2\xf7\x44\x69\x21\xa8\xe5\x42\x4b\xad\x24\x1c\x5a\x93\x8c\xb6\x60\xd2\xea\x06\x88\x8d\xe9\xbc\x21\xc2\x0e\x45\x08\x70\x31\x49\x9f\x62\x95\xe0\xc0\x41\x45\xbb\xcf\x72\x78\x28\x8e\x9c\xaf\x7f\x15\x2f\x60\x34\x3b\x86\xe3\x8c\xe7\x93\x6d\xf8\x09\x76\xab\x3d\x9c\xad\xac\xb5\x11\x9d\x7f\xca\xaa\xd4\xb8\x03\xf2\x48\x34\x60\x8c\xe4\x83\xac\x61\x1a\x86\x9d\x53\xec\x6f\x39\x3b\xcc\x21\xab\x8a\xd6\x45\xba\x58\xcc\xee\xc1\x71\xfe\x90\xff\xd3\xdf\x2f\x41\x42\x20\x8d\x81\x14\xce\xfe\x65\x23\x73\x60\xa8\xcf\x2b\x40\x13\x0a\x23\x40\xa1\x14\x2a\xff\xa7\xe4\x1e\x72\xd8\xde\x9c\x8a\x42\xe6\x60\x1a\x89\x75\x8a\x0e\xa6\xd6\x20\xcc\x69\xd8\xeb\x5c\xa4\x37\x69\x0e\x2c\x0b\xf0\xe6\xe9\x17\x0b\x2b\x11\x67\x18\xbd\x72\x3b\x01\xf8\x50\x46\x1e\xeb\xb0\xdd\x0a\x4a\x96\x4a\x4e\x12\xf1\x6d\x9a\xdd\xe1\xbe\x92\x45\x5d\x89\x28\x4f\x64\xc2\x5b\x3a\x30\x8e\x72\x59\xeb\x4d\x9f\x18\xb4\x34\xc0\x9f\xe5\xe0\x4a\x4f\x1c\x71\xe0\xd1\x83\xc4\x82\x47\xc7\x88\x70\x04\x9a\x0e\x01\x1f\xf8\x03\xe4\x7b\xcf\xe6\xe2\x50\x74\x2c\x2e\xf8\x67\x28\xf4\xff\x3d\x77\x13\xeb\x8a\xb1\xbf\x9c\x1c\x69\x89\xc3\x86\x5d\x44\x8b\x84\x16\x2a\x58\x30\x1e\xd3\x56\x6c\xa0\x7f\x32\x7a\x3d\x9b\x13\xa6\xcd\x31\x7f\x21\xd4\xed\x22\xc0\x21\x11\x02\x50\x2b\x40\xfa\x8c\xc5\x21\xb4\x6d\x84\xe7\x09\x77\x53\x46\x9a\x7c\x6a\x88\x64\xd0\x23\x4e\x75\x08\x43\xfe\x94\x17\xa5\xfb\xd3\x57\xf1\xdf\x18\x13\xb3\x96\x8f\xc7\xc2\x5f\xcb\xd3\x68\xdf\xe5\xcb\x61\x3a\x8c\xc9\x3e\x9b\x24\xa1\x61\x86\xf8\x31\x6b\xd8\x10\x03\x7d\x4f\xeb\x80\x76\xaf\xed\x16\xc5\x78\x2b\xfc\x46\xd6\xaf\xf5\xe9\x17\x39\xf9\x0e\xf2\x84\xa2\xac\xfe\x02\x88\xd7\xf2\x4b\x0d\x07\x5a\xe0\xdf\xa1\x58\xa4\xf5\x2d\x68\x2d\x0e\x7d\x1c\xb1\x85\xe4\x37\x86\x49\x9a\xa2\x02\xe3\xf2\xef\x65\x8d\x60\x09\x12\x40\xd7\xa6\xca\xb4\x4b\x99\xf3\x5c\xd2\xcf\xcc\x03\x1e\x39\x30\xdf\x2d\x64\x85\x82\xc6\x85\x3b\x14\xd3\x2c\xc1\xde\x34\xc2\x10\x8b\x37\x39\x35\x0d\x58\x30\x4a\x33\x88\x26\xb4\x21\x9d\x0b\xea\x1e\xe4\x63\xa8\xb1\x4e\x67\x77\xc7\x46\xb5\x17\x70\xd8\xcb\x68\x77\x84\xb2\xa1\x7d\xc3\x8f\x43\x31\xb5\x45\x0d\x78\x08\x4d\x01\xed\x08\x33\xda\xa0\x04\x4f\x03\x17\x40\x4c\xb1\x8b\xa9\x98\xea\x13\x65\x40\x5a\xf8\x17\x3f\x07\xd8\x46\x8c\xc5\x74\xe0\x6e\xea\x08\x40\x8e\x75\xc7\xb6\x77\x12\x0e\xb1\xb5\xa1\xe3\x6d\xaa\x9e\xc2\x30\xbc\xc1\x9c\x4f\x77\xb2\x46\x07\x4f\x40\xaa\x83\x8b\x4f\x27\xc7\xda\x09\xa7\x25\x6c\xcd\x1c\xff\xe8\x16\x0e\xc6\x8a\x04\x88\xd4\xaa\x50\x84\x70\xfe\x2d\xc4\x23\x62\x18\xbb\x0e\x45\x58\x97\x75\x3a\x7b\x5d\x2e\x0b\x16\x15\xb0\xba\x75\xeb\xed\xf6\x3b\x22\x68\xe8\x7e\xf4\x8f\x2f\xc5\xde\x19\x35\xf7\xe8\x96\xb5\xa5\x29\xc6\x65\x43\xb0\x14\xe9\x22\xab\x59\x47\xe0\xb0\x4f\x72\x1b\x53\x08\x2d\x94\xf3\xc5\x12\x74\xcf\xf5\xbd\xf8\xf0\xd3\x5b\x27\x35\x08\x5d\x11\xad\xbc\x4c\xe2\x1a\x0c\x0c\xe3\xd1\x2a\xa6\x94\x24\x5b\x1d\x94\x19\x6d\x2b\x59\x0f\x57\xa7\xce\x10\x06\xd0\x5a\x19\x0f\xb6\xbe\x95\xb9\x89\xbb\x42\xdb\x4a\xa6\x18\xbd\x40\x4f\x77\xc2\x9a\xb0\xb0\x11\x18\x3e\x00\xe5\x0d\xd7\x86\xc1\x47\x23\x91\x17\x13\xf9\x85\xc0\x41\xf7\xfc\x9b\x10\x30\x83\x25\x2a\xe4\x45\x3b\xce\x1b\x70\x1b\xcc\x14\x45\xa8\x40\x13\x0b\xd3\xa1\x50\x0f\xdc\x64\x10\x40\x25\x93\x9c\xd3\x48\x02\xa0\x88\x74\x60\xe3\xd1\x76\xca\x0c\x24\x2a\xa1\x0e\x66\x65\x0a\x61\x03\xa0\x85\x22\xd2\xe6\x95\x38\x3b\x4d\x4c\x6c\x74\x9e\x2e\x2e\x1d\xef\xee\x8a\xc2\xfc\x64\x82\xd1\xb6\xa4\x67\x7c\x3d\x3c\x0e\xf2\xa7\x61\xb2\x28\x61\x8d\xc6\x00\x59\x22\xb3\x59\xb9\xb6\xf3\x6c\xc2\xdf\x0c\x0a\x51\x25\xc3\x25\x5a\x89\x23\x77\xce\x62\xaa\xab\x9a\x09\x12\x43\xc8\x9b\xe4\x35\xce\x79\x11\x36\x33\xb4\x2b\x67\x82\x82\xbf\xab\x04\x86\xa3\x62\xdc\x2d\x26\x09\x08\x5f\xac\x10\xa4\x1a\x5e\x0a\x86\x89\x01\xd3\x07\x0c\x4c\x63\x8a\xe1\x20\x68\x85\x51\x4d\x1d\x95\xbc\x8e\xf2\x89\xf1\xfa\xd1\x2c\x13\xe9\xc4\xcc\x20\x56\x6b\x91\x94\xe6\x29\x55\xd6\xbe\x6b\x54\x75\xd3\xeb\xda\x24\xc3\x6e\x22\x38\xc6\x87\x34\x43\xb1\xa7\xb3\xe8\x3e\x2c\x20\xd2\x0a\x9b\x90\x66\x47\x12\x36\x23\x17\x32\xb3\x7b\x94\x83\xc0\xff\x2d\x28\x6d\xa7\x31\x01\x31\xc7\x77\x2c\x28\xb2\x61\x36\x5e\xe6\x95\x93\x69\x42\x6d\x79\xe3\x5a\x81\xd6\x02\x02\x20\xaa\x78\x82\x97\xbf\x80\x3c\x1d\x8a\x15\x25\xab\x28\xcc\x1a\x34\x75\xce\x4e\xa9\x1a\xee\x79\xdb\xc4\x67\x60\x3e\xd8\x28\xaa\x24\xa4\x3c\xed\x5c\x24\xc0\x72\xd6\x51\xd0\x1c\x7b\x76\xda\x4b\x50\x25\xeb\x28\x9f\xb8\x21\x11\xda\x88\xa4\xa5\xcf\x5f\x91\x26\xba\x7b\x87\xff\x70\x5a\x88\xf7\xe0\x4f\xb3\xf3\x90\x13\x14\x87\xf3\xec\xde\x03\x6c\xed\x95\xf3\x05\x8a\x12\xf9\xa5\x5e\xa6\x33\x2e\x05\x77\x05\x19\x5f\x0b\x48\x14\xf3\xa9\x12\xd7\xb3\xf2\x5a\x41\xd8\x2a\x9f\x8a\x6b\xe7\xf4\xda\x52\x26\xd1\xe5\xd5\xf5\x7d\x2d\xe3\x57\x82\xc2\x34\x01\xed\xf1\x12\xa0\xe8\x9a\xe7\x48\x23\xaf\x73\x85\xb0\x0e\x90\x36\x58\x51\x8e\xde\x65\x3e\x81\x02\x5d\xa9\xb1\x01\x0b\x3a\xe7\x60\x9d\x17\x13\x5c\xeb\x8f\xcc\xad\xd5\xed\x3a\x92\x6b\x0d\xc0\xbd\x53\x6b\x4d\x0b\x52\x78\xa0\x82\x7e\xc5\x6f\xce\x8e\xa3\x75\xba\x84\xae\xdf\xda\x33\x86\x66\x5a\xf5\xc1\xd6\x61\x7a\x23\xab\xe7\x24\x59\xa7\x65\x05\x9c\x36\x5f\xce\xea\x7c\x31\x23\xf1\x05\xf2\xaf\x2c\x78\x61\xa2\xbe\x2b\x64\x7e\x73\x7b\x5d\x2e\x75\x30\x4a\xa6\xd9\x2d\x56\x45\xe5\x50\x2c\xe7\xd7\xe6\x34\x7f\x06\xba\x9e\x5d\x3a\x00\x4d\x28\x01\x33\x02\x2e\x0a\x36\x8b\xea\x1c\xfe\xb4\x52\x14\xf1\x03\xd7\x27\xbf\x29\x9e\xdf\x49\x4a\xeb\x2d\x8b\xd9\xbd\xcd\xd2\x20\x66\xd7\xe0\x38\xae\xac\x47\x41\xea\xd1\x21\x8e\xa7\x1c\x61\xe3\x30\x9f\xc8\xa2\xd6\xe7\x80\x1b\x24\xf3\x9c\x6f\xdb\x43\xa2\x8f\xba\x39\x3a\xcb\xe0\x0d\x7e\x6f\x7d\xeb\x21\x4c\xd2\x8f\x41\x00\x26\xc9\x20\xb0\x2d\x1c\x30\xb8\x7d\x66\x94\xaa\xa3\x82\xfd\xfe\x87\x7c\x0e\x92\x4c\x83\xb6\x87\x9d\x0c\x82\xd6\xce\x2a\xf6\x60\xa4\x95\xd5\xdd\x14\x66\x75\x4a\xbc\xce\x92\x41\xd0\x4e\xad\xf5\x05\x24\x42\x46\x7f\x9d\x87\x3f\x4f\xbf\xe4\xf3\xe5\x9c\xa6\x1f\xb0\xc7\x79\x5a\xc8\xca\x4e\x31\x66\xc7\x40\x7c\x05\x07\x91\xc2\x0d\x17\xe5\x1a\xd6\x35\x41\x22\x43\x03\xad\x44\xd7\x1c\x70\x28\x07\x4e\x72\x84\x15\x34\x6f\xc5\x4d\xdb\xc0\x72\xa6\x6f\x20\x10\xd0\xa6\x81\xc0\xc6\x0d\x2b\x21\x43\x22\x5f\x29\xb9\x56\xe3\xd0\x9a\x8d\xdd\xb6\x9a\x31\xd3\x8c\x86\x65\x45\xfd\x28\x6b\xc7\xef\x13\x52\xbb\x60\x07\x00\xfb\x4d\x8b\x7b\x6b\xf3\x78\x02\xdd\x33\xd1\x35\xef\xfc\x5c\xae\x5f\xf3\x91\x92\xb1\x08\xf5\xc7\x8f\x55\xb9\xfe\xa8\xe7\x2a\xe4\x8a\x17\x40\x22\xaa\x6a\x2b\x22\xe1\x3e\x22\xb1\xf1\x54\x3a\x70\x83\x28\xe4\xfa\x8d\x59\x5f\x91\x33\x3f\x64\x8f\xec\x93\xf8\xdd\x88\xc9\x50\x00\xa6\x19\xaf\xf1\x02\x35\x76\x4d\xbb\x91\x1a\xcb\xac\x76\xcb\xad\x2f\x19\x1d\xb7\xf5\x1b\xc1\xa1\x5d\xbb\x71\x36\x06\xb0\x65\x32\xfd\x20\xef\x4d\x3f\x16\xa1\x1f\xe4\x7d\x44\x50\x29\x2e\xa0\x47\xc2\xf1\x01\x9d\x4d\xb0\x67\x3f\xa0\x4a\x0e\x2d\x6c\xa8\x77\x27\xef\x8f\x29\xfb\x84\x96\x29\xe0\x01\x79\x55\x4c\xfc\x63\x61\x17\x0a\x84\x2f\x71\x0e\xa8\x0d\xa9\x6b\x67\x3a\x9c\x5c\x01\xa8\x6c\x69\x78\xec\x66\x7c\x03\x18\x98\x7f\x75\x6c\xc1\x74\x2c\xa1\x78\x68\xd2\x86\xfb\xd2\x2b\xf3\xa9\x1b\x8d\xa6\x53\x74\x36\xed\x58\x8c\xdd\xd4\xe4\x84\xa4\x5d\xc4\xba\x7b\x9d\xf0\x79\x3e\xa8\xc8\xa1\x2c\x2f\xdc\x0d\x10\xd7\x89\x96\x24\x63\x71\xa4\xcb\xbe\x16\x2f\xbd\x63\x12\x69\x6f\xfd\x59\x6a\xaa\x1b\x93\x77\xed\xa5\x09\xb8\x53\xed\xb9\x10\x8e\x46\xb9\x67\x19\xd0\xa7\x52\x52\x23\x63\x71\x1d\x75\xf0\xcf\x23\x97\x05\x1d\xf2\xa6\xa5\x15\x0b\xda\xba\x72\xd7\xc7\xae\xa4\x03\xcf\xc6\xdb\xec\x62\x60\xec\x66\xbb\x8b\x87\xc3\x70\xb8\x23\x7d\x80\x34\x3f\xf2\x71\x67\x0a\x01\xdb\x6b\xcb\x29\x25\x74\xb8\x8e\xc5\xba\x4a\x17\xaa\xed\x4b\x83\x75\xad\x8d\xdd\xd4\x39\xd5\x82\xf2\x59\x0b\x37\x65\xcd\x10\x65\xac\x07\x3a\x3b\x84\x76\x8b\x5d\x32\x58\x53\x7b\xee\xb6\x2a\x77\x44\xd8\xb3\x5d\x9d\x3b\x5a\x0d\xe3\xef\x26\x33\x0a\x7e\xf0\x7e\xad\x38\x3b\x35\xfd\x54\xe5\xda\x1e\x32\xda\xdb\xff\x59\x8b\x23\xcb\x22\x7b\x79\x3f\x70\x30\x26\x82\x65\x0b\xc9\x94\xb0\x66\x05\x39\x41\x60\x98\x26\x17\xf0\xd5\x71\xfd\xfc\x52\xf2\x50\x5c\x71\xc0\xe5\x46\x8e\x0e\x82\xf8\x51\x5e\x14\x91\xc5\x51\xd9\x60\x31\x18\x95\x47\x7c\x8d\x03\x6b\xe8\x4f\xd0\xb3\x52\x28\x88\xb8\x41\xf6\x46\x21\x28\x54\x2b\xc1\x11\xc8\x38\xd3\x94\x53\xc4\x9d\x64\xf1\x98\x50\x88\xe2\xe4\xbb\xaa\x9c\x63\x72\x3b\x0e\x3c\xaa\xe1\xff\x71\xbc\x9f\x73\x97\xb1\x73\x67\x67\xfa\x78\x2c\xc2\xf7\x27\x3f\x5f\x9c\xc1\x06\x9e\xf8\xf6\x7f\x08\x38\x17\x95\x25\xaf\xa3\x75\x62\x2a\x41\xab\xac\xe1\x0e\x02\xe0\x8c\xc9\x4b\xf9\x9e\x0a\xbc\x3b\x10\xbd\x01\xe0\x77\xa2\x22\x58\x3a\x1f\x16\x55\x5e\xd4\xd3\x28\x7c\xfd\xee\x97\xf3\x8b\xe8\x28\x16\xef\xfe\xf1\xe6\x67\x11\x3d\x53\x71\x38\xb4\x2c\x17\x0f\x45\x4b\x65\x83\x0c\x0e\xf8\xf8\x2c\x51\xbd\xc1\x5a\xb0\x88\x16\x40\x65\x3e\xd9\xc6\x11\x27\x55\xce\x56\x72\xa2\x67\x8b\x66\x44\xc7\x56\x90\x60\x5c\x6b\x31\x4b\x33\x6b\xa5\xf3\x72\xcb\x25\x58\x3a\x41\xfd\x07\x27\x84\xa2\x15\x0b\xab\x3b\xd6\x89\x99\x0b\xca\xd0\x5f\x44\x35\xcf\x09\x4a\xa3\xae\x48\x08\xe8\xb5\x75\xd2\x3e\x3f\x02\xb9\x8a\x0e\x68\x27\xef\x9f\x92\xe6\x77\x9d\xcd\xb1\x27\x37\xa9\x63\xed\x45\x9a\xc3\x9b\x00\x8d\x4f\x9f\xd4\x94\xaa\xee\xa6\xca\x37\x1b\x9d\xf4\xb4\xa1\xc1\xd5\x1d\xec\x53\x1b\xf6\xa1\x8f\x34\xb7\x5d\x9c\xb0\x93\xb3\x7e\x7e\xf7\xeb\xc7\xf3\x5f\x7e\xfc\xf6\xcd\xcf\x11\x73\x97\xc7\xd2\xcf\x94\x78\xf7\xf3\xe9\x9b\x9f\x81\xbd\x35\xdb\xd5\x0d\x06\x1f\x92\xb2\x51\xc9\xff\x5f\xe6\x45\xa4\x07\x37\x14\xe1\x50\x84\xb1\xe1\x4c\x63\x75\x5a\xbe\xd4\x93\x9f\x01\x46\x76\xde\x41\x2e\x02\xe7\x40\x1f\x8d\x86\xb0\x49\xc6\x81\x54\x5d\xa5\xbd\x80\xf4\x52\x5e\x93\x13\xef\xad\x64\xd3\xd6\xc4\xb7\xf8\xcb\xd0\x34\xd8\x27\x0a\x43\x93\xa2\x1a\x93\x62\x81\x59\xdc\xbd\xe5\x08\x08\x47\x5d\x40\x19\x2a\x04\x07\xc8\x00\xe1\x53\x9b\xde\xe1\x86\xb7\x17\x6f\xa2\x0a\x4c\x5d\xaa\xc5\x87\xf4\x9c\x33\xc1\x55\xb9\x26\xb2\xd6\x1e\x59\x9b\x29\xe4\x9b\x0d\x71\xfe\x01\xfa\xe9\x4e\xa4\x02\x4e\xd2\x43\xc0\x1d\x67\x11\x43\xf0\xb8\xaa\xdc\x9f\xba\x8d\x57\x11\x8c\x7f\x5b\x8f\x83\x1b\x5c\x0d\xeb\xf1\xbe\x0c\x7e\xd6\x1b\x28\x0c\x02\x54\x1a\x14\xa1\xb0\xa3\x9a\x21\x00\x09\xb1\xca\x68\x24\x4c\xad\xed\x96\x1d\x4c\x6c\x54\x49\xba\x2d\x91\xd2\x76\xf5\xc1\x4c\x04\xb0\xdd\x92\xe3\xef\xb6\xb5\x9e\x3f\xcc\x81\x38\x72\x6a\x73\xda\x39\xa0\x07\xa9\xdb\x94\x4f\x4e\xff\x38\x79\xef\x5a\x1d\xe9\xa4\x74\x68\x0f\x91\x94\x16\xf6\xaf\x4d\x78\xc5\x1b\x03\xd6\xb5\x63\x70\xf7\x2e\xcb\xa2\x4e\xf3\x02\xa4\x31\x20\xab\x20\xb1\xa2\x7b\x2c\x0c\xc3\x8e\x05\x48\x05\xde\xd5\x91\x3b\x54\xc2\x16\x81\xc1\x78\xcc\x71\x00\xf3\x87\x1d\x92\xd9\x92\xf9\x34\x08\x88\x67\x51\x31\x17\x35\x79\x18\x54\xcf\xd9\xaf\x31\xc3\x87\xb6\x95\x1d\x7f\xa4\xe0\x8a\x49\xc2\xfb\x85\x78\x29\x7e\x17\x10\x12\xaf\x62\xbf\xe4\x65\x0c\xa9\x25\x37\x70\xcc\xd6\x30\xd2\xa2\x6e\x91\x91\x55\xfc\xbb\x45\x8b\x94\xe5\xa2\x06\x2a\xc8\x02\x44\x87\x72\x0d\xec\x6c\xa9\xea\x72\x9e\xff\x93\xe2\x20\x86\x70\xd4\x02\x0c\xed\xe8\xc8\xa2\xbe\xe5\xa4\xaf\x81\xe5\xe7\x16\x22\x28\xff\x3d\x5c\xdf\xf9\xf5\xc2\x5f\xf3\xfa\x36\xe4\xe6\x5c\x8f\x76\xe0\x9b\x75\x4f\xf5\xe7\xb0\x0f\x3a\xbb\x65\xea\x49\x74\x7d\x67\x9a\x37\x40\x12\x2f\x3f\x19\x26\x1d\xc7\xf0\x81\xfe\x20\xef\x9f\x8e\xe5\x0f\xf2\xbe\x39\xa9\xd8\x13\xcc\xac\xce\x5f\x5b\x56\xfe\xe4\xe2\x40\xf2\xe2\x46\x6f\xb4\xd9\x70\x99\x49\x76\x71\xce\x60\x99\xab\x38\x4c\xc6\x24\x58\xe0\x94\x97\x02\x36\x77\x2a\xea\x5c\x5e\x57\x32\xbd\x93\x95\x58\x16\x98\xf4\x03\xa1\x4d\xb2\x8f\x28\xf0\x06\x3d\x62\xf8\x28\xe7\xec\xba\x06\xaa\x5a\xe5\xf1\x02\x2c\xe9\x6b\xcc\xd5\x60\x30\x8d\x5b\x22\xf4\xa2\xe9\x62\x42\x77\xb3\xba\xb4\x16\x8a\x06\xca\xf6\x49\xe9\x6e\xe1\x1a\x7f\x0b\x3d\x3c\x95\x9c\xcb\x75\x14\xba\x88\x38\x79\x11\x45\x3e\x0b\xed\xc9\x39\xf2\xdc\x40\x6c\x27\xc6\xb6\x71\xee\x61\x6b\x67\xbd\x39\x5d\xb9\x06\x0a\x0e\x27\xe1\x0b\xca\x4c\xdf\x9a\x85\x99\x3e\xb1\x17\xdd\xa0\x7d\x0c\xa8\x0b\x56\xa3\xc3\x43\x5a\x41\xe0\x1e\x8e\xa7\x25\xca\x45\xfd\x1d\x5e\x06\xdb\x5e\x74\xc8\x54\xa1\x2e\x6d\xf2\x13\xb5\xe9\x65\xa8\x29\x96\xfb\x13\x6b\xda\x44\xba\x94\xae\x02\xb1\xc8\xc2\xec\xfa\xbf\xd9\xc1\xfe\x03\xb3\x8e\x19\x46\x33\x9b\xd2\xd3\x4c\x34\xf4\x26\x97\xfb\xe5\x26\xed\x39\xb6\x13\xc3\x50\xa9\x6e\xf7\x3c\x3c\xd7\x5a\x12\x32\x70\x1e\x77\x5f\x08\x95\x61\xd2\xc5\xcd\xe7\x59\x7b\x67\x23\x87\x9c\xe3\x89\x88\xe0\x4e\x5e\x99\x5c\xc0\x91\x2d\x9c\x58\x6b\x09\xc4\x22\x82\xbe\x4d\x36\xdc\x81\x8c\x75\xf3\xa0\x81\x97\xb3\x35\xe2\xee\x8d\x3c\xb0\x4f\x62\xdb\xd3\x5d\xb5\x30\xc7\xa6\x33\xcb\x4d\x6b\x97\x9b\xb8\x5c\x67\x26\x58\x7e\x32\xed\xb6\x5b\x51\xae\x64\x55\xe5\x9c\xcf\x4a\xc2\xde\xc8\x1a\x3f\x1d\x8e\x64\x8a\xe5\xbc\x64\x10\xb8\x3c\xe7\xc0\xed\xcd\x62\x6b\x32\xd7\x63\xb8\x8b\x78\xc1\x03\xad\x3f\x71\x07\x63\xd1\xd5\xaf\x9f\x05\xc5\x99\x63\x9b\x0d\x13\xd9\x1a\x26\xdc\xab\x63\x99\x74\x0a\xc6\x41\xf0\xe8\x55\x85\x13\xe0\x60\x87\x34\x66\xbc\x39\x46\xff\x78\xfa\x77\x8d\x17\xb6\x3e\x59\x32\x15\x72\xfd\xde\x37\x6f\xc2\x42\xae\x3d\x16\x21\x79\x63\x66\xd2\x34\x01\xb1\xb7\xa8\xc1\x2c\xb3\x73\xc6\xc3\x63\x4a\xf1\xf0\x38\xa1\xee\x80\x33\x08\x17\xcc\x0c\xb8\x46\x90\x66\xc6\xce\x3a\x74\x21\x6c\x68\x21\x00\xeb\xea\x3c\x0f\x02\xf2\x82\x16\x90\x33\xc4\x63\x40\x30\x01\xaa\x36\x56\x4f\x37\x84\x97\x04\x81\xe8\xd2\xdb\xda\xde\x0f\x01\x83\xb4\x1a\x6b\x51\x9b\x48\x2f\x6b\x99\x45\xad\xb9\xb4\x3f\x9f\xda\xc6\xd7\xf9\xac\xc1\x54\x78\xda\xc5\x36\x79\x48\xe9\x40\xca\x96\x1b\x4f\xc4\xfa\x26\x92\x88\x13\x16\x2d\x9a\xeb\x05\xcc\x88\x7b\x2d\xfe\x23\x2d\x62\xf7\xe2\x4f\xca\xba\x5a\xb0\xa8\x75\x46\xc6\xbd\x53\x91\x86\xea\x21\x46\xf7\x00\x11\x62\xd0\x5d\x65\x1d\xa5\x9f\x65\x26\xf3\x95\x63\x52\xf2\xf5\x57\x96\x25\xf1\x8b\x61\x4a\x6d\xa4\x9d\x9d\x1a\xdb\x0f\xd3\x7f\x09\xda\x43\x77\x84\x3b\xaa\x93\xfb\xd9\x6e\xdd\x9b\xb8\x28\xf9\x24\x9f\x40\x78\x32\x75\x3d\x14\x0a\xd3\xf1\xdd\x2b\x74\xd5\x17\x2f\x3b\xec\xdd\xea\x58\x0b\x3c\x72\x72\x5b\xba\xee\xee\xe2\xa5\x91\x4f\x1b\x43\x38\x5f\xce\x65\x95\x67\x96\x81\xf3\x29\xdc\x5d\xf4\xbe\x92\xd3\xfc\x4b\xf7\x70\xc3\x65\x6e\x32\xcd\x82\x95\x89\x81\xf3\xe1\x95\xf7\x80\xd4\x2f\x79\x01\x09\x10\x43\xf1\xf2\xc5\x50\xfc\xc7\xbf\xc7\xcc\xec\xa4\x0c\xfb\x1b\x9e\x75\xb7\xe3\x25\x96\x4f\x77\xb2\x7c\x47\x9c\x1c\x69\xd4\x45\xe1\x7c\x22\x9e\x7d\xa6\xa0\x39\x9d\xa4\x64\x7d\x4f\x20\x37\x1b\x9f\x00\xb0\x1c\x56\x7c\x2c\xd2\x0c\xc7\x25\xe9\x99\x22\x1a\x6d\x1f\x00\x93\x4f\xba\xe0\x94\x95\x0b\xea\x97\x5f\xce\x4e\xfd\x96\x60\x54\x2e\xe5\x07\x18\x19\xa9\x80\x60\x95\x56\x62\x99\x4f\xba\x3a\xf1\x84\xc6\x32\x9f\x24\xd0\x10\x92\x8b\x5e\xfd\x17\xd2\x70\x99\x4f\x9a\x03\x35\xac\x86\x77\x7f\x45\x68\x83\x4e\xbd\x3b\xef\xcd\xa9\x62\x3a\xf6\x60\x96\xc1\x33\x05\x2b\xe1\x59\xe7\x13\x03\x61\x83\x00\x88\x2b\xdf\xab\x6f\x58\x48\x8b\x06\xf8\x61\xf4\x4d\x5b\x7c\xd5\x25\x9d\xe0\x83\xcf\xf0\xc9\x0b\x71\xc4\x74\xfe\xc8\xb1\x4b\x9b\xbe\xe1\x76\x1b\x2d\x12\xf2\x99\x18\x46\xcc\x06\x62\x3e\xf5\xc2\x3d\x64\x17\xb9\xdb\xcd\xde\x3e\x18\xec\x68\xcb\xaa\x63\xfb\x9a\x37\xb6\x5b\xe9\x96\x08\xcf\xa4\x55\x82\xd9\xa6\x1a\x59\x92\xf6\x3a\x0d\x0b\x13\xce\x52\xf3\xe5\x1a\x26\xd5\xb0\x87\x40\x2e\xb2\x51\xec\xef\x95\x03\x59\x90\x2d\x1b\x49\x51\x36\x6a\xec\x38\x64\x8b\xa4\xe1\x92\xe9\xa4\x68\x1d\x47\xf4\x58\xd4\xbb\xe0\x85\xfd\xae\x95\x67\xe3\x07\x2b\x38\xb8\xe2\xf6\xb9\x41\x38\xea\xb8\x7b\x8b\x95\x12\xad\xe2\xad\x01\xb9\x4a\x38\x41\xd3\x44\x37\xcd\xa7\xa1\xc8\x41\x3e\x04\x9c\xeb\xe7\xd4\xc0\x0f\x43\x83\x3c\xfc\x6c\x2c\x83\x15\x68\x2a\x9c\x17\x25\xeb\xd7\x2e\xb5\x94\xa4\x7d\x31\xb3\x79\xd3\x31\xc5\x7c\xf6\x90\x0e\x59\xd1\x64\xe2\xcc\x9a\x49\xca\xc4\x91\x13\xd7\x8a\x9b\x1d\xb5\x73\xd4\x36\x83\x0e\x1a\x7a\xd7\xad\x92\x41\xe2\x24\x82\x67\x89\x76\x4c\x6c\x4a\x98\x73\xbe\x8f\x52\xbe\x24\x47\x66\xaf\x68\x96\xf8\xa8\x9f\x7f\x49\xcf\x96\xaf\x0e\xfa\x6d\xe8\x5e\xad\x6a\xa7\x40\x37\x91\x74\x2c\x8d\x4e\xd9\x3b\x89\x66\xea\xf2\xb7\x2b\x06\x44\xd6\xb4\x59\xe6\x7d\x09\xcd\x01\xc7\x32\x28\xe8\xed\xad\x34\x8a\x5a\x77\x2c\x35\x7d\x71\x44\x23\x41\x85\x17\x18\x48\x68\xd5\xca\x57\xc9\x2b\x9e\xae\xfe\x65\xe4\x62\x12\xf9\x49\xa9\x66\x2f\xb1\x37\x2b\x95\x96\x4e\x1c\x77\x46\x3a\x1e\xb5\xb0\xfa\x12\x56\xb9\x11\xdf\xd5\x6a\x43\x15\xc4\xd6\x54\xb3\x49\xfc\x9e\xe1\x62\x20\x08\xc7\x69\x4c\x4c\x40\xa1\xe6\x10\x1d\x8d\xd3\x14\xb6\xc6\xb9\x5b\x7e\xb0\x6c\x75\xa7\x9b\xf2\x35\x34\xd7\x18\xc0\x1b\xdc\x2e\xe7\xd1\xdd\xc9\xfb\x28\x1e\xda\x4b\xf8\x8f\xdd\x38\x0e\xeb\x2a\xab\xb9\xba\x21\x6a\x4a\x58\xa0\x7c\xfb\x4c\x37\x54\x7b\x05\x90\x6d\xc1\x5f\xb6\x0d\x9b\x87\xf4\xd7\x63\xec\x4f\x68\x16\xec\xb8\x4c\x82\x0c\x7d\xfe\xd5\x50\x91\x3c\xb9\x08\xe0\x21\x23\x9f\x0f\x92\x76\x58\xf9\x8d\x7c\x20\xce\xb2\xd8\x65\xfc\xdb\xad\x49\x63\x1d\x76\xe5\x1c\x2d\x12\x62\xa5\xa1\x75\x58\x20\x80\x6a\xd5\x6e\xfc\xd4\x64\x24\x5a\x49\x06\x11\x87\xd5\x4c\xff\x80\x69\xa0\xd1\x1f\x53\x62\x81\xbe\x3b\xcb\xb4\xda\xe1\x98\xec\x22\x26\x06\x6d\xbb\x49\x49\xa9\x3b\x7c\x47\x94\x5b\x28\x36\x83\x07\x04\x80\x61\xc2\x46\x90\x52\x2b\x00\x06\x0d\x35\x9d\xaa\x63\xd1\x9f\x38\xd4\xbd\xd4\x7c\x92\xe8\xc1\x58\x18\xf6\x02\x69\xe6\x78\x5c\x78\x71\x87\x6f\xe0\xc8\x2b\xf9\x65\xe1\xcd\xd9\x5e\xbd\xe0\xbd\xb1\x2e\x84\x8e\xcd\xe3\xc7\x22\xab\x65\xa0\x11\x82\x8d\x15\xea\x4e\x35\x65\x36\xf1\xa6\x8b\x90\x5f\x64\xb6\xac\xa5\x9b\x67\x03\xb1\x15\xd6\x3c\xa9\xa8\xe4\x2c\xbd\x17\xd7\x29\x84\xd3\xc9\xb0\x76\xb6\xb4\x9a\x3b\x58\x9a\x81\x7c\xcb\x94\x59\x21\x36\xd9\x1c\xd1\x20\xe8\x3c\xec\xd4\x9f\x0c\x35\x08\x76\x65\x43\x41\x18\x22\x49\x12\x1b\x84\x19\x0e\x78\x21\x93\xc5\xe1\xae\x63\xeb\x77\xec\x3c\x87\xdd\xf6\x44\xba\x96\xa4\x05\xe6\x9f\x5d\x67\x12\xd0\x69\x79\x15\xb5\x16\xfd\x5e\xe0\x29\xa0\x41\x5d\x74\x05\x9f\xf6\x16\x1e\x9c\x3c\xc7\xc9\x5d\xd0\x64\xdc\x38\x5a\xfb\x50\xfc\xb0\x31\x86\xa1\x78\x2c\xbd\x06\x7c\xb1\xae\x09\x8d\x89\x31\x75\xe1\x86\x65\xb8\xc6\x9e\x30\x79\x7b\xf6\xd0\x99\xf1\x0d\xda\x82\xc7\x8d\x2d\xd3\x0d\x2c\x09\xb0\xfa\x3a\x8f\xdf\x0d\xf5\x09\x2f\xfc\x1d\x8b\xdf\x7f\x27\x0e\xa4\xee\xe1\x30\xab\x39\x1e\xfc\x02\x8a\xdd\xec\x46\x28\xe5\xc3\xc2\x2f\xd8\x7c\xed\xe9\xc5\xee\xb1\x9a\xae\x60\xe9\xf6\xd4\xe6\x9d\x5b\xaa\x4b\xb7\xa0\x2e\x8b\xda\xe3\x0b\x20\x57\x82\xe7\xec\xe8\x6c\x68\x67\x3c\xa2\x4d\x3f\xb2\x75\x81\x84\x89\xb3\x2f\x0c\x57\xd8\x2c\x8b\xda\x14\xf1\x8e\x72\xe2\xde\x2f\x37\x6e\x11\x08\x1b\xc1\x85\xd4\x9d\x0d\xbd\xab\xe6\xc6\x4d\xf2\xb9\x6d\x3d\x33\xae\x28\xc8\x4b\x27\xfe\xa1\xa4\x49\x6a\xd9\x9c\xa6\xdf\x7f\x27\xee\x74\x3e\x38\x3d\xc5\xd0\xd5\xbe\xf3\xb2\x19\xf4\x93\x7a\x56\x16\x32\x8a\x7d\x92\x77\x50\xbc\x4d\xf0\xed\x60\x07\xb9\x1f\x5e\x22\x4d\x09\xf3\x24\xb9\xc2\x8d\x7d\xd0\x5a\xd1\x58\xc0\x2e\xd9\x20\xf7\xbc\x12\x4e\xd6\x7e\x57\x32\xae\x9f\x8a\xfb\xf5\xce\x44\x5c\x2f\x0d\x17\xab\xe2\xc8\xfd\xe4\x18\x07\x4f\xfe\x33\x79\x0b\x35\x22\xac\x17\x1b\x82\x99\xf3\xa8\x3d\xc7\x5a\xed\xc2\x1e\x62\x9c\x01\x8b\xe2\x57\xd4\xce\x41\xab\xab\x47\x3a\xf3\xbc\xef\xe9\xe9\x23\xe3\x92\xf4\x39\x7a\xdd\xdd\xe8\xf4\x22\x76\xb9\x9c\xd8\xb7\xf1\xc2\x28\x8b\xa8\xa1\xdf\x7b\xe2\x36\xe4\x02\xf3\x5e\x43\x95\x64\xae\xc7\x1d\x3b\x63\xf5\x4a\x8c\x07\xeb\xd9\xdd\x83\x80\xa2\x33\xcd\x75\x70\x32\x9b\xd9\xc3\xe8\x0e\xe7\xc1\xaa\x93\x45\x84\xad\x62\x2b\x14\x89\x19\x81\xff\x2d\x37\xc2\xaf\x04\xcf\xbe\x46\xd4\x0b\xe9\x3d\x57\xbd\xf4\x8f\x13\x9b\xb7\x22\x0a\xf8\x4f\xe3\x92\x0e\x42\x11\x2c\x77\x68\x23\x8b\xdd\x2a\x7b\xbf\xb5\x04\x97\xeb\x34\x97\x33\x0f\xb7\xbd\xce\xc1\x6b\xc4\xaf\xa8\x9f\x3c\x5b\xdc\x4a\x3a\x6d\xa4\x21\x45\x6c\x0c\x06\x59\xd8\xec\x7c\x01\x85\x44\x5e\x4c\xcb\x8e\x5b\x83\x4c\x78\x0d\x4c\xaf\x9c\x8f\xcf\x9a\x7c\x65\xfc\x85\xb1\x82\xbc\x70\xf3\xab\x61\xf5\xf3\xdb\xa7\xb9\x7a\xf0\xf8\x91\x39\xcf\x0c\x6f\x8b\x50\x6e\x13\xdc\x3c\x95\x4e\x26\x98\xb1\x9a\xce\x70\xa5\xd1\xb1\xb3\x82\xfd\x13\xc0\x15\xaf\xc6\x95\x45\x46\x97\x55\xe9\x0b\x6c\x61\x44\xc9\xa0\x3b\x5e\xe4\x30\x07\x6b\x74\xb2\x39\x89\x57\x7c\x97\xa5\xeb\xce\x0f\xf7\x7e\x8f\xa6\x46\xff\xfa\x25\x4c\x99\xc3\xaf\x70\x5d\x62\xd6\xa7\xf4\x60\x7f\x1a\x6e\x45\x47\x32\xea\x37\x5f\xd5\xe5\xb1\x6d\xfd\xfc\xe5\x55\x9f\xe0\x63\x2b\x61\x8f\x0e\x1b\xca\x72\xbf\x4e\xb5\x94\x86\x2f\x27\x35\x3d\xd6\x04\x63\x77\x09\x36\xe8\x3a\x97\x81\x79\xce\x0e\x3a\xcf\xe1\x54\x44\x40\x70\x1a\xcf\x3e\x39\xb0\x7c\x15\x87\x28\x15\xcf\xf3\x2b\x8a\x79\x59\x8f\xe6\x29\x80\x0c\x98\x41\xc0\x81\x3d\x13\x85\x71\x4d\xba\xa1\x43\x46\x0e\xc3\x58\x4f\x13\xbf\x1b\x1c\xf8\x89\xde\x93\x3a\xc2\x78\x29\x01\xd6\xf1\x92\x43\xd7\x4e\x84\x71\x41\xa4\x10\x0e\xc1\x40\x0b\xc8\x68\xa5\x7c\xc1\x63\x12\xa7\x26\x10\x0f\xe5\x31\xdd\x7c\xa4\x85\x8c\x21\x27\x75\x10\xbf\x12\x85\xd1\x69\xce\x34\xbb\x97\xe9\x42\x74\x98\xd0\x79\x71\x45\x71\x45\xbf\xb6\xbd\x4c\xd7\xa9\x5b\x3c\x7f\x69\x6b\xf3\xb9\x61\x4f\xb0\xd1\x2f\xd5\x15\xa5\x75\x84\x86\x16\x2f\xdd\x97\x33\xf6\xac\x4a\x5f\x7e\xf6\x5d\xd7\xe5\x64\x28\xec\x8e\xd9\x5a\xe9\x6c\xe2\xaa\xee\x35\x9b\x6d\x69\xcc\x0c\xe3\x6e\x26\x33\x0f\xd9\x9d\x56\x9b\xd7\x6a\x37\x54\x69\x23\xf5\x9d\x29\xb2\x69\x74\x40\x07\xfb\x5d\x99\x27\x43\xa8\xdc\x4d\x85\x71\x35\xd1\x73\xce\x3b\x51\xcb\x29\xec\x4e\xc2\xb9\x01\xba\xf6\x82\x8b\x70\x5f\xc8\x34\x41\x6b\x82\x32\x68\x0f\x90\xe7\x6c\xe3\xb1\x48\x3e\x60\x82\xc5\x77\x74\x27\x86\xb3\x2d\x14\x74\x21\x39\x06\x35\xd1\xfe\x7c\x40\x69\x38\x11\x8d\xda\x21\xc5\x81\xf4\xba\xa0\x9e\x63\x1e\x26\xf7\x46\x3a\xb7\xac\xdc\xc6\xaa\xa5\x83\xf9\x08\x8a\x93\x2c\x34\xb5\x14\xa2\x46\x6c\xf9\x3c\x17\x07\x50\xdf\x4e\x86\xad\x24\x0e\xa6\x8d\x91\x3b\x4d\xd8\x9a\x91\xc5\x72\x4e\xb6\x0a\x91\x6f\xea\x54\xcc\xa7\xa6\xae\xfe\xc8\x89\x3d\xd0\x27\x24\xf4\x40\x67\xca\xdb\x30\xbc\xbe\xc7\x9f\x74\xe7\x0b\x88\x14\x52\x8c\x13\x99\xcd\xd2\xca\xc9\x4a\x04\x7d\x05\x0f\x02\x51\x2a\x39\x82\x77\x60\x93\x10\x71\xc6\x43\xa2\x84\xee\x8e\x39\xb6\x5b\xa1\xf6\x2d\x6b\x8c\x5b\x4c\xe1\xca\x17\x55\xa7\x45\x4d\xe9\x17\xbc\x85\x71\x6c\x1f\xc1\xea\xd8\xec\x43\x32\x90\x6b\xc7\x74\x72\x08\x5b\x89\x30\x09\xbb\x69\xea\x50\x6b\x9a\x9c\xe7\xf4\x70\x80\x29\x63\x27\x64\x05\x7d\x7a\xfb\x58\xbe\x17\xc9\x9f\x4c\x3b\xc2\x81\x51\x08\x8f\x42\x0d\x84\xca\x5d\x6b\x0c\x3f\xf0\x75\x53\xdc\x97\x3b\x16\xe2\xa4\x7c\xc8\xeb\xcd\x9f\xd7\xc0\xb9\x77\xaa\x8b\xa6\x52\xd3\x54\x6c\xb7\xc7\x4d\xcc\xa1\x38\xdf\x81\xd5\x76\xe0\x55\x37\x03\xe5\xa9\x81\x06\x07\x70\x63\x17\xca\x87\x90\x13\x0e\xf6\x44\xdb\x69\x6d\xa6\x4a\x03\x0b\xc5\xaf\x7f\x7f\x73\x2e\xfe\x12\x8a\x88\x0e\xdf\xc0\x30\xf4\x2c\x87\x7f\x09\x45\xf8\x97\xbf\x84\xb1\x08\xff\x22\x2e\xa0\x5a\xe8\x0e\xa2\x39\x06\xf9\x65\x51\x1d\xf7\x9c\xd2\x72\xb6\x72\x9c\x51\x86\xaf\x4f\x3e\xbc\xc1\xf3\x4d\x70\x8c\x61\x2f\x4e\x8d\xc5\xd7\x30\x75\x9f\xe1\x4e\x50\x11\x35\x86\xf2\xe6\xfc\x94\xde\x8e\x6f\x92\x0e\xd6\xe8\x34\xd1\x19\xdf\xe9\xcc\x54\x31\xcf\xd1\x1d\x63\x0e\xe0\xb0\x73\x60\x66\xa1\x3b\x31\xd9\xc7\x2f\xf1\x7f\xb5\xa5\x4b\x53\xc0\x75\x09\x70\xd7\xb2\x6d\xd0\x11\xfc\x01\x97\x96\x91\xeb\x6c\xc6\x4f\x25\x2d\x7f\x6b\xfd\xe8\x53\x7f\x8e\xa0\x2e\xa6\xde\x29\x91\x96\x9e\x6b\x2a\x01\x4a\x48\xeb\xd3\x5d\x4e\x83\x3b\x37\x0f\x9d\xcb\xc3\x04\x43\x27\xbc\x04\x4d\x9e\xdb\x41\xc1\x5a\xc1\x36\x6e\xb7\x0d\x45\xb3\x17\x4b\x0f\xe6\xd4\x62\xfa\x18\x36\x03\xdb\x09\x66\xaf\x30\xbc\xc6\x4a\x63\xb3\x31\x1d\x13\x03\x3e\x85\x8d\x01\x3e\x8e\xf8\x01\xb0\xce\x30\xf6\x60\x72\xe2\x71\xcc\x3b\x06\x4a\x6d\xb7\xfa\x3e\xcc\x47\x88\x11\x26\x97\x4c\x7e\xfc\xe6\x47\x1e\x91\x73\x4a\x50\x1f\xff\xeb\x5b\x45\x74\x50\xd5\x95\x2d\x70\xbc\x29\xf4\x46\xf7\x11\x31\x0f\xe9\x55\x99\x00\x9e\xde\xdb\x75\x04\x91\xaa\x05\x74\x8a\x0b\x64\x20\x9a\x64\x51\x78\x14\x3a\xc5\xfa\x30\x95\xfd\x6d\x8f\x63\x51\xe0\xe5\xcd\x4f\x51\xbd\x43\x22\xca\xe4\xfd\x0f\x0e\xde\x97\xf4\x94\xb8\x4c\xce\xd4\x59\x81\x3e\xb4\xd8\x6e\x5f\x82\x09\xa7\x25\xd6\x0b\xb2\xaf\xb6\xdb\xab\x58\x1f\x25\xeb\x83\xcc\x1b\xaa\x2e\x55\xf8\x4d\x1d\xc3\x3b\x1c\x60\x2a\xa6\xff\xbd\x88\xae\xeb\xb9\xb8\xff\x39\xc4\x3c\x90\xc9\xbb\x75\xf1\xdd\x0f\x3b\xa9\x49\x49\xee\x4e\x17\xc8\xbe\x7f\x3a\xf9\x98\x04\xed\xfe\x48\xc6\x78\xa3\x79\x12\x75\xbb\x41\xd3\xd7\x06\xbd\x76\x53\xb8\x6b\x4e\x5a\x14\xfe\x6f\x42\xd5\x7d\xe9\xf3\x07\x79\xd6\x48\x79\x63\x42\x74\xbc\x4f\x1a\x0f\x1c\xc3\xa1\xad\xd5\xc0\x7a\x82\x58\x87\x32\x97\xf4\x7a\xa4\xf6\x5e\xad\xba\xbe\x17\xa9\xf3\xa4\x55\x32\xe8\x35\x2e\x7c\xf4\x9a\xc6\x44\x3c\xc0\xac\x25\xca\x09\x31\x57\xc6\xe3\x65\x12\xfa\xa3\x77\x55\x3c\x67\x29\x4d\x45\x4b\x7f\xc5\x82\xee\xa8\xf7\xf4\x11\x28\x3c\x55\x57\xf4\x69\x60\xfc\x8c\x69\x32\xe5\x0b\x56\xf7\xf2\x56\x77\x7b\x18\x1e\xa3\x90\x93\x01\xbd\xf2\x8d\x96\xc9\x09\xa4\xa8\xa2\xff\xa8\x92\x37\x45\xfd\xfd\x4f\x6f\x13\x6b\xe3\xe8\xbb\x2e\x9b\x94\x7a\xd8\xca\x32\x38\x3d\x68\x39\xe9\xc1\xb6\x11\x93\x4f\x40\xcc\xd9\x92\x53\x35\x6d\xbc\x3e\xe9\xb5\x88\x9d\x73\xb9\xe3\x3d\x86\x07\xdf\x24\x98\x52\x8a\x51\x84\x0f\x25\x13\x7e\x4f\x7b\x9a\xc0\xe2\x78\xd4\x81\xe4\x5e\x6f\x0b\x00\xbd\xbb\x9e\x17\xf0\x92\x0e\x89\xa0\x6e\x5a\x73\xab\x3f\xf1\xec\x42\xcc\x97\xf0\x04\x9b\x6c\xbe\x39\xe0\xde\x2a\x0d\x7c\xf7\x38\xae\xde\x97\x47\x89\x7f\x8e\xa6\x70\x69\xce\x66\xc3\x36\xb4\x83\x64\xa7\xab\xf2\x34\xb6\xde\x97\x3f\x9b\x48\xed\xed\x45\x18\x94\x28\xcd\xe1\xb8\x67\x1e\x9e\x29\xfb\xd0\x03\x26\x8a\xb4\xd9\x35\xc4\x6b\x11\x78\x1e\xfc\x03\x77\x4e\x57\xe6\xa4\xa2\xdb\x16\x0e\x62\xe5\x05\xed\x87\xf0\x89\x57\xda\x8e\xe4\x33\xee\xe6\x14\xbd\x77\x38\xdc\x43\xc1\x39\x88\xe5\x3e\x7d\x4f\x37\x85\x39\xc7\xaf\x7c\xbf\x73\xd0\xbf\x35\x49\xd7\xbe\xf2\x3b\x65\x10\xb2\xa5\x4b\x51\x4d\x8a\xa9\xab\x0c\x8a\xc6\xe5\xb9\xe0\x35\x8c\x46\x5e\x12\x11\x3c\x58\x56\x78\xcf\x6b\x27\xe2\xbb\x06\x48\x93\x60\x0c\x7b\x57\x1a\x04\xa7\xaf\xd2\xc6\x17\xbe\xf6\x43\x6f\x85\x0f\xc5\xf5\xb2\x86\xfb\xea\x8d\x12\x7a\xe0\x35\x77\x0d\xb1\xef\x8d\x7c\x2f\x9d\x19\x76\xb3\xae\x25\xea\xb8\x64\x10\x74\xbd\x90\x4f\xd3\x8a\x19\xf3\x5e\xa6\x19\x04\x4c\xe9\xba\xc1\xe6\x65\x51\x14\x6f\xd7\x3d\x11\x35\x56\xb2\xaa\xe5\x17\x51\x57\x29\x38\x09\xe9\x4c\xe9\x03\xcf\xfa\x33\x30\x0d\x5c\xd2\x09\x79\xef\x35\x9f\x58\x06\x77\x6f\x51\x95\x0b\x59\xd5\x39\x5d\x28\xec\x3c\x12\x53\xc9\xa9\xac\x60\x57\x0d\x67\xe6\x22\x81\xb7\xf4\x77\x89\x31\x4c\x71\x73\xa5\x17\xef\x91\x91\x8e\x1c\x8f\x7b\x15\x5f\x87\x5d\xe2\xee\x8f\x4e\xd4\x2c\xb9\x28\xef\x64\x11\x85\x80\x46\xe8\x6d\x73\x12\x78\xbe\xae\x02\x7c\xa2\xe7\xdb\xed\x4e\x4c\xe9\xce\x94\x1d\x0e\x28\xa2\xdd\xcc\xc7\x33\x1d\xd2\xe5\xb6\x2e\x16\x60\x75\x11\x26\xf1\xa0\x7f\xb9\xf6\xae\xd4\x3d\xd6\xa8\xbf\x3c\x4d\x62\x63\xe3\xa2\x19\xbe\xa0\xc1\xe4\xf7\xc1\x4d\x0e\x7a\xe8\x6d\x52\x70\x65\xc4\xdb\x3c\x9a\xd8\xba\x86\xc0\x5e\x3f\x41\x9f\x1f\xc0\x1b\xac\xa5\x2e\x20\x6e\xbc\x40\x87\x0a\x0c\xda\xc7\x8d\x61\x9c\xa8\x6c\x48\x78\x1f\xf7\x44\x19\x28\xc6\xf0\x08\x9e\x1a\x72\x16\xc3\xde\xa1\xb4\x66\x1c\xed\xec\x14\xa4\x33\xdc\x55\x37\x74\x88\x65\x52\xa3\x41\xf7\x88\xa2\xac\xe6\xe9\x0c\x0f\x45\xdb\x8d\x2f\x43\xb0\xbc\xa0\xe3\x6e\x78\xd3\x79\x49\xcf\xed\xe2\xfd\x02\xb0\x46\x41\x3e\xda\x3d\xf1\x44\xe8\x03\xf1\xe6\xb5\x0b\x96\xe8\x00\x0d\x56\xe5\xd9\x29\xdf\x94\xa8\x2f\x92\x86\x29\x49\x0b\xb8\xb3\x00\xbe\xf2\xad\xa6\x93\xaa\x5c\x2c\xe4\xa4\x79\xa7\x81\x4e\x81\xef\xb8\xd7\x20\x87\x48\x90\x91\x15\x46\x3a\xd3\xf2\x6f\x8d\xb8\xef\x32\x83\xc6\x87\xae\xfc\x77\xaf\x82\x49\xf7\x47\x10\xde\x15\xd4\x4e\xb2\xaf\x4d\xf5\x6d\x5d\x6b\xe0\x1c\xb9\xd8\xf2\x85\x52\x47\xa5\xb6\x91\xea\x84\x6e\x87\x37\xf9\x24\xf4\xc5\x97\x4e\x0d\x96\xf5\xaa\x60\x1f\x06\x4e\x7f\x75\xee\x1e\xc9\x63\x4e\x1a\xd0\xe5\x91\x87\x35\x19\x6d\x06\xd0\x8e\x8e\x3d\x26\x44\x00\x66\x63\xd2\x99\x2e\x18\x65\x07\x08\x7f\xeb\x18\x5b\x7b\x1b\xc7\x16\x82\xcd\x92\x86\xcc\x00\xa8\xa8\xf7\x81\xcd\x67\x57\xd2\x35\x87\x63\xa0\xf0\x3d\xde\x66\x4a\x75\x9a\x7a\x4b\x77\x35\x2f\x3a\x74\x16\x08\xf1\x72\xeb\x85\xb5\x06\x48\xf3\x02\xa8\xc7\x3e\xae\xfc\xbe\x93\xf7\x5d\xc7\x49\x2c\x21\x78\x6b\xdf\x63\x2d\x7b\xa5\x17\xb4\xa7\x63\x0f\x1e\x0b\x7c\x2d\x42\xdc\xd1\x70\x12\xcb\x8d\x97\xe0\xa9\x03\xf7\x7a\x2b\x00\x06\x97\x5b\x85\x2d\x0a\xe9\x6d\xe9\x26\x91\x28\x2f\xba\x9c\x7a\xb1\x5a\x58\x09\x9d\xe4\x6a\xd2\xc8\x00\xed\x26\xd3\x50\xf4\x88\x3d\x6a\xc7\x07\xcb\x73\x77\x7f\x1b\x76\xfe\xe0\x80\xf0\xd6\xe6\x87\x3d\xee\x84\x06\x83\x64\x88\xa1\xbd\x51\x2c\x82\x7d\xa7\x89\x08\x63\x0b\x9e\x54\x67\x40\x94\x38\x36\xcf\xa0\x9d\x9d\xea\x3d\xcf\x1c\xca\x87\x5a\x67\x1c\x77\xb3\x47\xbc\xed\x92\x1e\x58\x74\x79\xec\x1d\x15\x31\x1e\xfe\x8a\xad\xd9\xdd\xa7\x88\x56\x0e\x5b\x60\xa9\x51\x24\x6c\xbb\xd3\xfb\xbc\x26\x03\x8e\xd6\x8c\xf7\xd9\x3c\xf3\x46\x2c\xa3\x0b\x89\x43\x2e\x4a\x70\x65\x20\x11\x0c\xcc\x37\x3f\x68\x8f\x1a\x84\x77\xed\x91\x0c\x20\xd6\x39\x2b\x03\x6c\x3a\x22\x5b\xf7\x1b\x77\xc0\x80\xf4\xf0\x9b\x38\x87\x7d\x36\xb4\xc3\x1d\xbe\x23\xc3\xb5\x9c\x12\x93\x91\xb9\xee\x99\xd4\x4d\x6b\x1a\xb4\x4c\x51\xd6\x00\x9b\xae\x51\x06\x93\x11\x07\x41\xec\xd9\xa7\x6c\xf5\x48\xfb\x54\x48\xe4\xa7\xe6\xd8\xac\x7b\x69\x12\xb4\xa9\x70\x33\xb0\x19\x36\xdc\xd5\x70\x60\x93\x6c\x3a\x96\x47\x8f\x22\x8b\xed\x0a\xa1\x5b\x69\xfb\x33\x49\x9e\x9a\xe4\x47\xdf\xa4\xc9\xcf\xeb\xbc\xea\x9d\x2f\x72\x80\xc2\x83\xdb\x54\x99\x4b\xa1\xcc\xdf\x8d\x3a\xe6\xb9\xe7\xe6\xf5\x51\xa6\xc0\xdc\x37\x15\x2a\xbb\xdc\xf2\xa9\x03\x7e\x4b\x91\x8e\xcd\xa6\x05\x6f\xbb\x15\xe6\x8b\x63\xbf\x7e\x7b\x6f\x6e\xa8\xed\x38\xe3\xef\xe4\x11\x02\x23\x71\x2c\xa4\x13\x7c\x33\xfa\xd1\xb6\x10\x2c\x07\x04\x60\x40\x39\x11\x11\xff\xad\x01\x1b\x18\x39\x3c\x14\x2b\x6f\xf9\x8e\x46\xe2\x44\xc0\xd2\x98\xc1\x0d\x29\x8b\x25\xbd\x7f\x0f\xf6\x4e\x56\xca\x0a\xfc\x1d\x5c\x62\xa9\x80\x1e\xc0\x69\xc3\xae\xc4\xd8\x7f\xce\x60\xb3\xe2\x30\x96\xb9\xff\x6b\xa7\x51\x03\x30\xdc\x03\x8c\xce\xb3\xca\x50\x84\x43\x0a\xe6\xce\x80\xe0\x08\xaf\x56\x5c\xcd\x91\xf1\xd0\xda\x39\xf8\x5e\xc4\xc1\x79\x5a\xd2\xc5\x88\x83\x3d\xc0\x87\x41\x50\x9a\x85\xc4\xe5\x9b\x1d\x37\x3d\x79\xa1\xaa\xf9\xa5\xe3\x65\x5c\x75\xe4\x53\xf5\x1e\x0f\xc0\xa3\xb8\x40\xb0\x4b\x72\x3d\xae\x5e\x35\x26\x29\x20\x01\xdb\xbd\xc7\x88\x40\x3c\x14\xb1\xb2\x8d\xbe\x61\x24\xad\x0b\xa3\x2e\x94\x34\x21\xf0\x7f\x7c\x37\x08\x09\x6c\xca\x61\x2d\x1b\x31\x19\xfa\x4c\xa1\x19\x57\x63\xc1\x7a\xc2\x43\x35\xf4\x6e\x40\xf7\x2d\x30\xba\xd0\xbb\x26\xc8\x36\x69\x18\x03\x74\x6d\x30\x68\x32\xfb\xd0\x02\xc8\x75\x77\x91\x51\x0a\xae\x6a\x24\xe1\x21\x74\x94\x8f\x20\xcc\xc1\xb3\xe7\x43\xf1\x56\x35\xf4\x5d\x33\x6d\x4d\x0d\x27\xa9\xaf\xb5\x82\x5d\xb4\xff\xd8\xe3\x5d\x7d\xe9\x7e\xce\x9d\xb5\x64\x55\x75\x9d\xd0\xea\xbf\xda\xdd\xe4\x92\x05\x69\x75\xa3\xe8\x72\x42\xbc\xd3\x18\xf1\x49\x4e\x68\xd0\x3f\xa6\x0b\x18\x40\xf2\x8f\xb4\xca\x21\x40\x83\x0f\xb3\x04\xde\x59\x08\x3e\x2d\x66\x1e\xc7\xa3\x84\x60\x81\x07\xc7\xe0\x8e\x52\x5a\xdd\x30\x2d\xde\x8b\xa1\xce\x52\x3e\x22\x20\x9b\x10\x61\x87\xc7\xe2\x90\x3a\x09\xf5\x39\x13\xf8\xa2\xff\xa2\xec\x25\x5e\x2c\x80\xff\x25\x80\x6e\x2f\x96\xa3\x0c\xd6\x09\x01\x6e\x2e\x8e\xe8\x28\x8b\xff\xd0\xca\x68\xc2\x7a\xe0\x9d\xd3\x1d\x90\x58\x62\x5a\x22\x15\x3d\x44\xca\x8b\x7a\x43\x2f\x03\x1e\x8b\x43\xa2\xb6\x7e\xe8\xf3\x58\x1c\xc2\xbf\xfb\x53\x27\x37\xc7\x0c\x98\xf9\x0c\x35\xe0\x0a\x96\x55\xec\x0e\x72\xcf\x91\x04\x47\xf0\x28\xeb\x61\xee\x8e\xaa\x4b\x95\xb2\x4c\xf1\x4e\x3a\xb4\xf5\x1e\xce\x6d\x48\xda\x34\xbc\x7a\xf4\x79\x2b\x5c\x11\x56\x6c\x2d\x6a\x35\xec\xbe\x72\xd0\x1e\x41\xe5\xb8\xf5\x93\xcf\x2f\x76\x20\x63\x2e\x90\x7b\xe8\x8c\x61\xd7\xe0\xba\xc1\xfd\xd7\x9f\x33\xec\x41\x64\x6d\xc6\xd4\xf3\x74\x05\x77\xda\x7f\x5e\xdb\x1e\xc1\xb1\xc7\x37\x09\x15\xc6\x8c\xce\xa6\xec\x4f\x9f\x35\x5f\x40\x6c\xce\x5e\xf9\x67\x7c\x1c\xbe\x6c\x6d\x91\x98\x4b\x8f\x77\x1c\xe9\x71\x38\xc5\x7f\xd3\x20\x60\x6d\x47\x1a\x84\xe8\xe1\x18\x9d\x5c\xb0\x4b\xef\x35\x1a\x37\x3d\x61\xab\x7a\x48\x25\xb5\xb5\x9e\x7d\xb8\xdd\x7d\x88\x08\x55\x1f\x84\xcd\x28\x38\xae\x55\x19\xbd\x9c\x20\xce\x6a\x13\xb3\x9f\xa6\x94\xa7\xe1\x28\x5b\xb8\x1e\x66\x92\x4f\x31\xfc\x5d\x77\x6a\xc8\x21\xdd\xae\xc1\x0d\x35\x2a\x88\x05\xc4\xcc\xac\x0f\x64\xa1\xba\x6a\xb3\x35\x6a\x48\x76\xee\xd7\x80\xde\x1d\xfe\x43\xea\x8c\xec\x4c\x22\x06\xb8\x3a\xfd\xe7\x9d\x1b\x0a\xec\xc1\xe3\xce\x01\x7b\x5e\x94\x9b\x3f\xc4\xed\x0a\x4f\xad\xf6\x9d\x54\x86\xbf\xb3\xc6\x82\x7b\x60\x8d\xe1\x14\xfc\xe9\x22\xa4\x05\xf5\x7f\xb3\x24\x69\xe1\x73\xf7\xa4\x57\x60\x76\x09\x95\x27\x52\x65\x9d\xc0\x96\xd2\x57\x63\x8c\xce\xf5\xb5\x30\x37\x39\x3e\xf6\x78\x34\x88\xb2\xae\xa3\x7b\xdc\x09\x40\x18\xea\x44\x0f\xa7\x93\x1a\x0e\xb9\xb1\x33\xb4\x4e\xf0\xa7\xba\x44\x18\x97\x2f\xae\xe8\xc2\x1d\xd7\x0d\x7a\x10\x65\xff\xdc\x1c\x02\x1c\x04\xfb\x9e\x15\xec\x97\xa1\x9d\xa7\x05\x59\xb0\xb6\x44\xe8\xce\x13\x83\x0f\x1c\x4b\xe9\x9e\xbf\x3e\x2a\xfa\x7b\x50\xce\xdf\x26\x5b\x10\xbe\x8e\x8e\xc4\xdf\x9d\x57\xa4\x6c\x94\xc8\x15\xbd\x14\xdb\xe0\x7d\x46\x7d\xcb\xb2\xa9\x47\xa1\xa4\xa3\x91\x86\xad\x77\xb7\x44\xa8\x6b\x8d\xd0\xc8\x56\x23\xba\x01\x9d\x25\xbf\xf3\xe6\x3f\xee\x7c\xe0\x99\x40\x8c\x60\x81\x5b\x02\xe1\x2c\x14\x2a\x26\x7c\x65\x4f\x19\x59\xb4\x54\x32\xa0\x38\xdb\xeb\x1e\xc1\xe9\x0f\xb5\x89\x59\xb9\x40\x28\x2e\x6a\xf0\xaa\xa0\x03\xd1\xb9\xfd\xd7\x92\xe7\x71\xe8\x7a\xf1\x36\x0f\x79\xf1\x1d\xc0\xf8\x92\x42\x0a\xc9\x10\x12\x31\xf2\x9b\x02\xee\xc4\xc0\x9e\x2b\xf9\x9b\xcc\x4c\x20\x0f\x36\x77\xaa\x1b\xad\x4f\x74\xe4\x4e\x1d\x0f\x46\xa3\xc1\x68\x14\x90\xb4\x65\x29\xa2\x49\x70\x2e\xd7\x1f\x10\x9a\x33\x98\x08\x5f\x8f\x1a\x8d\x5a\xf2\x61\x34\x32\x3c\x04\x4c\x35\x1a\x05\x40\x87\x80\x30\xd6\x40\xe1\x82\xe8\xd7\xf8\x21\x82\xb9\x3e\xad\xe0\x7a\xc9\x68\x52\xc1\x05\x81\xf0\xc1\xed\x07\x31\x8a\x63\x1d\xf4\x6b\x15\x74\xcc\x52\x2c\x74\xe2\x7e\xf3\xea\xe3\x4c\x1c\xe9\x09\xe0\xd3\x8c\xee\x64\xc3\xfa\x99\xc8\x8c\x9f\x29\xe8\xe3\x68\xad\x79\x8d\x3f\xeb\x1a\x08\xce\x64\x50\x0c\xb3\x93\xbd\x51\x28\x3c\xc4\xdd\x6b\xc7\xae\xe9\x7f\xe9\x31\xd8\x75\x2d\x72\xd0\x19\x00\x24\x19\xd3\xfb\xd8\xa3\x27\x5c\x9a\x7f\x37\xdf\x7b\xc4\x9c\x05\xa6\xc8\x6d\x1f\x8d\x9a\x64\xe1\xe4\x89\x2e\x23\x8b\x0d\x1c\xef\x41\x25\x3a\x1c\x1c\xd0\x5b\x67\xca\xf3\xeb\xac\x9e\xf3\xf0\xed\x5e\xa8\x13\x9d\xc6\x3a\x52\x9f\x67\x23\xec\x82\xe9\x6d\x8f\x04\x7b\x82\x85\x06\x5d\x56\x22\x72\xc4\xf4\x41\x2c\x22\x8f\xaa\x74\x8a\xa1\x79\x0f\x61\xc7\x6b\x7a\x08\x85\x13\x39\x4c\x6e\x09\x3f\xdb\xe1\x8d\x3e\x31\x51\x7f\x02\xd7\xb8\x0f\xf0\x4f\x19\x6c\x93\xcf\x9a\xfc\xd2\x98\xe5\x9e\x29\xed\x7e\xb8\xb3\x39\x18\x02\xb1\xd7\x8c\x8d\x8e\xc4\x07\xe7\xd5\xab\x6e\xfa\xb9\xec\x31\xdc\x75\x85\x5c\x6b\xb1\xb5\x49\x03\x6f\xa2\xec\x33\xf5\xb8\x31\xd5\x9c\x7f\xfa\x68\xd6\xcf\x41\xc5\x17\xe6\x42\x28\x82\xff\x8e\x16\xa9\xca\xd2\x99\x38\x48\x3e\x64\xe5\x42\x26\xdf\xc2\x91\x71\x48\x1d\x64\x3d\xbe\x62\xdb\xd4\x34\x31\xdb\x00\x9a\x05\x9c\x38\xc4\xe1\xa1\xf8\x08\x38\x27\x1f\xb2\xb4\x20\x06\x31\x12\x18\xce\xf7\xe8\x0b\x33\x22\xac\x04\x46\x83\x8d\x5a\x05\xf9\x04\x03\x56\x60\x56\xf1\x56\x1b\x89\x85\x80\x28\x6b\x02\xcf\x4e\x98\xd8\x7f\xcd\x19\x41\x06\x05\x47\xbe\x9c\xc7\x76\xf5\xfb\x76\x90\xce\x99\xa5\x50\x3e\x6e\x21\x8a\x4d\x53\xa5\xf2\x9b\xc2\x94\x9e\xe0\x4f\x28\x41\xc8\xad\xb1\xd1\xc9\x6c\xf6\x4c\x79\x03\x36\x16\x4d\x24\x1d\x87\x22\x08\x26\x92\xcd\x72\xc8\x68\x87\x0b\x5c\x09\x80\xde\xaf\xa3\x1f\xf1\xf3\xe2\xea\x49\x11\x9b\xc6\x51\x6e\x22\x1e\x35\x83\xbe\x6d\xdc\x17\x7e\x0d\xc5\x21\x5d\xbd\x98\x53\x7f\x5b\x37\x04\xe3\xd7\xb4\x77\xdc\x52\x35\x97\x4e\x7c\x50\xbd\x49\x8e\xa1\x80\x7e\x9a\x8f\x24\xdb\x8c\x4f\xe0\x0b\xfd\xb8\x32\x3f\xa8\x1c\x7b\x18\xe0\x24\xf4\xd2\x48\x43\xd7\x9f\xe1\x2f\x43\x37\x0a\x57\x79\x8b\xd9\xfc\xb9\xc7\xba\x7b\x48\x0a\x39\x2c\xfa\x07\x96\xd7\xba\x6b\x79\xe9\xae\x5f\x89\xf5\xbe\x0b\x6b\xfd\xb4\x85\x85\xde\x81\xc0\x53\x15\xe7\xcb\xd9\xec\xac\xa8\xff\xe3\xdf\xff\x55\x56\x09\xdf\x44\xfa\xf0\x3a\xf9\xe6\x69\xeb\x84\xd9\x8b\x6e\x7c\xa5\xee\x0e\xc9\x23\x7b\x2a\xab\xd3\x72\xdb\xc1\xec\xc6\xcb\xd3\xef\x68\xc3\xad\xda\xf8\x21\x41\xf2\xef\xcf\xfa\xdf\x5c\x71\x6f\x97\xc7\x8e\xa4\x7b\xfe\xcd\x1e\xec\xff\x3f\x07\x00\x6c\xb8\x07\xfd\x5e\xbb\x00\x00")
 
 func templatePaginationTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -178,12 +284,12 @@ func templatePaginationTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/pagination.tmpl", size: 13582, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/pagination.tmpl", size: 47966, mode: os.FileMode(436), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templatePagination_testTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x7f\x6f\xdb\x36\x10\xfd\x5b\xfa\x14\x07\x22\x58\xe5\xc0\x61\x12\xb7\x01\xd6\x00\x06\xd6\xc6\x5e\xe1\xa1\x35\xd6\x35\x2d\xb0\x05\x45\x41\x51\x27\x89\x88\x4c\xaa\x47\x2a\xad\x21\xe8\xbb\x0f\x47\x39\x5b\xd6\x78\x1e\x86\xfe\x61\x43\xba\x77\x3f\xde\xbd\x47\xaa\xef\x4f\x8f\xd3\x2b\xd7\x6e\xc9\x54\x75\x80\xd9\xd9\xf9\xf3\x93\x96\xd0\xa3\x0d\xf0\xb3\xd2\x98\x3b\x77\x0b\x2b\xab\x25\xbc\x68\x1a\x88\x49\x1e\x18\xa7\x3b\x2c\x64\x7a\x5d\x1b\x0f\xde\x75\xa4\x11\xb4\x2b\x10\x8c\x87\xc6\x68\xb4\x1e\x0b\xe8\x6c\x81\x04\xa1\x46\x78\xd1\x2a\x5d\x23\xcc\xe4\xd9\x3d\x0a\xa5\xeb\x6c\x91\x1a\x1b\xf1\xd7\xab\xab\xe5\xfa\xdd\x12\x4a\xd3\x20\xec\x62\xe4\x5c\x80\xc2\x10\xea\xe0\x68\x0b\xae\x84\xf0\x60\x58\x20\x44\x99\x1e\x9f\x0e\x43\x9a\xf6\x3d\x14\x58\x1a\x8b\x20\x5a\x55\x19\xab\x82\x71\xf6\x53\x40\x1f\x04\xec\xf0\xa3\xf6\xb6\x82\xcb\x39\xe4\xca\x23\x1c\xc9\x2b\x67\x4b\x53\xc9\x5f\x95\xbe\x55\x15\x72\x52\xdf\x9f\xc0\x17\x13\x6a\xc0\xaf\x01\x6d\x01\x47\x20\x76\xa8\x80\xac\x25\x63\xc3\xd8\x43\x8c\x7d\x27\x5c\x93\xf4\x3d\x04\xdc\xb4\x8d\x0a\x08\xa2\x46\x55\x20\x09\x90\xf7\xed\xb8\x0d\x8f\x37\x9b\xd6\x51\x80\x2c\x4d\x44\xbe\x0d\xe8\x45\x9a\x08\x1f\x48\x3b\x7b\xc7\x8f\xdc\xcf\xd8\x4a\xa4\x69\x22\x98\xe9\x63\x72\x9c\x55\x99\x50\x77\xb9\xd4\x6e\x73\xea\x03\x61\xd0\x35\x9d\xc6\xca\x72\x7b\xaa\xbc\x47\x0a\x22\x9d\xa4\x69\xd9\x59\x0d\xd7\xe8\xc3\x55\x47\xde\xd1\xd2\x6a\x57\x18\x5b\x65\x01\x8e\x77\x73\xe4\xf5\x04\xfa\x34\x09\xf2\xb7\xce\x66\x22\x26\xe0\x02\xf9\x5f\x4c\x81\xcb\x1f\xe7\x26\xda\x59\x1f\x17\x48\x12\x53\xc0\x1c\xfa\x1e\x4c\x09\x47\x72\xb5\xb8\xde\xb6\x28\xd7\xdd\x06\xc9\x68\x18\x86\x67\xb3\xbe\x07\x6c\x7c\x64\xfd\x6c\xc6\xfb\xec\x54\x48\x92\xe4\x4e\x35\x1d\xc2\x1c\x44\xe9\x5c\xae\x48\x70\xec\x73\xe7\x02\xc7\x3a\x63\xc3\x8f\xd9\x13\xf1\x64\x92\x26\x09\xff\xee\x14\x41\xde\x95\x10\x15\x93\x2f\xbb\xb2\x44\x62\x26\x6c\xe2\xbd\x9f\xc3\x20\xc7\x35\xfb\xd5\xe2\x12\x4c\x31\x85\x0f\x3c\xe2\x12\xc6\x49\x3c\x54\xcb\x37\x8a\x7c\xad\x9a\x57\x6f\x5f\x67\x3f\xe4\x5d\xc9\xbd\x7d\x3c\x09\x5d\x29\xdf\x05\x62\x71\x38\x36\x6a\x28\x97\x9f\x3b\xd5\x64\x61\x0a\x91\xd8\x14\xfc\xcd\xd9\x47\x86\x2d\x97\x34\x68\x33\x3f\x81\x13\x38\x3f\x54\x60\x63\x81\x86\x7d\x3c\x99\x12\x12\x71\x33\x2d\xdf\xdb\xcd\xdf\xdc\xfc\xcd\xf9\xe5\x58\xb9\x6b\xbc\x76\x4b\x22\x47\xdc\x1a\x89\xf6\x51\xe4\x85\xb5\x5c\x2d\xf6\x61\x51\x01\x86\xa3\x22\x93\x34\x19\x26\xdf\x78\xbe\x76\x11\xfa\x2f\xd3\x0f\xfb\x7d\x71\xf1\xc0\xef\x8b\x8b\x7f\xf8\xfd\xff\x1c\x84\xd1\xc2\x03\xa6\x45\x1d\xb8\x78\x77\x77\xe4\x7b\x1b\x35\xcf\x1e\x3a\x79\x50\xc0\xc3\x9e\x3c\xb6\xe4\x7b\xdd\x58\x9b\x88\xec\x35\x61\xbc\x72\x2f\x55\xb1\xb2\x6d\x17\xfe\xdd\x05\xc3\x70\x3c\xb0\x37\x1f\x8d\x0d\x48\xa5\xd2\xd8\x0f\x0c\x25\x67\x5f\x73\x55\xe4\x88\xe5\x94\xdf\x84\x8e\xfb\xfc\x94\xab\xe2\x7c\xf6\x54\x8c\xb1\xdf\x9f\x7e\xd8\xea\xd9\xf3\xed\xdb\x57\xbf\xd4\x7f\x2c\x96\xdb\x37\x5f\xe6\xf3\x08\xf1\xd6\xa5\x23\xf8\x34\x85\x38\x82\x27\x90\xb2\x15\xc2\x6e\x62\x3f\x5e\x59\x02\xfd\x58\x33\x86\xf6\x1f\xe3\x58\xcc\x12\xfc\xa5\xcf\x37\xb2\x0d\x51\x05\xfe\x40\xde\x9f\x94\x3f\x03\x00\x00\xff\xff\x71\x15\x8f\x1b\x82\x06\x00\x00")
+var _templatePagination_testTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\x61\x6f\xdb\x36\x10\xfd\x4c\xfe\x8a\x03\x11\xac\x72\xe0\xd0\x89\xdb\x00\x6b\x00\x03\x6b\x63\xaf\xf0\xd0\x1a\xeb\x9a\x16\xd8\x82\xa0\xa0\xa8\x93\x44\x44\x26\x55\x92\x4a\x6b\x08\xfa\xef\xc3\xd1\x0e\x96\x25\x8e\x87\x7d\xb0\x61\xf3\xdd\xdd\x7b\xf7\x1e\xa5\xbe\x9f\x1c\xf3\x4b\xd7\x6e\xbc\xa9\xea\x08\xd3\xd3\xb3\xd7\x27\xad\xc7\x80\x36\xc2\xaf\x4a\x63\xee\xdc\x2d\x2c\xad\x96\xf0\xa6\x69\x20\x15\x05\x20\xdc\xdf\x61\x21\xf9\x55\x6d\x02\x04\xd7\x79\x8d\xa0\x5d\x81\x60\x02\x34\x46\xa3\x0d\x58\x40\x67\x0b\xf4\x10\x6b\x84\x37\xad\xd2\x35\xc2\x54\x9e\xde\xa3\x50\xba\xce\x16\xdc\xd8\x84\xbf\x5f\x5e\x2e\x56\x9f\x16\x50\x9a\x06\x61\x77\xe6\x9d\x8b\x50\x18\x8f\x3a\x3a\xbf\x01\x57\x42\x7c\x40\x16\x3d\xa2\xe4\xc7\x93\x61\xe0\xbc\xef\xa1\xc0\xd2\x58\x04\xd1\xaa\xca\x58\x15\x8d\xb3\x5f\x23\x86\x28\x60\x87\x1f\xb5\xb7\x15\x5c\xcc\x20\x57\x01\xe1\x48\x5e\x3a\x5b\x9a\x4a\xfe\xae\xf4\xad\xaa\x90\x8a\xfa\xfe\x04\xbe\x9b\x58\x03\xfe\x88\x68\x0b\x38\x02\xb1\x43\x05\x64\xad\x37\x36\x6e\x67\x88\xed\xdc\x11\xf5\xb0\xbe\x87\x88\xeb\xb6\x51\x11\x41\xd4\xa8\x0a\xf4\x02\xe4\xfd\x38\x1a\x43\xf4\x66\xdd\x3a\x1f\x21\xe3\x4c\xe4\x9b\x88\x41\x70\x26\x42\xf4\xda\xd9\x3b\xfa\x49\xf3\x8c\xad\x04\xe7\x4c\xf4\xfd\x3e\x71\x54\x55\x99\x58\x77\xb9\xd4\x6e\x3d\x09\xd1\x63\xd4\xb5\x9f\xa4\xce\x72\x33\x51\x21\xa0\x8f\x82\x8f\x38\x2f\x3b\xab\xe1\x0a\x43\xbc\xec\x7c\x70\x7e\x61\xb5\x2b\x8c\xad\xb2\x08\xc7\x3b\x1e\x79\x35\x82\x9e\xb3\x28\xff\xe8\x6c\x26\x52\x01\xce\x91\xbe\xc5\x18\xa8\xfd\x69\x2d\xd3\xce\x86\xb4\x00\x63\xa6\x80\x19\xf4\x3d\x98\x12\x8e\xe4\x72\x7e\xb5\x69\x51\xae\xba\x35\x7a\xa3\x61\x18\x5e\x4d\xfb\x1e\xb0\x09\x49\xf5\xab\x29\xed\xb3\x73\x81\x31\x76\xa7\x9a\x0e\x61\x06\xa2\x74\x2e\x57\x5e\x70\xc6\xd8\xb7\xce\x45\x3a\xeb\x8c\x8d\x3f\x67\x2f\xc4\x8b\x11\x67\x8c\x3e\x77\xca\x43\xde\x95\x90\x1c\x93\x6f\xbb\xb2\x44\x4f\x4a\x28\xc4\xfb\x3c\x87\x41\x6e\xd7\xec\x97\xf3\x0b\x30\xc5\x18\xbe\x10\xc5\x05\x6c\x99\x06\xaa\x97\x1f\x94\x0f\xb5\x6a\xde\x7d\x7c\x9f\xfd\x94\x77\x25\xcd\x0e\x34\x24\xef\x4a\xf9\x29\x7a\x32\x87\xce\xb6\x1e\xca\xc5\xb7\x4e\x35\x59\x1c\x43\x12\x36\x86\x70\x7d\x7a\x43\xb0\xa5\x96\x06\x6d\x16\x46\x70\x02\x67\x87\x1a\x6c\x6a\xd0\xb0\x4f\x27\x49\x42\xef\x69\x98\x96\x9f\xed\xfa\x1f\x6d\xe1\xfa\xec\xc2\xde\x3c\x50\xb2\x72\x0b\xef\x9d\x27\x2d\xe8\xfd\x3e\x89\xb4\xb0\x96\xcb\xf9\x3e\x2c\x39\x40\x70\x72\x64\xc4\xd9\x30\x7a\x94\xf9\xca\x25\xe8\xbf\x42\x3f\x9c\xf7\xf9\xf9\x83\xbc\xcf\xcf\xff\x95\xf7\xff\x4b\x10\xb6\x11\x1e\x08\x2d\xf9\x40\xcd\xbb\x67\x47\x7e\xb6\x29\xa4\xec\x61\x92\x07\x0d\x3c\x9c\xc9\xd3\x48\x0e\x0e\x7b\xec\xf8\x9e\x34\x56\x26\xdd\x8c\xbd\x21\x6c\x1f\xb9\xb7\xaa\x58\xda\xb6\x8b\xcf\xa7\x60\x08\x4e\x17\xf6\xfa\xc6\xd8\x88\xbe\x54\x1a\xfb\x81\x20\x76\xfa\x23\x57\x45\x8e\x58\x8e\xe9\x9f\xd0\x69\x9f\x5f\x72\x55\x9c\x4d\x5f\x8a\xed\xd9\x9f\x2f\xbf\x6c\xf4\xf4\xf5\xe6\xe3\xbb\xdf\xea\xbf\xe6\x8b\xcd\x87\xef\xb3\x59\x82\x68\xeb\xd2\x79\xf8\x3a\x86\x44\x41\x0c\x5e\xd9\x8a\x5e\xbf\x89\x31\x31\x50\x86\xfa\xa9\x67\x9c\x3d\x77\x8d\x53\x33\x59\x70\xef\xc1\x63\xdb\x86\xe4\x02\xbd\x20\x01\x6d\x01\xc3\xc0\xff\x1e\x00\x71\x15\x8f\x1b\x82\x06\x00\x00")
 
 func templatePagination_testTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -198,12 +304,12 @@ func templatePagination_testTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/pagination_test.tmpl", size: 1666, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/pagination_test.tmpl", size: 1666, mode: os.FileMode(436), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _templateTransactionTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x53\xc1\x6e\xdb\x3a\x10\x3c\x8b\x5f\x31\x4f\xc8\xc1\x0a\x1c\x3a\x2f\xb7\x06\xf0\x21\x30\x12\x20\x40\xe0\xa2\x88\x7f\x80\x21\x57\x31\x51\x99\x54\x96\xeb\xc4\x81\xe0\x7f\x2f\x44\x49\xad\xeb\x02\xbd\xf4\x22\x81\x3b\x3b\x33\xdc\x21\xd9\x75\x8b\x4b\xb5\x8a\xed\x27\xfb\xd7\xad\xe0\xe6\xfa\xff\x2f\x57\x2d\x53\xa2\x20\x78\x30\x96\x5e\x62\xfc\x8e\xc7\x60\x35\xee\x9a\x06\xb9\x29\xa1\xc7\xf9\x9d\x9c\x56\x9b\xad\x4f\x48\x71\xcf\x96\x60\xa3\x23\xf8\x84\xc6\x5b\x0a\x89\x1c\xf6\xc1\x11\x43\xb6\x84\xbb\xd6\xd8\x2d\xe1\x46\x5f\x4f\x28\xea\xb8\x0f\x4e\xf9\x90\xf1\xa7\xc7\xd5\xfd\xfa\xf9\x1e\xb5\x6f\x08\x63\x8d\x63\x14\x38\xcf\x64\x25\xf2\x27\x62\x0d\x39\x31\x13\x26\xd2\xea\x72\x71\x3c\x2a\xd5\x75\x70\x54\xfb\x40\x28\x85\x4d\x48\xc6\x8a\x8f\xa1\xc4\xf1\xd8\x43\x42\xbb\xb6\x31\x42\x28\xb7\x64\x1c\x71\x89\x0b\x0c\xac\x2b\xf8\x1a\x81\x70\xa1\x9f\x25\xb2\x79\x25\xbd\x36\x3b\x42\x99\xde\x9a\x4c\x06\x80\xae\x43\x6d\x7c\xf3\x9b\x32\x98\xde\xf6\x9e\x29\xe1\xf9\xdb\x13\xd2\xc0\x1d\xed\xae\x40\xc1\x65\x7d\xbf\x6b\x23\x0b\x66\xaa\x28\x6d\x0c\x42\x07\x29\x55\x51\x3a\x23\xe6\xc5\x24\x5a\xa4\xb7\x66\xe1\xd8\xbf\x13\xf7\x65\x62\x8e\x9c\x4a\x55\x29\xb5\x58\xe0\x6b\x4b\x61\x73\x40\x6c\x29\x24\x18\x9c\x5a\x9b\xe0\xc0\x24\x7b\x3e\x47\x4c\xd3\x33\x47\x27\x98\x26\x86\x57\x7c\x78\xd9\xe6\x2c\x2d\x93\x11\x72\xa7\xfd\x5a\xd5\xfb\x60\x31\xb3\xb8\x5c\x35\x9e\x82\x54\xa3\xed\xcc\xca\x61\xd2\xd1\xab\xe1\x5f\x61\x76\x56\x99\x63\xd8\xbc\xde\x1c\xe6\xc8\xbb\xaf\xd0\xa9\x42\x86\x15\x6e\x97\xb0\x7a\xd0\xaa\x54\xe1\xeb\x5c\xfc\x6f\x89\xe0\x9b\xbe\xad\x18\x46\xe8\x97\xf3\xe1\x43\xcc\xaa\x38\xaa\xa2\x37\x5f\x62\x4d\x1f\x9b\xc3\xe8\xd4\x6b\xcc\x91\x75\x7e\x82\x67\x90\x1e\x26\x98\x55\x95\x9a\x94\x47\x24\xab\xab\xe3\x49\xaa\x0f\x1c\x77\x23\x3d\x07\x7c\x9a\x49\x42\xcd\x71\x07\x9b\xd5\xf2\xc1\x92\xeb\xaf\xe3\x34\xfb\x10\xd9\x1f\x3a\xff\x94\xd8\x68\x76\xbb\xc4\x99\xe2\x90\xdb\x08\x2f\xff\x1e\x5d\xe4\xa4\xd7\xf4\x31\x2b\x43\x9c\x18\x46\xa4\x7f\x73\x0e\x12\xa7\xbd\x95\x55\x8e\x78\x4a\x28\xf7\xe9\x5f\x87\x5e\xa9\xe1\x2d\x8d\x17\xf8\x47\x00\x00\x00\xff\xff\xfd\x7b\xf0\xa3\x1a\x04\x00\x00")
+var _templateTransactionTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x58\x4d\x6f\xe3\x36\x10\x3d\x4b\xbf\x62\x2a\xe4\x20\xa5\x5e\x7a\xbb\xb7\xa6\xc8\x21\x9b\xf5\x02\x8b\x06\x49\xb1\x31\xda\x43\xd1\x03\x23\x8d\x6d\x22\x14\xa9\x90\x63\x47\x81\xa1\xff\x5e\xf0\x43\xb2\xec\x78\xb7\xdb\x74\x0f\xe9\x25\x91\x48\xce\x7b\xc3\x99\xc7\xd1\xd0\xdb\xed\xf4\x34\xbd\xd4\xcd\x93\x11\xcb\x15\xc1\xbb\xb7\x3f\xfd\xfc\xa6\x31\x68\x51\x11\x7c\xe4\x25\xde\x69\x7d\x0f\x9f\x54\xc9\xe0\x42\x4a\xf0\x8b\x2c\xb8\x79\xb3\xc1\x8a\xa5\xf3\x95\xb0\x60\xf5\xda\x94\x08\xa5\xae\x10\x84\x05\x29\x4a\x54\x16\x2b\x58\xab\x0a\x0d\xd0\x0a\xe1\xa2\xe1\xe5\x0a\xe1\x1d\x7b\xdb\xcf\xc2\x42\xaf\x55\x95\x0a\xe5\xe7\xaf\x3e\x5d\xce\xae\x6f\x67\xb0\x10\x12\x21\x8e\x19\xad\x09\x2a\x61\xb0\x24\x6d\x9e\x40\x2f\x80\x46\x64\x64\x10\x59\x7a\x3a\xed\xba\x34\xdd\x6e\xa1\xc2\x85\x50\x08\x19\x19\xae\x2c\x2f\x49\x68\x95\x41\xd7\xb9\x29\xc2\xba\x91\x9c\x10\xb2\x15\xf2\x0a\x4d\x06\x27\x10\xad\x4e\xec\x83\x84\xb3\x73\xc0\x07\x38\x61\xb7\xa4\x0d\x5f\x22\xbb\xe6\x35\x42\x66\x1f\xa4\x07\x48\x45\xdd\x68\x43\x90\xa7\x49\x56\x6a\x45\xd8\x52\x96\x26\x59\xc5\x89\xdf\x71\x8b\x53\xfb\x20\xa7\x95\x11\x1b\x34\x6e\x18\x8d\xd1\xc6\x66\x69\x9a\x6c\xb7\x6f\x40\x2c\x02\x43\xd7\xa5\x49\x62\xa9\x72\xcf\x7b\x96\x6e\x61\x92\xa1\xa2\xa5\x66\x42\x4f\x51\xd1\xb4\x12\x5c\x62\x49\xd9\x97\x26\x1c\x61\x16\xe0\x51\x55\xce\xc3\x22\x4d\x0f\xc9\xa6\x53\xb8\x69\x50\xcd\x5b\xd0\x0d\x2a\x0b\x1c\x46\x71\x01\xae\x2a\x30\x48\x6b\x73\x38\xc3\xa5\x37\x8d\xdb\x04\x2e\xb5\x5a\xc2\xa3\xa0\x95\xcf\x47\x69\x90\x13\x56\x63\x03\x96\x26\x8b\xb5\x2a\x21\x2f\xe1\xf4\x52\x0a\x54\x54\x44\xe2\xbc\xa4\xb6\x07\x62\x97\xe1\x7f\x01\xf9\xc1\xc8\x04\x42\xe8\xd8\xbc\x9d\x80\x8f\x5d\x01\xdb\x34\x49\x28\xbc\xba\xd4\x94\x2c\x80\x15\x69\x92\x88\x85\x1f\xfd\xe1\x1c\x94\x90\x7e\x61\x12\xf6\xe1\xde\x27\xe1\x0f\x1a\x93\x26\x89\x0b\xf8\x5a\x3d\x1a\xde\xdc\xa8\x4b\x5d\xd7\x82\xf2\x00\xe1\xdc\x3a\x87\x6b\x7c\x9c\xb7\xd1\x07\x07\x3e\x81\x83\xd9\x83\x39\x16\x36\x97\x17\x45\x3a\x50\xc6\x29\x4f\x9b\x26\x5d\x3a\x8a\xfa\x1f\x82\x56\x37\x8d\x0b\x90\x3d\x9a\x80\x21\xa4\x4b\xb1\x41\x05\x3a\x2e\xcd\x91\x2d\x19\x08\xab\x25\x77\x03\x1e\x50\xe2\x06\x65\xf1\xb5\x94\x7d\x97\x74\x8d\x3c\x3e\x96\xb9\x89\xf3\xd1\xc2\xa9\x7d\x90\x6c\xde\xc6\x85\x2f\x4c\xe7\x7b\x5c\x8a\x28\x90\x00\xfb\xff\xc8\xec\x3e\x27\xd4\xfc\x1e\xad\xcf\x21\x2a\x12\x24\xd0\x86\x02\xd5\xe7\x74\x14\x75\x78\x58\xa3\x79\x02\x5a\x19\xbd\x5e\xfa\xdc\xf8\xcc\x86\x50\xf5\x56\xa5\x4f\x07\xf0\x05\xa1\x01\x41\xf0\xc8\x2d\x94\x5e\xb8\x84\xd5\x04\xb8\xe7\x12\x06\x96\x86\x37\xab\x07\x09\x16\x5d\x31\xf0\xb2\xa9\xf9\x93\x07\xbc\x43\x57\x97\xb5\xdc\x60\x15\x71\x3c\xb0\x07\xe9\xb5\x15\x2a\x6b\x83\xe6\xcd\x42\xa0\xac\xa0\x76\x25\x3b\xba\x30\xdf\xb9\x8c\xa6\xe8\xb5\xf2\x2c\xd6\x70\x3a\x6f\xfb\xb4\xb2\x61\xdc\xe9\x2a\x57\xae\x6a\x5c\x46\xaf\x4d\xb1\x7b\xdc\xcb\x68\x18\xfd\xe8\x0c\xbc\xd5\x51\xbd\xf5\x3c\xbe\x28\x04\xf3\x5e\x24\x67\xe7\xe0\x88\x58\x64\x8e\xd9\x2a\x7e\x79\xa6\xa0\x81\x31\x28\x27\x68\x27\x49\x2a\xb3\x71\x85\x85\x5a\x56\x6a\xb5\x10\x4b\x16\x45\x9b\x9f\x52\xfb\xc1\x3f\x16\xfd\x3a\xe6\x55\x33\xe4\x61\xde\x6e\xc3\x82\x33\x70\x93\x95\xd9\x74\xe9\x88\xc6\xcb\x25\x49\x3a\x67\xde\x15\x83\x72\x46\xe6\xee\xeb\xc8\x77\x79\x1d\xab\x64\x02\x8f\x2b\x6d\xd1\x5b\x58\xe2\x84\x35\x2a\xb2\xc0\x0d\x02\xb6\x58\xae\xdd\x51\xbe\x73\x2a\xc2\x78\xc8\x80\x56\x9c\x7c\x79\xc1\x0a\x04\xb1\x34\xa1\xa7\xa6\xcf\x77\x60\xb3\x64\xd6\x25\xf9\xe8\xc5\xaf\x07\x0b\xfe\x0f\xbe\x45\x35\x8b\xba\x91\x91\x70\x47\xc0\xe6\x2d\x08\x45\x68\x16\xbc\xc4\x5d\xe9\xd8\xe1\xf7\x09\xce\x87\x34\xc5\x1a\x05\xe1\x5b\xc7\x66\xc6\xcc\xdb\x0f\x5a\x21\x44\xba\xcf\x5a\xca\x3b\x5e\xde\xbf\x98\xb0\x07\xf8\x76\xca\x5b\xbe\xc1\x46\x0b\x45\xb1\x20\xba\x04\xd8\x61\x6c\xa8\x96\xe1\xd4\x2a\x5e\x0f\xed\xc7\x28\x37\x83\x2f\xbd\x2a\x07\xd0\xe3\xc5\xd2\xc3\x58\x32\x42\x2d\x07\x3f\xd3\xa4\x6f\x09\x5c\x2d\xcf\x57\xdc\xce\x87\xbe\x04\x37\xa8\x28\x2b\x20\xf7\x0f\xd7\xba\x42\x0b\x27\xcc\xff\x2f\xdc\x17\x7e\x4f\xfb\xd4\xf6\x82\x9d\xb5\x58\x3a\x07\x26\x90\xdd\x5e\xfc\x3e\xfb\xed\xe6\xd3\xf5\x1c\xb2\x1f\x1d\xfb\x04\xfe\xfc\x6b\x08\xe5\xb6\xdb\x76\xbe\x98\x1d\x3b\x23\xfb\x47\x64\xe0\x72\x8e\xd8\x70\x4c\x66\xfe\x39\x1f\xce\x8b\x43\xf1\x43\xfb\x40\xde\x77\xcb\x86\xd8\xe6\xce\x8f\x62\x00\x8d\x3c\xe1\x8c\xb8\x48\xa0\xb4\x08\xdd\x78\xee\xbf\xec\xac\x07\x0d\x2d\x51\x72\xa0\xb7\xb9\xde\xc9\xc0\x68\x29\x2d\xb8\x51\x9f\xe6\x47\x6d\xee\xc3\x51\x72\x25\xb7\xd2\xea\x98\x00\x3c\xd8\xae\xa6\x7e\x5d\x3f\x0e\x27\x7e\x7c\x9f\x09\xe7\x88\x43\xaf\x45\x42\x9f\x6f\xae\xae\xde\x5f\x5c\xfe\x0a\xf3\x1b\x78\x3d\x72\x32\x43\xc0\xbe\x93\x9e\x5e\xb0\xcd\x2f\x68\x0b\x25\x72\x8b\x23\x61\x85\x01\xfb\xcf\x12\x79\x2e\x8b\x03\xac\x57\xa3\x89\xd9\xd5\xec\xe2\x76\xf6\x9a\xf4\x10\x22\xf5\xbd\xc4\xf0\xef\xf6\xf7\x4c\x08\x7b\x54\xbb\x4b\xd7\x82\x0b\x69\x61\xa1\x43\xb5\xd8\x6e\x0f\x2f\x97\x5d\x07\x36\xbc\xfb\xce\x4e\x90\x8d\x5f\x40\xa8\x34\x5a\x50\x9a\xc0\xae\x1b\x77\xed\xf4\x4a\x1b\x15\x22\x3b\xf1\x57\x01\x07\x5b\xaf\xc9\x5f\x13\x2c\x94\x5c\x39\x93\xbb\x51\xa7\xc0\x49\xd7\xa2\xe4\x52\x3e\x0d\x5a\x7b\x76\x41\x7b\xe9\xe5\xec\x58\x63\xae\x8d\x65\xd7\xf8\x98\xbb\xbb\xec\xd9\x9e\xc3\xbe\x83\x19\xed\x68\xd7\xc6\x1c\x8d\x4b\xbc\x4a\x17\x43\x74\x43\xac\xd3\x21\xb8\x1f\x8d\xae\xa3\x83\xbe\xf9\xd9\x27\x5b\x18\x5d\xf7\xbd\xb4\x0b\xb1\x6b\x8d\xd4\x70\x92\x52\xd7\x6f\x3e\xc7\x39\x76\xda\xbe\x39\x1c\x91\xec\xec\x1c\x0e\x10\x8b\xd4\x9d\xae\x38\x7d\xbe\x93\xf2\x57\xc3\xa7\x74\x6f\xc1\x89\xdc\x4f\x26\x15\x90\xee\x7d\x0b\x51\xe9\x01\xc2\x3a\xd6\x67\xd3\x5d\x60\xc3\x8f\x1a\xa8\x2a\xe8\xba\xf4\xef\x01\x00\x3b\x83\xab\x3e\xd9\x11\x00\x00")
 
 func templateTransactionTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -218,7 +324,27 @@ func templateTransactionTmpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "template/transaction.tmpl", size: 1050, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	info := bindataFileInfo{name: "template/transaction.tmpl", size: 4569, mode: os.FileMode(436), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templateWhere_inputTmpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x5d\x6f\xdb\xb8\x12\x7d\x96\x7e\xc5\x54\xf0\x83\x6c\xb8\x74\xda\xb7\x1b\x20\x17\x08\x7a\x53\xdc\x00\x8b\x24\x8b\x16\xdb\x87\x20\x68\x19\x69\x64\xb3\x91\x49\x85\xa4\xea\x0d\xbc\xfa\xef\x8b\xa1\xa8\x2f\x5b\x76\xdc\x05\xba\x6f\x92\x66\xe6\x70\x78\xe6\x70\x86\xf6\x76\xbb\x98\x85\x1f\x54\xf1\xa2\xc5\x72\x65\xe1\xfd\xd9\xbb\xff\xbc\x2d\x34\x1a\x94\x16\x3e\xf2\x04\x1f\x95\x7a\x82\x6b\x99\x30\xb8\xcc\x73\x70\x4e\x06\xc8\xae\x7f\x60\xca\xc2\xcf\x2b\x61\xc0\xa8\x52\x27\x08\x89\x4a\x11\x84\x81\x5c\x24\x28\x0d\xa6\x50\xca\x14\x35\xd8\x15\xc2\x65\xc1\x93\x15\xc2\x7b\x76\xd6\x58\x21\x53\xa5\x4c\x43\x21\x9d\xfd\xb7\xeb\x0f\x57\x37\x9f\xae\x20\x13\x39\x82\xff\xa6\x95\xb2\x90\x0a\x8d\x89\x55\xfa\x05\x54\x06\xb6\xb7\x98\xd5\x88\x2c\x9c\x2d\xaa\x2a\x0c\xb7\x5b\x48\x31\x13\x12\x21\xda\xac\x50\xe3\x57\x21\x8b\xd2\x46\x50\x55\x64\xb2\xb8\x2e\x72\x6e\x11\xa2\x15\xf2\x14\x75\x04\x13\xf0\x51\x13\xb1\x2e\x94\xb6\x06\xce\x2f\x20\x15\x89\xa5\xef\xdb\xed\x5b\xd0\x5c\x2e\x11\x26\x92\xbe\x4f\xd8\x8d\x4a\xd1\x90\x29\xe8\xd9\x32\xb2\xf1\xa2\x40\x99\xc2\x44\xb2\x8f\x02\xf3\xd4\xd0\xd3\xf5\xff\x9c\xab\xf3\xdd\x08\xbb\x82\x49\xc6\x3e\xbf\x14\xc8\xee\x9e\x96\x77\xdc\xae\x6a\xab\x33\x8b\x0c\x24\x02\x83\xb8\xd0\x42\x5a\x98\xb0\x0f\x4a\x66\x62\xc9\xee\x78\xf2\xc4\x97\x08\xd1\x22\x22\x44\xff\x3a\xf5\x91\x2e\xb4\xcd\xfc\x02\x0c\xda\x6e\x23\x0c\xac\x2e\xb1\xb7\x06\xe5\xd7\xe6\xd3\xbc\xf4\x9e\x7b\x8f\x61\x0d\x02\x71\x18\x44\xdb\xed\x7e\x3a\x55\xb5\x28\x34\xa6\x22\xe1\x16\xa3\x30\x38\x46\xd4\x41\x80\xed\xb6\xb7\x23\xa8\xaa\x68\x90\x4c\x1f\xb3\x20\xae\xce\x2f\xe0\x09\x5f\x4c\xb7\xbf\x0e\xdc\xd9\x77\x01\xa6\x4e\x0c\x07\xb2\xa2\x28\xa7\x0c\xfa\xee\x29\x97\xec\x86\xaf\x11\xa2\x2f\xa4\x9b\xeb\x56\x36\x8b\x05\x74\xde\x55\x05\x1a\xfd\x99\x30\xc0\xc1\x69\x0c\x6a\x5b\xa6\x34\x89\xd6\xa2\x16\x72\xe9\x62\x3c\x62\x55\xc1\x73\x89\x5a\xa0\x61\xa1\x7d\x29\x70\x88\x67\xac\x2e\x13\x0b\xdb\x30\xb8\x51\x16\x66\x03\x1b\xc0\xb7\xef\x46\xc9\xf3\x48\x2a\x3b\x57\x6b\x41\xfa\xb5\x2f\xd1\xb7\x30\xb8\xd5\x00\xf7\x0f\x43\x6f\xef\xab\xf4\xd0\xf5\x52\xa6\x87\x5c\xb9\x4c\x87\xbe\x3d\xd2\x9d\xaa\xdd\xfe\x5a\x41\x13\xad\x61\x10\x2c\x16\xe0\x6a\x9a\x35\xdb\x8b\x20\x23\x17\x68\x25\x61\x58\x18\xf4\xb1\x54\xd1\x82\xdd\x16\x74\x34\x60\x92\xf5\x84\x39\xa9\xc3\xbb\x52\x64\xec\x93\x63\xc5\xad\x0c\xb1\x0f\xfc\x54\x66\x99\xf8\x93\xd0\x1a\xfd\x53\xba\x13\xcb\x97\x5d\x68\x9c\xf0\x35\xe6\x4d\x6e\xd3\x57\x62\x45\x46\x68\xec\x46\xe4\x3c\x15\x89\x37\x04\x6e\x6f\x6e\xe5\xaa\x82\x47\xa5\xf2\x86\x2e\x32\x58\xbe\x84\xaa\x1a\xb2\xe6\x4f\x54\x6e\xb0\x41\xfc\x83\x6b\x71\x10\xf2\xfe\xc1\xbd\xba\x56\xd0\x2b\xc6\x09\xe8\x63\x68\xb3\x7f\x02\xe6\xcf\xd8\xe0\x65\xf7\xd9\xd7\x0e\x89\xdc\xe5\x73\x7e\x95\x2e\x71\x44\x04\xd8\x89\x00\xd3\x25\xee\x68\xe0\xff\xdc\x50\x26\x38\xa8\x67\x55\xc1\xac\x4f\xeb\x6a\xdc\x69\x27\xf1\x03\x50\x5f\xa8\xaf\x7a\x79\xa3\x63\xa1\x49\xa8\x3b\xc7\xaf\x2c\x44\x10\xc3\xc5\x7a\x54\xf8\xe9\x40\x07\xf8\x85\x98\x98\x48\xf6\x3b\x3d\xbb\x45\xde\xd6\xfd\xe1\xa3\x3b\xf5\xc0\x8b\x22\x17\x68\xdc\xc4\x1a\x9c\xb6\xba\x2b\x80\x92\xad\xa9\x86\x23\x79\x95\x22\x4f\x51\x33\x82\xb9\xb6\x90\x70\x09\x8f\x08\x25\x8d\x4c\x4e\x0d\xa6\xe0\x4b\x21\xb9\x15\x4a\xfa\xde\x02\x1b\xda\x30\xe1\x50\xda\x83\x2e\xe3\xd3\x50\x05\xb9\xb3\x30\x2b\x65\x02\xb1\x18\xb6\x94\xa9\x4f\x36\x7e\x86\x59\x3f\x91\x29\xc4\x83\xf7\x39\xa0\xd6\x4a\x4f\xa9\x2f\x89\x0c\x04\x5c\x5c\x80\x14\x39\xbd\x06\x1a\x6d\xa9\x25\x3c\xcf\xe9\x4b\x18\x54\x61\x50\x38\x77\xa2\x47\xb0\xbb\x78\xea\x42\xe8\xc3\x9b\xbd\x20\x29\x72\xe7\xeb\xc2\x44\x06\xc5\x71\xe0\xe6\x0b\x73\xc5\x8c\x8b\x69\x6d\xaa\x42\xe2\xeb\x0e\x6a\xb3\xe3\xa9\x91\xdd\x7e\x1b\x2e\xf2\x52\xf3\xbc\xe5\xe9\x2f\xc8\xd5\x06\x35\x54\x95\x23\xfd\x92\x00\x7b\xe1\xc2\x78\x54\x4c\xe9\x30\x13\xd1\x35\x77\xc2\x38\x4f\xa5\xc1\x35\xe1\x39\x6c\x56\x22\x59\xc1\x1a\xb9\x34\x04\xc4\xf3\xfc\xe8\x6a\xc0\x35\xc2\x9a\xdb\x64\x85\x29\x83\x4b\x49\x2c\x28\x7d\x74\x3d\x21\x7f\xf0\x5c\xa4\x07\x4b\x79\x17\x4f\x21\x6e\x53\x67\x03\x31\x9c\x50\x40\x57\x8b\x86\xe9\x1f\x5c\x77\x2c\x18\xb8\x7f\x38\x80\x5b\xa3\x31\x9a\x55\xbd\xea\xf6\x05\x70\xa3\x6c\x2d\x82\x11\x15\x0c\x96\x76\x32\xa0\xb5\xf7\x84\x30\x70\xcb\xd6\x96\x5d\x11\x57\x59\x1c\x39\xea\xfb\xd5\x92\x83\x61\xca\xa4\xb2\xd1\xd4\x83\xb6\x5e\x06\x9a\xeb\x59\x47\x96\x99\xc3\xee\xfd\x83\xf6\x14\x17\xd3\x69\x23\xcd\x1c\x65\x2c\xd8\xad\x9e\xc2\x7f\xe1\xcc\xa5\xa5\xdc\x0e\xd7\xfc\x09\xe3\x83\xfc\xcc\xe1\x6c\xde\x85\x52\x2e\x24\xc8\xaf\x73\xd8\x50\x6c\xdd\x53\x09\xd5\x01\xf6\x0e\xce\xc6\x73\x36\x46\xda\x08\x6b\x94\xa3\x9b\xc4\x24\x24\x47\xca\xa3\xe6\x92\xf4\xe8\x14\x66\x5e\x95\xe3\x1c\xb8\x4c\xc1\x28\x48\x95\x6f\x59\xa9\x30\xdf\x4b\x99\xd4\xcd\x23\x18\x29\x4a\xa0\x34\xb8\x57\x5a\x3a\x78\xd4\xc8\x9f\xda\x4c\x94\xee\x48\x56\x7a\x0e\x45\x53\x05\xb3\x11\x36\x59\x39\x46\xbc\x1a\x83\x84\x1b\x84\xb3\xf3\xe6\xe9\x1d\x3d\xbd\x5a\x2d\xa5\xef\xcf\x1e\x08\x34\xc5\x8c\x97\xb9\x3d\x29\x68\xaf\xc4\xb7\x3a\x56\x9a\x31\x36\xf5\xe9\x55\xe1\x68\x79\xe8\xca\xb4\x0d\xc7\xca\xf3\x73\x92\xee\xbb\xbd\x96\x6b\xc3\x58\x75\xca\x2d\xec\xdf\xbb\x5d\xb9\xd6\xd3\xc5\x76\x6c\x46\x2c\xda\x85\x72\x77\xa9\xa6\x4d\x1c\xbb\x61\xb9\x16\x32\xb8\xc5\x38\x26\x4f\x2b\xa8\xcb\xa8\xaa\x62\x57\x43\x2f\xbf\xfe\xfd\x0b\x9f\xbb\x44\xa2\x6b\x19\x35\x8b\x92\xcf\x62\xd6\x1d\x98\x5c\x18\xdb\x1e\x17\xa9\x40\xd2\x6f\xbb\x39\x94\x32\x17\x4f\x08\xbc\xb6\xdb\x15\xb7\xb0\xe1\x06\xa4\xb2\xf4\xf3\x8a\xc1\x6c\x71\x70\x13\xfd\x72\xff\xdc\x5e\x86\x40\x8d\x40\x47\x36\x37\x76\xb9\x6c\x7b\x55\x1f\xa3\xeb\x5a\xbf\x24\x95\x5f\xc0\xc1\x6c\x88\xb4\xb7\xac\xbf\x9a\x0e\x5e\x76\x9f\x8f\xdc\x59\x7d\xb2\x87\x6e\xa4\xfd\xbc\xdd\x79\xda\xeb\x1d\x07\x22\xdb\xae\xfd\x66\x76\x18\xbd\xa6\xa3\x80\x11\xd8\x7a\xea\xb4\x8d\xf4\xd4\x36\xd1\x55\xfd\xc0\x9a\x74\x3d\xec\x89\xc0\x5d\x1b\x0f\x4d\xaf\xe1\xc5\xb9\x37\xc2\x8e\x61\xbb\x7d\x8f\x75\xcf\x23\x41\x0d\x11\xfb\x63\x6f\x74\xee\x8d\x0d\xbe\xa0\x55\xde\xb0\xbf\xfa\x2d\xb6\xa4\xd1\x9b\xa7\xcb\xc7\x9c\xc4\xef\xa9\x65\x27\x7a\xdd\x1a\xcd\x09\xd9\x51\x63\x6f\xee\x75\xf0\x6e\xfe\x75\xe3\xaf\xbf\x3b\x37\x56\xbb\x79\xe8\x4d\x5d\xe8\xfd\xd9\x83\x77\xea\x0d\x40\xef\xb5\x97\xf3\xe5\x60\x53\x94\xa1\x8f\xad\x42\xfa\x57\xab\x49\x72\xbb\x05\x94\x29\x54\x55\xf8\xf7\x00\x20\x89\x9e\x91\xfb\x13\x00\x00")
+
+func templateWhere_inputTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templateWhere_inputTmpl,
+		"template/where_input.tmpl",
+	)
+}
+
+func templateWhere_inputTmpl() (*asset, error) {
+	bytes, err := templateWhere_inputTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "template/where_input.tmpl", size: 5115, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -278,10 +404,16 @@ var _bindata = map[string]func() (*asset, error){
 	"template/collection.tmpl":      templateCollectionTmpl,
 	"template/edge.tmpl":            templateEdgeTmpl,
 	"template/enum.tmpl":            templateEnumTmpl,
+	"template/error.tmpl":           templateErrorTmpl,
+	"template/event.tmpl":           templateEventTmpl,
+	"template/federation.tmpl":      templateFederationTmpl,
+	"template/globalid.tmpl":        templateGlobalidTmpl,
+	"template/mutation_input.tmpl":  templateMutation_inputTmpl,
 	"template/node.tmpl":            templateNodeTmpl,
 	"template/pagination.tmpl":      templatePaginationTmpl,
 	"template/pagination_test.tmpl": templatePagination_testTmpl,
 	"template/transaction.tmpl":     templateTransactionTmpl,
+	"template/where_input.tmpl":     templateWhere_inputTmpl,
 }
 
 // AssetDir returns the file names below a certain
@@ -329,10 +461,16 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"collection.tmpl":      &bintree{templateCollectionTmpl, map[string]*bintree{}},
 		"edge.tmpl":            &bintree{templateEdgeTmpl, map[string]*bintree{}},
 		"enum.tmpl":            &bintree{templateEnumTmpl, map[string]*bintree{}},
+		"error.tmpl":           &bintree{templateErrorTmpl, map[string]*bintree{}},
+		"event.tmpl":           &bintree{templateEventTmpl, map[string]*bintree{}},
+		"federation.tmpl":      &bintree{templateFederationTmpl, map[string]*bintree{}},
+		"globalid.tmpl":        &bintree{templateGlobalidTmpl, map[string]*bintree{}},
+		"mutation_input.tmpl":  &bintree{templateMutation_inputTmpl, map[string]*bintree{}},
 		"node.tmpl":            &bintree{templateNodeTmpl, map[string]*bintree{}},
 		"pagination.tmpl":      &bintree{templatePaginationTmpl, map[string]*bintree{}},
 		"pagination_test.tmpl": &bintree{templatePagination_testTmpl, map[string]*bintree{}},
 		"transaction.tmpl":     &bintree{templateTransactionTmpl, map[string]*bintree{}},
+		"where_input.tmpl":     &bintree{templateWhere_inputTmpl, map[string]*bintree{}},
 	}},
 }}
 
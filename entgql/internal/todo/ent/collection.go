@@ -19,10 +19,15 @@ package ent
 import (
 	"context"
 
+	"entgo.io/contrib/entgql/internal/todo/ent/todo"
+
 	"github.com/99designs/gqlgen/graphql"
 )
 
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+// The selected columns are limited to the fields that were requested, along with the
+// ID and the foreign-keys of the node, unless a field that is not mapped to a column
+// (e.g. a custom resolver) was requested.
 func (t *TodoQuery) CollectFields(ctx context.Context, satisfies ...string) *TodoQuery {
 	if fc := graphql.GetFieldContext(ctx); fc != nil {
 		t = t.collectField(graphql.GetOperationContext(ctx), fc.Field, satisfies...)
@@ -31,17 +36,71 @@ func (t *TodoQuery) CollectFields(ctx context.Context, satisfies ...string) *Tod
 }
 
 func (t *TodoQuery) collectField(ctx *graphql.OperationContext, field graphql.CollectedField, satisfies ...string) *TodoQuery {
+	var (
+		all     bool
+		columns []string
+	)
 	for _, field := range graphql.CollectFields(ctx, field.Selections, satisfies) {
 		switch field.Name {
 		case "children":
+			w, err := newTodoWindow(ctx, field, t.cursors(), todo.ChildrenColumn, withTodoPageSize(0, 50))
+			if err != nil {
+				// The edge resolver reports the pagination errors.
+				break
+			}
+			windows := make(map[string]*edgeWindow, len(t.windows)+1)
+			for k, v := range t.windows {
+				windows[k] = v
+			}
+			windows["Todo.children"] = w
+			t.windows = windows
 			t = t.WithChildren(func(query *TodoQuery) {
-				query.collectField(ctx, field)
+				query.window, query.cursorValues = w, w.values
+				if field := collectedField(ctx, field, edgesField, nodeField); field != nil {
+					query.collectField(ctx, *field).selectColumns(w.columns...)
+				}
 			})
 		case "parent":
 			t = t.WithParent(func(query *TodoQuery) {
 				query.collectField(ctx, field)
 			})
+		case "createdAt":
+			columns = append(columns, todo.FieldCreatedAt)
+		case "status":
+			columns = append(columns, todo.FieldStatus)
+		case "priority":
+			columns = append(columns, todo.FieldPriority)
+		case "text":
+			columns = append(columns, todo.FieldText)
+		case "id", "__typename":
+		default:
+			// The field may be resolved from any of the columns.
+			all = true
+		}
+	}
+	if all {
+		t.fields = nil
+	} else {
+		t.fields = []string{todo.FieldID}
+		t.selectColumns(columns...)
+	}
+	return t
+}
+
+// selectColumns adds the given columns to the selected
+// columns of the query, unless all columns are selected.
+func (t *TodoQuery) selectColumns(columns ...string) *TodoQuery {
+	if len(t.fields) == 0 {
+		return t
+	}
+Columns:
+	for _, column := range columns {
+		for _, selected := range t.fields {
+			if selected == column {
+				continue Columns
+			}
 		}
+		t.fields = append(t.fields, column)
 	}
 	return t
 }
@@ -17,6 +17,9 @@
 package ent
 
 import (
+	"time"
+
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 )
@@ -34,6 +37,17 @@ type config struct {
 	log func(...interface{})
 	// hooks to execute on mutations.
 	hooks *hooks
+
+	// cursorCodec used for encoding and decoding the cursors of connections.
+	cursorCodec entgql.CursorCodec
+
+	// nodeTypesReloadInterval overrides the minimal interval between
+	// reloads of the universal-id type table on ids without a type.
+	nodeTypesReloadInterval *time.Duration
+
+	// windows holds the windows of the connection edges
+	// that were eager-loaded by the query of the nodes.
+	windows map[string]*edgeWindow
 }
 
 // hooks per client, for fast access.
@@ -71,3 +85,26 @@ func Driver(driver dialect.Driver) Option {
 		c.driver = driver
 	}
 }
+
+// CursorCodec configures the codec used for encoding and decoding the cursors of the
+// client connections. For example, a signed codec rejects cursors forged by clients:
+//
+//	codec, err := entgql.NewSignedCursorCodec(key)
+//	if err != nil {
+//		return err
+//	}
+//	client := ent.NewClient(ent.Driver(drv), ent.CursorCodec(codec))
+func CursorCodec(codec entgql.CursorCodec) Option {
+	return func(c *config) {
+		c.cursorCodec = codec
+	}
+}
+
+// NodeTypesReloadInterval configures the minimal interval between reloads of the
+// universal-id type table, when resolving ids that do not match any of the loaded
+// types. Defaults to one second. Use ReloadNodeTypes for reloading it explicitly.
+func NodeTypesReloadInterval(d time.Duration) Option {
+	return func(c *config) {
+		c.nodeTypesReloadInterval = &d
+	}
+}
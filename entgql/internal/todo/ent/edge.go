@@ -16,20 +16,85 @@
 
 package ent
 
-import "context"
+import (
+	"context"
+
+	"entgo.io/contrib/entgql/internal/todo/ent/todo"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/dialect/sql"
+)
 
 func (t *Todo) Parent(ctx context.Context) (*Todo, error) {
 	result, err := t.Edges.ParentOrErr()
 	if IsNotLoaded(err) {
-		result, err = t.QueryParent().Only(ctx)
+		result, err = t.loadParent(ctx)
 	}
 	return result, MaskNotFound(err)
 }
 
-func (t *Todo) Children(ctx context.Context) ([]*Todo, error) {
-	result, err := t.Edges.ChildrenOrErr()
-	if IsNotLoaded(err) {
-		result, err = t.QueryChildren().All(ctx)
+// loadParent loads the "parent" edge in a batch with the other nodes of the
+// response, or queries it if it cannot be batched (see entgql.LoadEdge).
+func (t *Todo) loadParent(ctx context.Context) (*Todo, error) {
+	node, err := t.batchLoad(ctx, todo.EdgeParent, func(q *TodoQuery) *TodoQuery {
+		return q.WithParent()
+	})
+	if err != nil {
+		return nil, err
+	}
+	if node == nil {
+		return t.QueryParent().Only(ctx)
+	}
+	return node.Edges.ParentOrErr()
+}
+
+func (t *Todo) Children(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy []*TodoOrder,
+) (*TodoConnection, error) {
+	opts := []TodoPaginateOption{
+		WithTodoOrder(orderBy),
+		withTodoPageSize(0, 50),
 	}
-	return result, err
+	if w := t.windows["Todo.children"]; w != nil {
+		if nodes, err := t.Edges.ChildrenOrErr(); err == nil {
+			conn, ok, err := paginateTodoWindow(t.cursors(), w, nodes, after, first, before, last, opts...)
+			if err != nil || ok {
+				return conn, err
+			}
+		}
+	}
+	return t.QueryChildren().Paginate(ctx, after, first, before, last, opts...)
+}
+
+// batchLoad reloads the node with the given edge eager-loaded, in a batch with the
+// other nodes of the response that load the same edge. It returns nil if the
+// edge cannot be batched (see entgql.LoadEdge), or the node was not found.
+func (t *Todo) batchLoad(ctx context.Context, edge string, with func(*TodoQuery) *TodoQuery) (*Todo, error) {
+	v, ok, err := entgql.LoadEdge(ctx, "Todo."+edge, t.ID, func(ctx context.Context, keys []interface{}) (map[interface{}]interface{}, error) {
+		nodes, err := with((&TodoClient{config: t.config}).Query()).
+			Where(func(s *sql.Selector) {
+				s.Where(sql.In(s.C(todo.FieldID), keys...))
+			}).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		values := make(map[interface{}]interface{}, len(nodes))
+		for _, n := range nodes {
+			values[n.ID] = n
+		}
+		return values, nil
+	})
+	if err != nil || !ok {
+		return nil, err
+	}
+	node, _ := v.(*Todo)
+	return node, nil
+}
+
+// EdgeCardinality holds the cardinality of the edge fields in the graphql
+// schema by their "Type.field" name. It is used by the entgql.CostLimit.
+var EdgeCardinality = map[string]entgql.Cardinality{
+	"Todo.parent":   entgql.CardinalityUnique,
+	"Todo.children": entgql.CardinalityMany,
 }
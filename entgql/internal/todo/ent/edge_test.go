@@ -0,0 +1,124 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/todo/ent"
+	"entgo.io/contrib/entgql/internal/todo/ent/todo"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestEdgeLoader(t *testing.T) {
+	ctx := context.Background()
+	drv, err := entsql.Open(dialect.SQLite, "file:edges?mode=memory&cache=shared&_fk=1")
+	require.NoError(t, err)
+	var queries int32
+	ec := ent.NewClient(ent.Driver(dialect.DebugWithContext(drv, func(context.Context, ...interface{}) {
+		atomic.AddInt32(&queries, 1)
+	})))
+	defer ec.Close()
+	require.NoError(t, ec.Schema.Create(ctx))
+
+	root := ec.Todo.Create().SetText("root").SetStatus(todo.StatusInProgress).SaveX(ctx)
+	for i := 0; i < 4; i++ {
+		ec.Todo.Create().SetText("child").SetStatus(todo.StatusInProgress).SetParent(root).SaveX(ctx)
+	}
+	todos := ec.Todo.Query().Order(ent.Asc(todo.FieldID)).AllX(ctx)
+
+	// parents resolves the parents of all todos concurrently, and
+	// returns them along with the number of executed queries.
+	parents := func(ctx context.Context) ([]*ent.Todo, int) {
+		atomic.StoreInt32(&queries, 0)
+		var (
+			parents = make([]*ent.Todo, len(todos))
+			errs    = make([]error, len(todos))
+			wg      sync.WaitGroup
+		)
+		for i, n := range todos {
+			wg.Add(1)
+			go func(i int, n *ent.Todo) {
+				defer wg.Done()
+				parents[i], errs[i] = n.Parent(ctx)
+			}(i, n)
+		}
+		wg.Wait()
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+		return parents, int(atomic.LoadInt32(&queries))
+	}
+
+	t.Run("Query", func(t *testing.T) {
+		nodes, n := parents(ctx)
+		require.Equal(t, len(todos), n)
+		require.Nil(t, nodes[0])
+		for _, p := range nodes[1:] {
+			require.Equal(t, root.ID, p.ID)
+		}
+	})
+	t.Run("Batch", func(t *testing.T) {
+		entgql.EdgeLoader{}.InterceptResponse(ctx, func(ctx context.Context) *graphql.Response {
+			nodes, n := parents(ctx)
+			// One query for the nodes, and one for their parents.
+			require.Equal(t, 2, n)
+			require.Nil(t, nodes[0])
+			for _, p := range nodes[1:] {
+				require.Equal(t, root.ID, p.ID)
+			}
+			return &graphql.Response{}
+		})
+	})
+	t.Run("Node", func(t *testing.T) {
+		entgql.EdgeLoader{}.InterceptResponse(ctx, func(ctx context.Context) *graphql.Response {
+			atomic.StoreInt32(&queries, 0)
+			var (
+				nodes = make([]*ent.Node, len(todos))
+				errs  = make([]error, len(todos))
+				wg    sync.WaitGroup
+			)
+			for i, n := range todos {
+				wg.Add(1)
+				go func(i int, n *ent.Todo) {
+					defer wg.Done()
+					nodes[i], errs[i] = n.Node(ctx)
+				}(i, n)
+			}
+			wg.Wait()
+			for _, err := range errs {
+				require.NoError(t, err)
+			}
+			// One query for the nodes, and one for each of their edges.
+			require.Equal(t, 3, int(atomic.LoadInt32(&queries)))
+			require.Len(t, nodes[0].Edges, 2)
+			require.Empty(t, nodes[0].Edges[0].IDs)
+			require.Len(t, nodes[0].Edges[1].IDs, len(todos)-1)
+			for _, n := range nodes[1:] {
+				require.Equal(t, []int{root.ID}, n.Edges[0].IDs)
+			}
+			return &graphql.Response{}
+		})
+	})
+}
@@ -34,7 +34,10 @@ func main() {
 			//
 			// Code generated by entc, DO NOT EDIT.
 		`,
-		Templates: entgql.AllTemplates,
+		Templates: append(entgql.AllTemplates, entgql.EventTemplate, entgql.FederationTemplate),
+		Hooks: []gen.Hook{
+			entgql.SchemaGenerator("../ent.graphql"),
+		},
 	})
 	if err != nil {
 		log.Fatalf("running ent codegen: %v", err)
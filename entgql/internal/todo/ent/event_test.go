@@ -0,0 +1,135 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/todo/ent"
+	"entgo.io/contrib/entgql/internal/todo/ent/enttest"
+	"entgo.io/contrib/entgql/internal/todo/ent/todo"
+	"entgo.io/ent/dialect"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ec := enttest.Open(t, dialect.SQLite, "file:events?mode=memory&cache=shared&_fk=1")
+	defer ec.Close()
+	b := entgql.NewMemoryBroker(0)
+	ec.UseBroker(b)
+	events := b.Subscribe(ctx)
+
+	// next returns the next published event, or fails if
+	// no event is published within a reasonable time.
+	next := func(t *testing.T) entgql.Event {
+		select {
+		case e := <-events:
+			return e
+		case <-time.After(time.Second):
+			require.FailNow(t, "event was not published")
+			return entgql.Event{}
+		}
+	}
+	none := func(t *testing.T) {
+		select {
+		case e := <-events:
+			require.FailNow(t, "unexpected event", e)
+		default:
+		}
+	}
+
+	t.Run("Client", func(t *testing.T) {
+		node := ec.Todo.Create().SetText("t1").SetStatus(todo.StatusInProgress).SaveX(ctx)
+		e := next(t)
+		require.Equal(t, entgql.Event{Op: entgql.EventCreated, Type: "Todo", ID: node.ID, Node: node}, e)
+
+		node = node.Update().SetText("t2").SaveX(ctx)
+		e = next(t)
+		require.Equal(t, entgql.EventUpdated, e.Op)
+		require.Equal(t, node, e.Node)
+
+		ec.Todo.Update().Where(todo.ID(node.ID)).SetText("t3").ExecX(ctx)
+		e = next(t)
+		require.Equal(t, entgql.Event{Op: entgql.EventUpdated, Type: "Todo", ID: node.ID}, e)
+
+		ec.Todo.DeleteOne(node).ExecX(ctx)
+		e = next(t)
+		require.Equal(t, entgql.Event{Op: entgql.EventDeleted, Type: "Todo", ID: node.ID}, e)
+		none(t)
+	})
+
+	t.Run("Commit", func(t *testing.T) {
+		txCtx, tx, err := ec.OpenTx(ctx)
+		require.NoError(t, err)
+		client := ent.FromContext(txCtx)
+		node := client.Todo.Create().SetText("t1").SetStatus(todo.StatusInProgress).SaveX(txCtx)
+		client.Todo.Delete().Where(todo.ID(node.ID)).ExecX(txCtx)
+		none(t)
+		require.NoError(t, tx.Commit())
+		require.Equal(t, entgql.EventCreated, next(t).Op)
+		require.Equal(t, entgql.Event{Op: entgql.EventDeleted, Type: "Todo", ID: node.ID}, next(t))
+		none(t)
+	})
+
+	t.Run("Rollback", func(t *testing.T) {
+		txCtx, tx, err := ec.OpenTx(ctx)
+		require.NoError(t, err)
+		ent.FromContext(txCtx).Todo.Create().SetText("t1").SetStatus(todo.StatusInProgress).SaveX(txCtx)
+		require.NoError(t, tx.Rollback())
+		none(t)
+	})
+
+	t.Run("Tx", func(t *testing.T) {
+		tx, err := ec.Tx(ctx)
+		require.NoError(t, err)
+		node := tx.Todo.Create().SetText("t1").SetStatus(todo.StatusInProgress).SaveX(ctx)
+		none(t)
+		require.NoError(t, tx.Commit())
+		require.Equal(t, entgql.Event{Op: entgql.EventCreated, Type: "Todo", ID: node.ID, Node: node}, next(t))
+		none(t)
+	})
+
+	t.Run("Savepoint", func(t *testing.T) {
+		tx, err := ec.Tx(ctx)
+		require.NoError(t, err)
+		kept := tx.Todo.Create().SetText("t1").SetStatus(todo.StatusInProgress).SaveX(ctx)
+		require.NoError(t, tx.Savepoint(ctx, "s1"))
+		tx.Todo.Create().SetText("t2").SetStatus(todo.StatusInProgress).SaveX(ctx)
+		require.NoError(t, tx.RollbackToSavepoint(ctx, "s1"))
+		require.NoError(t, tx.ReleaseSavepoint(ctx, "s1"))
+		require.NoError(t, tx.Commit())
+		require.Equal(t, kept.ID, next(t).ID)
+		none(t)
+	})
+
+	t.Run("Subscribe", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		changes := ec.Todo.Subscribe(ctx, b, todo.TextHasPrefix("match"))
+		for _, text := range []string{"skip", "match"} {
+			ec.Todo.Create().SetText(text).SetStatus(todo.StatusInProgress).SaveX(ctx)
+		}
+		e := <-changes
+		require.Equal(t, entgql.EventCreated, e.Op)
+		require.Equal(t, "match", e.Node.Text)
+		cancel()
+		_, ok := <-changes
+		require.False(t, ok)
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todo/ent/todo"
@@ -64,67 +65,107 @@ func (t *Todo) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     t.ID,
 		Type:   "Todo",
-		Fields: make([]*Field, 4),
+		Fields: make([]*Field, 0, 4),
 		Edges:  make([]*Edge, 2),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(t.CreatedAt); err != nil {
 		return nil, err
 	}
-	node.Fields[0] = &Field{
+	node.Fields = append(node.Fields, &Field{
 		Type:  "time.Time",
 		Name:  "created_at",
 		Value: string(buf),
-	}
+	})
 	if buf, err = json.Marshal(t.Status); err != nil {
 		return nil, err
 	}
-	node.Fields[1] = &Field{
+	node.Fields = append(node.Fields, &Field{
 		Type:  "todo.Status",
 		Name:  "status",
 		Value: string(buf),
-	}
+	})
 	if buf, err = json.Marshal(t.Priority); err != nil {
 		return nil, err
 	}
-	node.Fields[2] = &Field{
+	node.Fields = append(node.Fields, &Field{
 		Type:  "int",
 		Name:  "priority",
 		Value: string(buf),
-	}
+	})
 	if buf, err = json.Marshal(t.Text); err != nil {
 		return nil, err
 	}
-	node.Fields[3] = &Field{
+	node.Fields = append(node.Fields, &Field{
 		Type:  "string",
 		Name:  "text",
 		Value: string(buf),
+	})
+	loaded, err := t.nodeEdges(ctx)
+	if err != nil {
+		return nil, err
 	}
 	node.Edges[0] = &Edge{
 		Type: "Todo",
 		Name: "parent",
 	}
-	node.Edges[0].IDs, err = t.QueryParent().
-		Select(todo.FieldID).
-		Ints(ctx)
-	if err != nil {
-		return nil, err
+	if n := loaded.Edges.Parent; n != nil {
+		node.Edges[0].IDs = []int{n.ID}
 	}
 	node.Edges[1] = &Edge{
 		Type: "Todo",
 		Name: "children",
 	}
-	node.Edges[1].IDs, err = t.QueryChildren().
-		Select(todo.FieldID).
-		Ints(ctx)
+	node.Edges[1].IDs = make([]int, len(loaded.Edges.Children))
+	for j, n := range loaded.Edges.Children {
+		node.Edges[1].IDs[j] = n.ID
+	}
+	return node, nil
+}
+
+// nodeEdges returns the Todo with the ids of its edges loaded for the Node API, in a
+// batch with the other nodes of the response if an entgql.EdgeLoader was installed.
+func (t *Todo) nodeEdges(ctx context.Context) (*Todo, error) {
+	load := func(ctx context.Context, keys []interface{}) (map[interface{}]interface{}, error) {
+		query := (&TodoClient{config: t.config}).Query().
+			Where(func(s *sql.Selector) {
+				s.Where(sql.In(s.C(todo.FieldID), keys...))
+			})
+		query.fields = []string{todo.FieldID}
+		query.WithParent(func(q *TodoQuery) {
+			q.fields = []string{todo.FieldID}
+		})
+		query.WithChildren(func(q *TodoQuery) {
+			q.fields = []string{todo.FieldID}
+		})
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		values := make(map[interface{}]interface{}, len(nodes))
+		for _, n := range nodes {
+			values[n.ID] = n
+		}
+		return values, nil
+	}
+	v, ok, err := entgql.LoadEdge(ctx, "Todo.node", t.ID, load)
+	if err == nil && !ok {
+		var values map[interface{}]interface{}
+		values, err = load(ctx, []interface{}{t.ID})
+		v = values[t.ID]
+	}
 	if err != nil {
 		return nil, err
 	}
+	node, _ := v.(*Todo)
+	if node == nil {
+		return nil, &NotFoundError{todo.Label}
+	}
 	return node, nil
 }
 
-func (c *Client) Node(ctx context.Context, id int) (*Node, error) {
-	n, err := c.Noder(ctx, id)
+func (c *Client) Node(ctx context.Context, id int, opts ...NodeOption) (*Node, error) {
+	n, err := c.Noder(ctx, id, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +204,7 @@ func (c *Client) newNodeOpts(opts []NodeOption) *nodeOptions {
 	}
 	if nopts.nodeType == nil {
 		nopts.nodeType = func(ctx context.Context, id int) (string, error) {
-			return c.tables.nodeType(ctx, c.driver, id)
+			return c.tables.nodeType(ctx, c.driver, c.tablesReloadInterval(), id)
 		}
 	}
 	return nopts
@@ -291,44 +332,88 @@ func (c *Client) noders(ctx context.Context, table string, ids []int) ([]Noder,
 	return noders, nil
 }
 
+// ReloadNodeTypes reloads the universal-id type table used for resolving the node
+// types from their ids. For example, after a migration added new types to the graph.
+// Note that the table is also reloaded when an id does not match any of the types.
+func (c *Client) ReloadNodeTypes(ctx context.Context) error {
+	_, err := c.tables.Reload(ctx, c.driver)
+	return err
+}
+
+// defaultTablesReloadInterval is the default minimal interval
+// between reloads of the type table on ids without a type.
+const defaultTablesReloadInterval = time.Second
+
+// tablesReloadInterval returns the minimal interval between
+// reloads of the type table on ids without a type.
+func (c config) tablesReloadInterval() time.Duration {
+	if c.nodeTypesReloadInterval == nil {
+		return defaultTablesReloadInterval
+	}
+	return *c.nodeTypesReloadInterval
+}
+
 type tables struct {
-	once  sync.Once
-	sem   *semaphore.Weighted
-	value atomic.Value
+	once   sync.Once
+	sem    *semaphore.Weighted
+	value  atomic.Value
+	loaded time.Time // guarded by sem.
 }
 
-func (t *tables) nodeType(ctx context.Context, drv dialect.Driver, id int) (string, error) {
+func (t *tables) nodeType(ctx context.Context, drv dialect.Driver, interval time.Duration, id int) (string, error) {
 	tables, err := t.Load(ctx, drv)
 	if err != nil {
 		return "", err
 	}
 	idx := int(id / (1<<32 - 1))
+	if idx >= len(tables) {
+		// The type of the id may have been added after the table was loaded.
+		tables, err = t.reload(ctx, drv, func(tables []string) bool {
+			return idx >= len(tables) && time.Since(t.loaded) >= interval
+		})
+		if err != nil {
+			return "", err
+		}
+	}
 	if idx < 0 || idx >= len(tables) {
 		return "", fmt.Errorf("cannot resolve table from id %v: %w", id, errNodeInvalidID)
 	}
 	return tables[idx], nil
 }
 
+// Load returns the type table. It is loaded on the first call, and cached afterwards.
 func (t *tables) Load(ctx context.Context, drv dialect.Driver) ([]string, error) {
-	if tables := t.value.Load(); tables != nil {
-		return tables.([]string), nil
+	if tables, ok := t.value.Load().([]string); ok {
+		return tables, nil
 	}
+	return t.reload(ctx, drv, func([]string) bool { return false })
+}
+
+// Reload loads the type table and replaces the cached one.
+func (t *tables) Reload(ctx context.Context, drv dialect.Driver) ([]string, error) {
+	return t.reload(ctx, drv, func([]string) bool { return true })
+}
+
+// reload loads the type table if it was not loaded yet, or if the cached one is stale.
+// Concurrent calls are serialized by the semaphore, and share the loaded table.
+func (t *tables) reload(ctx context.Context, drv dialect.Driver, stale func([]string) bool) ([]string, error) {
 	t.once.Do(func() { t.sem = semaphore.NewWeighted(1) })
 	if err := t.sem.Acquire(ctx, 1); err != nil {
 		return nil, err
 	}
 	defer t.sem.Release(1)
-	if tables := t.value.Load(); tables != nil {
-		return tables.([]string), nil
+	if tables, ok := t.value.Load().([]string); ok && !stale(tables) {
+		return tables, nil
 	}
 	tables, err := t.load(ctx, drv)
 	if err == nil {
 		t.value.Store(tables)
+		t.loaded = time.Now()
 	}
 	return tables, err
 }
 
-func (tables) load(ctx context.Context, drv dialect.Driver) ([]string, error) {
+func (*tables) load(ctx context.Context, drv dialect.Driver) ([]string, error) {
 	rows := &sql.Rows{}
 	query, args := sql.Dialect(drv.Dialect()).
 		Select("type").
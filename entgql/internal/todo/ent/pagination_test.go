@@ -18,55 +18,66 @@ package ent_test
 
 import (
 	"bytes"
+	"context"
 	"strconv"
 	"testing"
 
 	"entgo.io/contrib/entgql/internal/todo/ent"
+	"entgo.io/contrib/entgql/internal/todo/ent/enttest"
+	"entgo.io/contrib/entgql/internal/todo/ent/todo"
+	"entgo.io/ent/dialect"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
 )
 
 func TestCursorEncoding(t *testing.T) {
-	t.Run("EncodeDecode", func(t *testing.T) {
-		const (
-			id    = 42
-			value = "foobar"
-			quote = uint8('"')
-		)
+	ctx := context.Background()
+	client := enttest.Open(t, dialect.SQLite, "file:cursors?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	for i := 0; i < 3; i++ {
+		client.Todo.Create().SetText(strconv.Itoa(i)).SetStatus(todo.StatusInProgress).SaveX(ctx)
+	}
+	first := 1
+	// marshal returns the GraphQL representation of the cursor, without the quotes.
+	marshal := func(c ent.Cursor) string {
 		var buf bytes.Buffer
-		c := ent.Cursor{ID: id, Value: value}
 		c.MarshalGQL(&buf)
-		s := buf.String()
-		assert.Equal(t, quote, s[0])
-		n := len(s) - 1
-		assert.Equal(t, quote, s[n])
-		c = ent.Cursor{}
-		err := c.UnmarshalGQL(s[1:n])
+		s, err := strconv.Unquote(buf.String())
+		require.NoError(t, err)
+		return s
+	}
+	t.Run("EncodeDecode", func(t *testing.T) {
+		conn, err := client.Todo.Query().Paginate(ctx, nil, &first, nil, nil)
+		require.NoError(t, err)
+		var c ent.Cursor
+		err = c.UnmarshalGQL(marshal(*conn.PageInfo.EndCursor))
+		assert.NoError(t, err)
+		id := conn.Edges[0].Node.ID
+		conn, err = client.Todo.Query().Paginate(ctx, &c, &first, nil, nil)
 		assert.NoError(t, err)
-		assert.Equal(t, id, c.ID)
-		assert.Equal(t, value, c.Value)
+		assert.Equal(t, id, c.ID, "cursors are decoded by the pagination")
+		assert.Equal(t, "1", conn.Edges[0].Node.Text)
 	})
 	t.Run("EncodeNoValue", func(t *testing.T) {
-		const id = 55
-		var buf bytes.Buffer
-		c := ent.Cursor{ID: id}
-		c.MarshalGQL(&buf)
-		s, err := strconv.Unquote(buf.String())
+		var c ent.Cursor
+		err := c.UnmarshalGQL(marshal(ent.Cursor{ID: 2, Order: "id ASC"}))
 		assert.NoError(t, err)
-		c = ent.Cursor{}
-		err = c.UnmarshalGQL(s)
+		conn, err := client.Todo.Query().Paginate(ctx, &c, &first, nil, nil)
 		assert.NoError(t, err)
-		assert.Equal(t, id, c.ID)
-		assert.Nil(t, c.Value)
+		assert.Equal(t, 2, c.ID)
+		assert.Nil(t, c.Values)
+		assert.Equal(t, "2", conn.Edges[0].Node.Text)
 	})
 	t.Run("DecodeBadInput", func(t *testing.T) {
-		inputs := []interface{}{
-			0xbadbeef,
-			"cursor@bad123",
-			"Y3Vyc29yQGJhZDEyMw==",
-		}
-		for _, input := range inputs {
+		var c ent.Cursor
+		assert.Error(t, c.UnmarshalGQL(0xbadbeef))
+		for _, input := range []string{"cursor@bad123", "Y3Vyc29yQGJhZDEyMw=="} {
 			var c ent.Cursor
 			err := c.UnmarshalGQL(input)
+			assert.NoError(t, err)
+			_, err = client.Todo.Query().Paginate(ctx, &c, &first, nil, nil)
 			assert.Error(t, err)
 		}
 	})
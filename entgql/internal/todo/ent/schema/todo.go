@@ -19,6 +19,7 @@ import (
 
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -38,12 +39,13 @@ func (Todo) Fields() []ent.Field {
 				entgql.OrderField("CREATED_AT"),
 			),
 		field.Enum("status").
-			NamedValues(
-				"InProgress", "IN_PROGRESS",
-				"Completed", "COMPLETED",
-			).
+			Values("in_progress", "completed").
 			Annotations(
 				entgql.OrderField("STATUS"),
+				entgql.EnumValues(map[string]string{
+					"in_progress": "IN_PROGRESS",
+					"completed":   "COMPLETED",
+				}),
 			),
 		field.Int("priority").
 			Default(0).
@@ -62,9 +64,25 @@ func (Todo) Fields() []ent.Field {
 func (Todo) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("children", Todo.Type).
-			Annotations(entgql.Bind()).
+			Annotations(
+				entgql.Bind(),
+				entgql.OrderField("CHILDREN_COUNT"),
+				entgql.RelayConnection(),
+				entgql.MaxPageSize(50),
+			).
 			From("parent").
-			Annotations(entgql.Bind()).
+			Annotations(
+				entgql.Bind(),
+				entgql.OrderEdgeField("PARENT_PRIORITY", "priority"),
+			).
 			Unique(),
 	}
 }
+
+// Annotations returns todo annotations.
+func (Todo) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.MaxPageSize(100),
+		entgql.Events(),
+	}
+}
@@ -96,8 +96,8 @@ type Status string
 
 // Status values.
 const (
-	StatusInProgress Status = "IN_PROGRESS"
-	StatusCompleted  Status = "COMPLETED"
+	StatusInProgress Status = "in_progress"
+	StatusCompleted  Status = "completed"
 )
 
 func (s Status) String() string {
@@ -115,18 +115,31 @@ func StatusValidator(s Status) error {
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
+// It writes the GraphQL name of the enum value.
 func (s Status) MarshalGQL(w io.Writer) {
-	io.WriteString(w, strconv.Quote(s.String()))
+	name := s.String()
+	switch s {
+	case StatusInProgress:
+		name = "IN_PROGRESS"
+	case StatusCompleted:
+		name = "COMPLETED"
+	}
+	io.WriteString(w, strconv.Quote(name))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
+// It reads the enum value from its GraphQL name.
 func (s *Status) UnmarshalGQL(val interface{}) error {
 	str, ok := val.(string)
 	if !ok {
 		return fmt.Errorf("enum %T must be a string", val)
 	}
-	*s = Status(str)
-	if err := StatusValidator(*s); err != nil {
+	switch str {
+	case "IN_PROGRESS":
+		*s = StatusInProgress
+	case "COMPLETED":
+		*s = StatusCompleted
+	default:
 		return fmt.Errorf("%s is not a valid Status", str)
 	}
 	return nil
@@ -15,16 +15,40 @@
 package todo
 
 import (
+	"context"
+
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todo/ent"
 	"github.com/99designs/gqlgen/graphql"
 )
 
 // Resolver is the resolver root.
-type Resolver struct{ client *ent.Client }
+type Resolver struct {
+	client *ent.Client
+	broker entgql.Broker
+}
 
-// NewSchema creates a graphql executable schema.
+// NewSchema creates a graphql executable schema. The changes
+// of the client are published to the schema subscriptions.
 func NewSchema(client *ent.Client) graphql.ExecutableSchema {
+	broker := entgql.NewMemoryBroker(0)
+	client.UseBroker(broker)
 	return NewExecutableSchema(Config{
-		Resolvers: &Resolver{client},
+		Resolvers: &Resolver{client, broker},
 	})
 }
+
+// entClient returns the client of the context, which is transactional in snapshot
+// queries (see entgql.Transactioner), or the client of the resolver otherwise.
+func (r *Resolver) entClient(ctx context.Context) *ent.Client {
+	if client := ent.FromContext(ctx); client != nil {
+		return client
+	}
+	return r.client
+}
+
+// Entities implements the EntityResolver interface by resolving
+// the Apollo Federation entities using the Node API of the client.
+func (r *Resolver) Entities(ctx context.Context, representations []map[string]interface{}) ([]ent.Noder, error) {
+	return r.entClient(ctx).Entities(ctx, representations)
+}
@@ -55,6 +55,8 @@ func main() {
 
 	srv := handler.NewDefaultServer(todo.NewSchema(client))
 	srv.Use(entgql.Transactioner{TxOpener: client})
+	srv.Use(entgql.EdgeLoader{})
+	srv.SetErrorPresenter(entgql.ErrorPresenter)
 	if cli.Debug {
 		srv.Use(&debug.Tracer{})
 	}
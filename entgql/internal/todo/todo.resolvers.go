@@ -20,17 +20,24 @@ package todo
 import (
 	"context"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todo/ent"
+	"entgo.io/contrib/entgql/internal/todo/ent/todo"
 )
 
-func (r *mutationResolver) CreateTodo(ctx context.Context, todo TodoInput) (*ent.Todo, error) {
+func (r *mutationResolver) CreateTodo(ctx context.Context, input ent.CreateTodoInput) (*ent.Todo, error) {
 	client := ent.FromContext(ctx)
 	return client.Todo.
 		Create().
-		SetStatus(todo.Status).
-		SetNillablePriority(todo.Priority).
-		SetText(todo.Text).
-		SetNillableParentID(todo.Parent).
+		SetInput(input).
+		Save(ctx)
+}
+
+func (r *mutationResolver) UpdateTodo(ctx context.Context, id int, input ent.UpdateTodoInput) (*ent.Todo, error) {
+	client := ent.FromContext(ctx)
+	return client.Todo.
+		UpdateOneID(id).
+		SetInput(input).
 		Save(ctx)
 }
 
@@ -41,26 +48,81 @@ func (r *mutationResolver) ClearTodos(ctx context.Context) (int, error) {
 		Exec(ctx)
 }
 
+func (r *mutationResolver) CreateTodos(ctx context.Context, inputs []*ent.CreateTodoInput) ([]*ent.Todo, error) {
+	client := ent.FromContext(ctx)
+	return client.Todo.CreateFromInputs(ctx, inputs)
+}
+
+func (r *mutationResolver) UpdateTodos(ctx context.Context, where ent.TodoWhereInput, input ent.UpdateTodoInput) ([]*ent.Todo, error) {
+	client := ent.FromContext(ctx)
+	return client.Todo.UpdateWhere(ctx, &where, input)
+}
+
+func (r *mutationResolver) DeleteTodos(ctx context.Context, where ent.TodoWhereInput) (int, error) {
+	client := ent.FromContext(ctx)
+	return client.Todo.DeleteWhere(ctx, &where)
+}
+
 func (r *queryResolver) Node(ctx context.Context, id int) (ent.Noder, error) {
-	return r.client.Noder(ctx, id)
+	return r.entClient(ctx).Noder(ctx, id)
 }
 
 func (r *queryResolver) Nodes(ctx context.Context, ids []int) ([]ent.Noder, error) {
-	return r.client.Noders(ctx, ids)
+	return r.entClient(ctx).Noders(ctx, ids)
 }
 
-func (r *queryResolver) Todos(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.TodoOrder) (*ent.TodoConnection, error) {
-	return r.client.Todo.Query().
+func (r *queryResolver) Todos(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy []*ent.TodoOrder, where *ent.TodoWhereInput) (*ent.TodoConnection, error) {
+	return r.entClient(ctx).Todo.Query().
 		Paginate(ctx, after, first, before, last,
 			ent.WithTodoOrder(orderBy),
+			ent.WithTodoFilter(where.Filter),
 		)
 }
 
+func (r *subscriptionResolver) TodoUpdated(ctx context.Context, id int) (<-chan *ent.Todo, error) {
+	var (
+		events = r.client.Todo.Subscribe(ctx, r.broker, todo.ID(id))
+		ch     = make(chan *ent.Todo)
+	)
+	go func() {
+		defer close(ch)
+		for e := range events {
+			switch {
+			case e.ID != id:
+			case e.Op == entgql.EventDeleted:
+				return
+			case e.Op == entgql.EventUpdated:
+				select {
+				case ch <- e.Node:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
+
+func (r *subscriptionResolver) TodosChanged(ctx context.Context, where *ent.TodoWhereInput) (<-chan *ent.TodoEvent, error) {
+	p, err := where.P()
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return r.client.Todo.Subscribe(ctx, r.broker), nil
+	}
+	return r.client.Todo.Subscribe(ctx, r.broker, p), nil
+}
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
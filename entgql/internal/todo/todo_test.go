@@ -15,13 +15,17 @@
 package todo_test
 
 import (
+	"bytes"
 	"context"
+	"database/sql/driver"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -32,9 +36,12 @@ import (
 	"entgo.io/contrib/entgql/internal/todo/ent/migrate"
 	"entgo.io/contrib/entgql/internal/todo/ent/todo"
 	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
 	"github.com/99designs/gqlgen/client"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/AlekSi/pointer"
 	"github.com/stretchr/testify/suite"
@@ -85,7 +92,7 @@ func (s *todoTestSuite) SetupTest() {
 	s.Client = client.New(srv)
 
 	const mutation = `mutation($priority: Int, $text: String!, $parent: ID) {
-		createTodo(todo: {status: COMPLETED, priority: $priority, text: $text, parent: $parent}) {
+		createTodo(input: {status: COMPLETED, priority: $priority, text: $text, parentID: $parent}) {
 			id
 		}
 	}`
@@ -180,7 +187,7 @@ func (s *todoTestSuite) TestQueryAll() {
 	)
 	for i, edge := range rsp.Todos.Edges {
 		s.Require().Equal(strconv.Itoa(i+1), edge.Node.ID)
-		s.Require().EqualValues(todo.StatusCompleted, edge.Node.Status)
+		s.Require().EqualValues("COMPLETED", edge.Node.Status)
 		s.Require().NotEmpty(edge.Cursor)
 	}
 }
@@ -572,6 +579,68 @@ func (s *todoTestSuite) TestNodes() {
 	}
 }
 
+func (s *todoTestSuite) TestEntities() {
+	const (
+		query = `query($representations: [_Any!]!) {
+			_entities(representations: $representations) {
+				... on Todo {
+					text
+				}
+			}
+		}`
+	)
+	var rsp struct {
+		Entities []*struct{ Text string } `json:"_entities"`
+	}
+	ids := []int{1, maxTodos + 1, 2, 1}
+	representations := make([]map[string]interface{}, len(ids))
+	for i, id := range ids {
+		representations[i] = map[string]interface{}{"__typename": "Todo", "id": strconv.Itoa(id)}
+	}
+	err := s.Post(query, &rsp, client.Var("representations", representations))
+	s.Require().Error(err)
+	s.Require().Len(rsp.Entities, len(ids))
+	for i, id := range ids {
+		if id <= maxTodos {
+			s.Require().Equal(strconv.Itoa(id), rsp.Entities[i].Text)
+		} else {
+			s.Require().Nil(rsp.Entities[i])
+		}
+	}
+	var jerr client.RawJsonError
+	s.Require().True(errors.As(err, &jerr))
+	var errs gqlerror.List
+	err = json.Unmarshal(jerr.RawMessage, &errs)
+	s.Require().NoError(err)
+	s.Require().Len(errs, 1)
+	s.Require().Equal(fmt.Sprintf("Could not resolve to a Todo entity with the key {id: %d}", maxTodos+1), errs[0].Message)
+	s.Require().Equal("NOT_FOUND", errs[0].Extensions["code"])
+
+	err = s.Post(query, &rsp, client.Var("representations", []map[string]interface{}{
+		{"__typename": "User", "id": "1"},
+	}))
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), `cannot resolve entities of type \"User\"`)
+}
+
+func (s *todoTestSuite) TestService() {
+	var rsp struct {
+		Service struct{ SDL string } `json:"_service"`
+	}
+	err := s.Post(`query { _service { sdl } }`, &rsp)
+	s.Require().EqualError(err, `[{"message":"federated introspection disabled","path":["_service"]}]`)
+
+	srv := handler.New(gen.NewSchema(s.ent))
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	err = client.New(srv).Post(`query { _service { sdl } }`, &rsp)
+	s.Require().NoError(err)
+	s.Require().Contains(rsp.Service.SDL, `type Todo implements Node @key(fields: "id")`)
+	for _, name := range []string{"_entities", "_service", "_Any", "_Entity", "directive @key"} {
+		s.Require().NotContains(rsp.Service.SDL, name, "federation definitions are added by the gateway")
+	}
+}
+
 func (s *todoTestSuite) TestNodeCollection() {
 	const (
 		query = `query($id: ID!) {
@@ -584,15 +653,28 @@ func (s *todoTestSuite) TestNodeCollection() {
 						}
 					}
 					children {
-						text
-						children {
-							text
+						edges {
+							node {
+								text
+								children {
+									edges {
+										node {
+											text
+										}
+									}
+								}
+							}
 						}
 					}
 				}
 			}
 		}`
 	)
+	type edges []struct {
+		Node struct {
+			Text string
+		}
+	}
 	var rsp struct {
 		Todo struct {
 			Parent *struct {
@@ -601,10 +683,14 @@ func (s *todoTestSuite) TestNodeCollection() {
 					Text string
 				}
 			}
-			Children []struct {
-				Text     string
-				Children []struct {
-					Text string
+			Children struct {
+				Edges []struct {
+					Node struct {
+						Text     string
+						Children struct {
+							Edges edges
+						}
+					}
 				}
 			}
 		}
@@ -612,12 +698,12 @@ func (s *todoTestSuite) TestNodeCollection() {
 	err := s.Post(query, &rsp, client.Var("id", 1))
 	s.Require().NoError(err)
 	s.Require().Nil(rsp.Todo.Parent)
-	s.Require().Len(rsp.Todo.Children, maxTodos/2+1)
+	s.Require().Len(rsp.Todo.Children.Edges, maxTodos/2+1)
 	s.Require().Condition(func() bool {
-		for _, child := range rsp.Todo.Children {
-			if child.Text == "3" {
-				s.Require().Len(child.Children, 1)
-				s.Require().Equal("5", child.Children[0].Text)
+		for _, child := range rsp.Todo.Children.Edges {
+			if child.Node.Text == "3" {
+				s.Require().Len(child.Node.Children.Edges, 1)
+				s.Require().Equal("5", child.Node.Children.Edges[0].Node.Text)
 				return true
 			}
 		}
@@ -628,7 +714,7 @@ func (s *todoTestSuite) TestNodeCollection() {
 	s.Require().NoError(err)
 	s.Require().NotNil(rsp.Todo.Parent)
 	s.Require().Equal("1", rsp.Todo.Parent.Text)
-	s.Require().Empty(rsp.Todo.Children)
+	s.Require().Empty(rsp.Todo.Children.Edges)
 
 	err = s.Post(query, &rsp, client.Var("id", 5))
 	s.Require().NoError(err)
@@ -636,8 +722,8 @@ func (s *todoTestSuite) TestNodeCollection() {
 	s.Require().Equal("3", rsp.Todo.Parent.Text)
 	s.Require().NotNil(rsp.Todo.Parent.Parent)
 	s.Require().Equal("1", rsp.Todo.Parent.Parent.Text)
-	s.Require().Len(rsp.Todo.Children, 1)
-	s.Require().Equal("7", rsp.Todo.Children[0].Text)
+	s.Require().Len(rsp.Todo.Children.Edges, 1)
+	s.Require().Equal("7", rsp.Todo.Children.Edges[0].Node.Text)
 }
 
 func (s *todoTestSuite) TestConnCollection() {
@@ -651,7 +737,12 @@ func (s *todoTestSuite) TestConnCollection() {
 							id
 						}
 						children {
-							id
+							totalCount
+							edges {
+								node {
+									id
+								}
+							}
 						}
 					}
 				}
@@ -666,8 +757,13 @@ func (s *todoTestSuite) TestConnCollection() {
 					Parent *struct {
 						ID string
 					}
-					Children []struct {
-						ID string
+					Children struct {
+						TotalCount int
+						Edges      []struct {
+							Node struct {
+								ID string
+							}
+						}
 					}
 				}
 			}
@@ -682,23 +778,254 @@ func (s *todoTestSuite) TestConnCollection() {
 		switch {
 		case i == 0:
 			s.Require().Nil(edge.Node.Parent)
-			s.Require().Len(edge.Node.Children, maxTodos/2+1)
+			s.Require().Len(edge.Node.Children.Edges, maxTodos/2+1)
+			s.Require().Equal(maxTodos/2+1, edge.Node.Children.TotalCount)
 		case i%2 == 0:
 			s.Require().NotNil(edge.Node.Parent)
 			id, err := strconv.Atoi(edge.Node.Parent.ID)
 			s.Require().NoError(err)
 			s.Require().Equal(i-1, id)
 			if i < len(rsp.Todos.Edges)-2 {
-				s.Require().Len(edge.Node.Children, 1)
+				s.Require().Len(edge.Node.Children.Edges, 1)
 			} else {
-				s.Require().Empty(edge.Node.Children)
+				s.Require().Empty(edge.Node.Children.Edges)
 			}
 		case i%2 != 0:
 			s.Require().NotNil(edge.Node.Parent)
 			s.Require().Equal("1", edge.Node.Parent.ID)
-			s.Require().Empty(edge.Node.Children)
+			s.Require().Empty(edge.Node.Children.Edges)
+		}
+	}
+}
+
+func (s *todoTestSuite) TestEdgeConnection() {
+	type conn struct {
+		TotalCount int
+		PageInfo   struct {
+			HasNextPage     bool
+			HasPreviousPage bool
+			EndCursor       *string
+		}
+		Edges []struct {
+			Node struct {
+				ID       string
+				Priority int
+			}
 		}
 	}
+	priorities := func(c conn) []int {
+		p := make([]int, len(c.Edges))
+		for i, e := range c.Edges {
+			p[i] = e.Node.Priority
+		}
+		return p
+	}
+	order := []map[string]interface{}{{"field": "PRIORITY", "direction": "DESC"}}
+	s.Run("Forward", func() {
+		const query = `query($orderBy: [TodoOrder!]) {
+			todos {
+				edges {
+					node {
+						id
+						children(first: 2, orderBy: $orderBy) {
+							totalCount
+							pageInfo {
+								hasNextPage
+								hasPreviousPage
+								endCursor
+							}
+							edges {
+								node {
+									id
+									priority
+								}
+							}
+						}
+					}
+				}
+			}
+		}`
+		var rsp struct {
+			Todos struct {
+				Edges []struct {
+					Node struct {
+						ID       string
+						Children conn
+					}
+				}
+			}
+		}
+		err := s.Post(query, &rsp, client.Var("orderBy", order))
+		s.Require().NoError(err)
+		s.Require().Len(rsp.Todos.Edges, maxTodos)
+		for _, e := range rsp.Todos.Edges {
+			children := e.Node.Children
+			switch id, _ := strconv.Atoi(e.Node.ID); {
+			case id == 1:
+				s.Require().Equal(maxTodos/2+1, children.TotalCount)
+				s.Require().Equal([]int{maxTodos, maxTodos - 2}, priorities(children))
+				s.Require().True(children.PageInfo.HasNextPage)
+				s.Require().False(children.PageInfo.HasPreviousPage)
+			case id%2 != 0 && id < maxTodos-1:
+				s.Require().Equal(1, children.TotalCount)
+				s.Require().Equal([]int{id + 2}, priorities(children))
+				s.Require().False(children.PageInfo.HasNextPage)
+			default:
+				s.Require().Zero(children.TotalCount)
+				s.Require().Empty(children.Edges)
+				s.Require().False(children.PageInfo.HasNextPage)
+				s.Require().Nil(children.PageInfo.EndCursor)
+			}
+		}
+
+		const next = `query($id: ID!, $after: Cursor, $orderBy: [TodoOrder!]) {
+			todo: node(id: $id) {
+				... on Todo {
+					children(first: 2, after: $after, orderBy: $orderBy) {
+						totalCount
+						pageInfo {
+							hasNextPage
+							endCursor
+						}
+						edges {
+							node {
+								priority
+							}
+						}
+					}
+				}
+			}
+		}`
+		var page struct {
+			Todo struct {
+				Children conn
+			}
+		}
+		after := rsp.Todos.Edges[0].Node.Children.PageInfo.EndCursor
+		s.Require().NotNil(after)
+		err = s.Post(next, &page, client.Var("id", 1), client.Var("after", *after), client.Var("orderBy", order))
+		s.Require().NoError(err)
+		s.Require().Equal(maxTodos/2+1, page.Todo.Children.TotalCount)
+		s.Require().Equal([]int{maxTodos - 4, maxTodos - 6}, priorities(page.Todo.Children))
+		s.Require().True(page.Todo.Children.PageInfo.HasNextPage)
+	})
+	s.Run("Backward", func() {
+		const query = `query($orderBy: [TodoOrder!]) {
+			todo: node(id: 1) {
+				... on Todo {
+					children(last: 2, orderBy: $orderBy) {
+						totalCount
+						pageInfo {
+							hasNextPage
+							hasPreviousPage
+						}
+						edges {
+							node {
+								priority
+							}
+						}
+					}
+				}
+			}
+		}`
+		var rsp struct {
+			Todo struct {
+				Children conn
+			}
+		}
+		err := s.Post(query, &rsp, client.Var("orderBy", order))
+		s.Require().NoError(err)
+		s.Require().Equal(maxTodos/2+1, rsp.Todo.Children.TotalCount)
+		s.Require().Equal([]int{3, 2}, priorities(rsp.Todo.Children))
+		s.Require().True(rsp.Todo.Children.PageInfo.HasPreviousPage)
+		s.Require().False(rsp.Todo.Children.PageInfo.HasNextPage)
+	})
+	s.Run("Aliases", func() {
+		const query = `query {
+			todo: node(id: 1) {
+				... on Todo {
+					first: children(first: 1) {
+						totalCount
+						edges {
+							node {
+								id
+							}
+						}
+					}
+					last: children(last: 1) {
+						totalCount
+						edges {
+							node {
+								id
+							}
+						}
+					}
+				}
+			}
+		}`
+		var rsp struct {
+			Todo struct {
+				First, Last conn
+			}
+		}
+		err := s.Post(query, &rsp)
+		s.Require().NoError(err)
+		s.Require().Equal(maxTodos/2+1, rsp.Todo.First.TotalCount)
+		s.Require().Len(rsp.Todo.First.Edges, 1)
+		s.Require().Equal("2", rsp.Todo.First.Edges[0].Node.ID)
+		s.Require().Equal(maxTodos/2+1, rsp.Todo.Last.TotalCount)
+		s.Require().Len(rsp.Todo.Last.Edges, 1)
+		s.Require().Equal(strconv.Itoa(maxTodos), rsp.Todo.Last.Edges[0].Node.ID)
+	})
+	s.Run("SingleQuery", func() {
+		var queries int32
+		ec := enttest.Open(s.T(), dialect.SQLite,
+			fmt.Sprintf("file:%s-%d?mode=memory&cache=shared&_fk=1", s.T().Name(), time.Now().UnixNano()),
+			enttest.WithOptions(ent.Debug(), ent.Log(func(...interface{}) {
+				atomic.AddInt32(&queries, 1)
+			})),
+		)
+		defer ec.Close()
+		ctx := context.Background()
+		for i := 0; i < 3; i++ {
+			parent := ec.Todo.Create().SetText("parent").SetStatus(todo.StatusInProgress).SaveX(ctx)
+			for j := 0; j < 3; j++ {
+				ec.Todo.Create().SetText("child").SetStatus(todo.StatusInProgress).SetParent(parent).SaveX(ctx)
+			}
+		}
+		const query = `query {
+			todos {
+				edges {
+					node {
+						children(first: 1) {
+							totalCount
+							edges {
+								node {
+									id
+								}
+							}
+						}
+					}
+				}
+			}
+		}`
+		var rsp struct {
+			Todos struct {
+				Edges []struct {
+					Node struct {
+						Children conn
+					}
+				}
+			}
+		}
+		atomic.StoreInt32(&queries, 0)
+		err := client.New(handler.NewDefaultServer(gen.NewSchema(ec))).Post(query, &rsp)
+		s.Require().NoError(err)
+		s.Require().Len(rsp.Todos.Edges, 12)
+		s.Require().Equal(3, rsp.Todos.Edges[0].Node.Children.TotalCount)
+		s.Require().Len(rsp.Todos.Edges[0].Node.Children.Edges, 1)
+		s.Require().EqualValues(2, atomic.LoadInt32(&queries), "todos and their children are loaded in 2 queries")
+	})
 }
 
 func (s *todoTestSuite) TestEnumEncoding() {
@@ -712,15 +1039,18 @@ func (s *todoTestSuite) TestEnumEncoding() {
 		s.Require().Equal(quote, str[:1])
 		s.Require().Equal(quote, str[len(str)-1:])
 		str = str[1 : len(str)-1]
-		s.Require().EqualValues(status, str)
+		s.Require().Equal("COMPLETED", str)
+		s.Require().EqualValues("completed", status)
 	})
 	s.Run("Decode", func() {
 		const want = todo.StatusInProgress
 		var got todo.Status
 		s.Require().Implements((*graphql.Unmarshaler)(nil), &got)
-		err := got.UnmarshalGQL(want.String())
+		err := got.UnmarshalGQL("IN_PROGRESS")
 		s.Require().NoError(err)
 		s.Require().Equal(want, got)
+		err = got.UnmarshalGQL(want.String())
+		s.Require().EqualError(err, "in_progress is not a valid Status")
 	})
 }
 
@@ -744,6 +1074,210 @@ func (s *todoTestSuite) TestNodeOptions() {
 	s.Require().EqualError(err, "bad node type")
 }
 
+func (s *todoTestSuite) TestReloadNodeTypes() {
+	ctx := context.Background()
+	drv, err := entsql.Open(dialect.SQLite,
+		fmt.Sprintf("file:%s-%d?mode=memory&cache=shared&_fk=1", s.T().Name(), time.Now().UnixNano()),
+	)
+	s.Require().NoError(err)
+	ec := ent.NewClient(ent.Driver(drv), ent.NodeTypesReloadInterval(0))
+	defer ec.Close()
+	s.Require().NoError(ec.Schema.Create(ctx, migrate.WithGlobalUniqueID(true)))
+	td := ec.Todo.Create().SetText("text").SetStatus(todo.StatusInProgress).SaveX(ctx)
+
+	// Simulate a type that was added after the type table was loaded.
+	_, err = drv.ExecContext(ctx, "DELETE FROM "+schema.TypeTable)
+	s.Require().NoError(err)
+	_, err = ec.Noder(ctx, td.ID)
+	s.Require().True(ent.IsNotFound(err))
+	_, err = drv.ExecContext(ctx, "INSERT INTO "+schema.TypeTable+" (type) VALUES (?)", todo.Table)
+	s.Require().NoError(err)
+	s.Require().NoError(ec.ReloadNodeTypes(ctx))
+	nr, err := ec.Noder(ctx, td.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(td.ID, nr.(*ent.Todo).ID)
+
+	_, err = drv.ExecContext(ctx, "DELETE FROM "+schema.TypeTable)
+	s.Require().NoError(err)
+	s.Require().NoError(ec.ReloadNodeTypes(ctx))
+	_, err = drv.ExecContext(ctx, "INSERT INTO "+schema.TypeTable+" (type) VALUES (?)", todo.Table)
+	s.Require().NoError(err)
+	// The type table is reloaded on ids without a type, once the reload interval has passed.
+	nr, err = ec.Noder(ctx, td.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(td.ID, nr.(*ent.Todo).ID)
+}
+
+func (s *todoTestSuite) TestMutationSavepoints() {
+	const mutation = `mutation {
+		ok: createTodo(input: {status: COMPLETED, text: "ok"}) { id }
+		bad: createTodo(input: {status: COMPLETED, text: ""}) { id }
+	}`
+	ctx := context.Background()
+	// Without savepoints, the work of all fields is rolled back.
+	err := s.Post(mutation, &struct{}{})
+	s.Require().Error(err)
+	s.Require().Equal(maxTodos, s.ent.Todo.Query().CountX(ctx))
+
+	srv := handler.New(gen.NewSchema(s.ent))
+	srv.AddTransport(transport.POST{})
+	srv.Use(entgql.Transactioner{TxOpener: s.ent, Savepoints: true})
+	err = client.New(srv).Post(mutation, &struct{}{})
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "bad")
+	s.Require().Equal(maxTodos+1, s.ent.Todo.Query().CountX(ctx))
+	s.Require().True(s.ent.Todo.Query().Where(todo.Text("ok")).ExistX(ctx))
+}
+
+func (s *todoTestSuite) TestBulkMutations() {
+	ctx := context.Background()
+	var created struct {
+		CreateTodos []struct {
+			ID     string
+			Text   string
+			Parent struct{ Text string }
+		}
+	}
+	err := s.Post(`mutation {
+		createTodos(inputs: [
+			{status: IN_PROGRESS, text: "bulk-1", parentID: 1},
+			{status: IN_PROGRESS, text: "bulk-2", parentID: 1},
+			{status: IN_PROGRESS, text: "bulk-3"}
+		]) { id text parent { text } }
+	}`, &created)
+	s.Require().NoError(err)
+	s.Require().Len(created.CreateTodos, 3)
+	for i, td := range created.CreateTodos {
+		s.Require().Equal(fmt.Sprintf("bulk-%d", i+1), td.Text)
+	}
+	s.Require().Equal("1", created.CreateTodos[0].Parent.Text)
+	s.Require().Equal(maxTodos+3, s.ent.Todo.Query().CountX(ctx))
+
+	// Inputs are created in a single transaction.
+	err = s.Post(`mutation {
+		createTodos(inputs: [{status: IN_PROGRESS, text: "ok"}, {status: IN_PROGRESS, text: ""}]) { id }
+	}`, &struct{}{})
+	s.Require().Error(err)
+	s.Require().Equal(maxTodos+3, s.ent.Todo.Query().CountX(ctx))
+
+	var updated struct {
+		UpdateTodos []struct {
+			Text     string
+			Priority int
+		}
+	}
+	err = s.Post(`mutation {
+		updateTodos(where: {textHasPrefix: "bulk-", statusNEQ: COMPLETED}, input: {priority: 100}) { text priority }
+	}`, &updated)
+	s.Require().NoError(err)
+	s.Require().Len(updated.UpdateTodos, 3)
+	for _, td := range updated.UpdateTodos {
+		s.Require().Equal(100, td.Priority)
+	}
+	s.Require().Equal(3, s.ent.Todo.Query().Where(todo.Priority(100)).CountX(ctx))
+
+	err = s.Post(`mutation { updateTodos(where: {textHasPrefix: "none"}, input: {priority: 1}) { id } }`, &updated)
+	s.Require().NoError(err)
+	s.Require().Empty(updated.UpdateTodos)
+	err = s.Post(`mutation { updateTodos(where: {}, input: {priority: 1}) { text } }`, &updated)
+	s.Require().EqualError(err, `[{"message":"ent: empty where input","path":["updateTodos"]}]`, "updating all nodes must be explicit")
+	s.Require().Zero(s.ent.Todo.Query().Where(todo.Priority(1)).Where(todo.TextHasPrefix("bulk-")).CountX(ctx))
+
+	var deleted struct{ DeleteTodos int }
+	err = s.Post(`mutation { deleteTodos(where: {textHasPrefix: "bulk-", hasChildren: false}) }`, &deleted)
+	s.Require().NoError(err)
+	s.Require().Equal(3, deleted.DeleteTodos)
+	s.Require().Equal(maxTodos, s.ent.Todo.Query().CountX(ctx))
+
+	_, err = s.ent.Todo.DeleteWhere(ctx, nil)
+	s.Require().Error(err, "deleting all todos must be explicit")
+	err = s.Post(`mutation { deleteTodos(where: {}) }`, &deleted)
+	s.Require().EqualError(err, `[{"message":"ent: empty where input","path":["deleteTodos"]}]`)
+	err = s.Post(`mutation { deleteTodos(where: {or: [{}]}) }`, &deleted)
+	s.Require().Error(err)
+	s.Require().Equal(maxTodos, s.ent.Todo.Query().CountX(ctx))
+
+	_, err = s.ent.Todo.CreateFromInputs(ctx, []*ent.CreateTodoInput{{Status: todo.StatusInProgress, Text: "text"}, nil})
+	s.Require().EqualError(err, "ent: missing CreateTodoInput at index 1")
+	s.Require().Equal(maxTodos, s.ent.Todo.Query().CountX(ctx))
+
+	// Updates of many nodes are executed in chunks of ids.
+	builders := make([]*ent.TodoCreate, 1200)
+	for i := range builders {
+		builders[i] = s.ent.Todo.Create().SetText("chunk").SetStatus(todo.StatusInProgress)
+	}
+	s.ent.Todo.CreateBulk(builders...).SaveX(ctx)
+	err = s.Post(`mutation { updateTodos(where: {text: "chunk"}, input: {priority: 200}) { priority } }`, &updated)
+	s.Require().NoError(err)
+	s.Require().Len(updated.UpdateTodos, len(builders))
+	s.Require().Equal(len(builders), s.ent.Todo.Query().Where(todo.Priority(200)).CountX(ctx))
+}
+
+// snapshotOpener opens snapshot transactions that hold a todo
+// that is not visible outside of them, as it is never committed.
+type snapshotOpener struct{ *ent.Client }
+
+func (o snapshotOpener) OpenTxWithOptions(ctx context.Context, opts *entsql.TxOptions) (context.Context, driver.Tx, error) {
+	ctx, tx, err := o.Client.OpenTxWithOptions(ctx, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := ent.FromContext(ctx).Todo.Create().SetText("snapshot").SetStatus(todo.StatusCompleted).Save(ctx); err != nil {
+		_ = tx.Rollback()
+		return nil, nil, err
+	}
+	return ctx, tx, nil
+}
+
+func (s *todoTestSuite) TestSnapshotQueries() {
+	srv := handler.New(gen.NewSchema(s.ent))
+	srv.AddTransport(transport.POST{})
+	srv.Use(entgql.Transactioner{TxOpener: snapshotOpener{s.ent}, SnapshotQueries: true})
+	var rsp struct {
+		Todos struct {
+			TotalCount int
+			Edges      []struct {
+				Node struct{ Text string }
+			}
+		}
+	}
+	err := client.New(srv).Post(`query { todos(where: {text: "snapshot"}) { totalCount edges { node { text } } } }`, &rsp)
+	s.Require().NoError(err)
+	s.Require().Equal(1, rsp.Todos.TotalCount, "count is read from the snapshot transaction")
+	s.Require().Len(rsp.Todos.Edges, 1, "edges are read from the snapshot transaction")
+	s.Require().Equal("snapshot", rsp.Todos.Edges[0].Node.Text)
+	s.Require().False(s.ent.Todo.Query().Where(todo.Text("snapshot")).ExistX(context.Background()))
+}
+
+func (s *todoTestSuite) TestMutationPerField() {
+	srv := handler.New(gen.NewSchema(s.ent))
+	srv.AddTransport(transport.POST{})
+	srv.Use(entgql.Transactioner{TxOpener: s.ent, PerField: true})
+	var rsp struct {
+		First, Second struct {
+			Text   string
+			Parent struct{ Text string }
+		}
+	}
+	err := client.New(srv).Post(`mutation {
+		first: createTodo(input: {status: COMPLETED, text: "first", parentID: 1}) { text parent { text } }
+		second: createTodo(input: {status: COMPLETED, text: "second", parentID: 1}) { text parent { text } }
+	}`, &rsp)
+	s.Require().NoError(err)
+	s.Require().Equal("first", rsp.First.Text)
+	s.Require().Equal("1", rsp.First.Parent.Text)
+	s.Require().Equal("second", rsp.Second.Text)
+
+	err = client.New(srv).Post(`mutation {
+		ok: createTodo(input: {status: COMPLETED, text: "ok"}) { id }
+		bad: createTodo(input: {status: COMPLETED, text: ""}) { id }
+	}`, &struct{}{})
+	s.Require().Error(err)
+	ctx := context.Background()
+	s.Require().Equal(maxTodos+3, s.ent.Todo.Query().CountX(ctx))
+	s.Require().True(s.ent.Todo.Query().Where(todo.Text("ok")).ExistX(ctx))
+}
+
 func (s *todoTestSuite) TestMutationFieldCollection() {
 	var rsp struct {
 		CreateTodo struct {
@@ -755,7 +1289,7 @@ func (s *todoTestSuite) TestMutationFieldCollection() {
 		}
 	}
 	err := s.Post(`mutation {
-		createTodo(todo: { text: "OKE", parent: 1 }) {
+		createTodo(input: { status: IN_PROGRESS, text: "OKE", parentID: 1 }) {
 			parent {
 				id
 				text
@@ -768,3 +1302,837 @@ func (s *todoTestSuite) TestMutationFieldCollection() {
 	s.Require().Equal("1", rsp.CreateTodo.Parent.ID)
 	s.Require().Equal("1", rsp.CreateTodo.Parent.Text)
 }
+
+func (s *todoTestSuite) TestPaginationMultiOrder() {
+	const (
+		query = `query($after: Cursor, $first: Int, $before: Cursor, $last: Int) {
+			todos(after: $after, first: $first, before: $before, last: $last, orderBy: [{ field: STATUS, direction: ASC }, { field: PRIORITY, direction: DESC }]) {
+				totalCount
+				edges {
+					node {
+						id
+						priority
+						status
+					}
+					cursor
+				}
+				pageInfo {
+					hasNextPage
+					hasPreviousPage
+					startCursor
+					endCursor
+				}
+			}
+		}`
+		step  = 5
+		steps = maxTodos/step + 1
+	)
+	ctx := context.Background()
+	for id := 3; id <= maxTodos; id += 3 {
+		err := s.ent.Todo.UpdateOneID(id).
+			SetStatus(todo.StatusInProgress).
+			Exec(ctx)
+		s.Require().NoError(err)
+	}
+	// IN_PROGRESS todos come first, as statuses are ordered by their declaration order,
+	// and each status group is ordered by priority in descending order.
+	expected := make([]string, 0, maxTodos)
+	for _, group := range []int{0, 1} {
+		for id := maxTodos; id > 0; id-- {
+			if id%3 == 0 == (group == 0) {
+				expected = append(expected, strconv.Itoa(id))
+			}
+		}
+	}
+	s.Run("Forward", func() {
+		var (
+			rsp response
+			ids []string
+		)
+		for i := 0; i < steps; i++ {
+			err := s.Post(query, &rsp,
+				client.Var("after", rsp.Todos.PageInfo.EndCursor),
+				client.Var("first", step),
+			)
+			s.Require().NoError(err)
+			s.Require().Equal(maxTodos, rsp.Todos.TotalCount)
+			s.Require().Equal(i < steps-1, rsp.Todos.PageInfo.HasNextPage)
+			for _, e := range rsp.Todos.Edges {
+				ids = append(ids, e.Node.ID)
+			}
+		}
+		s.Require().Equal(expected, ids)
+	})
+	s.Run("Backward", func() {
+		var (
+			rsp response
+			ids []string
+		)
+		for i := 0; i < steps; i++ {
+			err := s.Post(query, &rsp,
+				client.Var("before", rsp.Todos.PageInfo.StartCursor),
+				client.Var("last", step),
+			)
+			s.Require().NoError(err)
+			s.Require().Equal(maxTodos, rsp.Todos.TotalCount)
+			s.Require().Equal(i < steps-1, rsp.Todos.PageInfo.HasPreviousPage)
+			page := make([]string, 0, len(rsp.Todos.Edges))
+			for _, e := range rsp.Todos.Edges {
+				page = append(page, e.Node.ID)
+			}
+			ids = append(page, ids...)
+		}
+		s.Require().Equal(expected, ids)
+	})
+	s.Run("CursorMismatch", func() {
+		var rsp response
+		err := s.Post(query, &rsp, client.Var("first", 1))
+		s.Require().NoError(err)
+		err = s.Post(`query($after: Cursor) {
+			todos(after: $after, first: 1) {
+				edges {
+					cursor
+				}
+			}
+		}`, &rsp, client.Var("after", rsp.Todos.PageInfo.EndCursor))
+		var jerr client.RawJsonError
+		s.Require().True(errors.As(err, &jerr))
+		var errs gqlerror.List
+		err = json.Unmarshal(jerr.RawMessage, &errs)
+		s.Require().NoError(err)
+		s.Require().Len(errs, 1)
+		s.Require().Equal(`Invalid cursor: cursor order "status ASC,priority DESC,id DESC" does not match the pagination order "id ASC"`, errs[0].Message)
+		s.Require().Equal("INVALID_PAGINATION", errs[0].Extensions["code"])
+	})
+}
+
+func (s *todoTestSuite) TestPaginationCursorCodec() {
+	const query = `query($after: Cursor, $first: Int) {
+		todos(after: $after, first: $first) {
+			edges {
+				cursor
+			}
+			pageInfo {
+				endCursor
+			}
+		}
+	}`
+	codec, err := entgql.NewSignedCursorCodec([]byte("0123456789abcdef0123456789abcdef"))
+	s.Require().NoError(err)
+	ec := enttest.Open(s.T(), dialect.SQLite,
+		fmt.Sprintf("file:%s-%d?mode=memory&cache=shared&_fk=1", s.T().Name(), time.Now().UnixNano()),
+		enttest.WithOptions(ent.CursorCodec(codec)),
+	)
+	defer ec.Close()
+	for i := 0; i < 3; i++ {
+		ec.Todo.Create().SetStatus(todo.StatusInProgress).SetText(strconv.Itoa(i)).SaveX(context.Background())
+	}
+	srv := handler.New(gen.NewSchema(ec))
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	var rsp response
+	err = c.Post(query, &rsp, client.Var("first", 1))
+	s.Require().NoError(err)
+	signed := rsp.Todos.PageInfo.EndCursor
+	err = c.Post(query, &rsp,
+		client.Var("after", signed),
+		client.Var("first", 1),
+	)
+	s.Require().NoError(err)
+	err = s.Post(query, &rsp,
+		client.Var("after", signed),
+		client.Var("first", 1),
+	)
+	s.Require().Error(err, "the codec is configured per client")
+
+	var buf bytes.Buffer
+	ent.Cursor{ID: 2, Order: "id ASC"}.MarshalGQL(&buf)
+	forged, err := strconv.Unquote(buf.String())
+	s.Require().NoError(err)
+	err = c.Post(query, &rsp,
+		client.Var("after", forged),
+		client.Var("first", 1),
+	)
+	var jerr client.RawJsonError
+	s.Require().True(errors.As(err, &jerr))
+	var errs gqlerror.List
+	err = json.Unmarshal(jerr.RawMessage, &errs)
+	s.Require().NoError(err)
+	s.Require().Len(errs, 1)
+	s.Require().Equal("INVALID_PAGINATION", errs[0].Extensions["code"])
+}
+
+func (s *todoTestSuite) TestPaginationEdgeOrder() {
+	const (
+		query = `query($after: Cursor, $first: Int, $before: Cursor, $last: Int, $orderBy: [TodoOrder!], $where: TodoWhereInput) {
+			todos(after: $after, first: $first, before: $before, last: $last, orderBy: $orderBy, where: $where) {
+				totalCount
+				edges {
+					node {
+						id
+					}
+					cursor
+				}
+				pageInfo {
+					hasNextPage
+					hasPreviousPage
+					startCursor
+					endCursor
+				}
+			}
+		}`
+		step = 5
+	)
+	ctx := context.Background()
+	todos, err := s.ent.Todo.Query().
+		WithParent().
+		WithChildren().
+		All(ctx)
+	s.Require().NoError(err)
+	paginate := func(orderBy, where interface{}, forward bool) []string {
+		var (
+			rsp response
+			ids []string
+		)
+		for {
+			vars := []client.Option{
+				client.Var("orderBy", orderBy),
+				client.Var("where", where),
+			}
+			if forward {
+				vars = append(vars, client.Var("after", rsp.Todos.PageInfo.EndCursor), client.Var("first", step))
+			} else {
+				vars = append(vars, client.Var("before", rsp.Todos.PageInfo.StartCursor), client.Var("last", step))
+			}
+			err := s.Post(query, &rsp, vars...)
+			s.Require().NoError(err)
+			page := make([]string, 0, len(rsp.Todos.Edges))
+			for _, e := range rsp.Todos.Edges {
+				page = append(page, e.Node.ID)
+			}
+			if forward {
+				ids = append(ids, page...)
+				if !rsp.Todos.PageInfo.HasNextPage {
+					return ids
+				}
+			} else {
+				ids = append(page, ids...)
+				if !rsp.Todos.PageInfo.HasPreviousPage {
+					return ids
+				}
+			}
+		}
+	}
+	s.Run("ChildrenCount", func() {
+		sort.Slice(todos, func(i, j int) bool {
+			if n, m := len(todos[i].Edges.Children), len(todos[j].Edges.Children); n != m {
+				return n > m
+			}
+			return todos[i].ID > todos[j].ID
+		})
+		expected := make([]string, len(todos))
+		for i, t := range todos {
+			expected[i] = strconv.Itoa(t.ID)
+		}
+		orderBy := []map[string]interface{}{
+			{"field": "CHILDREN_COUNT", "direction": "DESC"},
+		}
+		s.Require().Equal(expected, paginate(orderBy, nil, true))
+		s.Require().Equal(expected, paginate(orderBy, nil, false))
+	})
+	s.Run("ParentPriority", func() {
+		var expected []string
+		sort.Slice(todos, func(i, j int) bool {
+			if todos[i].Edges.Parent == nil || todos[j].Edges.Parent == nil {
+				return todos[j].Edges.Parent != nil
+			}
+			if p, q := todos[i].Edges.Parent.Priority, todos[j].Edges.Parent.Priority; p != q {
+				return p < q
+			}
+			return todos[i].ID < todos[j].ID
+		})
+		for _, t := range todos {
+			if t.Edges.Parent != nil {
+				expected = append(expected, strconv.Itoa(t.ID))
+			}
+		}
+		orderBy := []map[string]interface{}{
+			{"field": "PARENT_PRIORITY", "direction": "ASC"},
+		}
+		where := map[string]interface{}{"hasParent": true}
+		s.Require().Equal(expected, paginate(orderBy, where, true))
+		s.Require().Equal(expected, paginate(orderBy, where, false))
+	})
+	s.Run("ParentPriorityNull", func() {
+		// Todos without a parent are ordered by a NULL priority,
+		// which is the smallest value in SQLite.
+		sort.Slice(todos, func(i, j int) bool {
+			p, q := todos[i].Edges.Parent, todos[j].Edges.Parent
+			switch {
+			case p == nil && q == nil:
+				return todos[i].ID < todos[j].ID
+			case p == nil || q == nil:
+				return p == nil
+			case p.Priority != q.Priority:
+				return p.Priority < q.Priority
+			default:
+				return todos[i].ID < todos[j].ID
+			}
+		})
+		expected := make([]string, len(todos))
+		for i, t := range todos {
+			expected[i] = strconv.Itoa(t.ID)
+		}
+		orderBy := []map[string]interface{}{
+			{"field": "PARENT_PRIORITY", "direction": "ASC"},
+		}
+		s.Require().Equal(expected, paginate(orderBy, nil, true))
+		s.Require().Equal(expected, paginate(orderBy, nil, false))
+
+		for i, j := 0, len(expected)-1; i < j; i, j = i+1, j-1 {
+			expected[i], expected[j] = expected[j], expected[i]
+		}
+		orderBy[0]["direction"] = "DESC"
+		s.Require().Equal(expected, paginate(orderBy, nil, true))
+		s.Require().Equal(expected, paginate(orderBy, nil, false))
+	})
+}
+
+func (s *todoTestSuite) TestPaginationFiltering() {
+	const query = `query($where: TodoWhereInput) {
+		todos(where: $where) {
+			totalCount
+			edges {
+				node {
+					id
+				}
+			}
+		}
+	}`
+	s.Run("Fields", func() {
+		var rsp response
+		err := s.Post(query, &rsp, client.Var("where", map[string]interface{}{
+			"priorityGT": 16,
+			"textIn":     []string{"18", "19", "20", "32"},
+		}))
+		s.Require().NoError(err)
+		s.Require().Equal(4, rsp.Todos.TotalCount)
+	})
+	s.Run("Edges", func() {
+		var rsp response
+		err := s.Post(query, &rsp, client.Var("where", map[string]interface{}{
+			"priorityGT":    16,
+			"hasParentWith": []interface{}{map[string]interface{}{"id": 1}},
+		}))
+		s.Require().NoError(err)
+		s.Require().Equal(8, rsp.Todos.TotalCount)
+
+		err = s.Post(query, &rsp, client.Var("where", map[string]interface{}{
+			"not": map[string]interface{}{"hasParent": true},
+		}))
+		s.Require().NoError(err)
+		s.Require().Equal(1, rsp.Todos.TotalCount)
+	})
+	s.Run("Or", func() {
+		var rsp response
+		err := s.Post(query, &rsp, client.Var("where", map[string]interface{}{
+			"or": []interface{}{
+				map[string]interface{}{"id": 1},
+				map[string]interface{}{"textHasPrefix": "3"},
+			},
+		}))
+		s.Require().NoError(err)
+		s.Require().Equal(5, rsp.Todos.TotalCount)
+		s.Require().Len(rsp.Todos.Edges, 5)
+	})
+	s.Run("Empty", func() {
+		var rsp response
+		err := s.Post(query, &rsp, client.Var("where", map[string]interface{}{}))
+		s.Require().NoError(err)
+		s.Require().Equal(maxTodos, rsp.Todos.TotalCount)
+
+		err = s.Post(query, &rsp, client.Var("where", map[string]interface{}{
+			"priorityGT": 16,
+			"or": []interface{}{
+				map[string]interface{}{"id": 1},
+				map[string]interface{}{},
+			},
+			"and": []interface{}{map[string]interface{}{}},
+		}))
+		s.Require().NoError(err)
+		s.Require().Equal(maxTodos-16, rsp.Todos.TotalCount)
+
+		err = s.Post(query, &rsp, client.Var("where", map[string]interface{}{
+			"not": map[string]interface{}{},
+		}))
+		s.Require().EqualError(err, `[{"message":"empty predicate in TodoWhereInput.not","path":["todos"]}]`)
+	})
+	s.Run("EmptyList", func() {
+		var rsp response
+		err := s.Post(query, &rsp, client.Var("where", map[string]interface{}{"idIn": []int{}}))
+		s.Require().NoError(err)
+		s.Require().Zero(rsp.Todos.TotalCount, "empty in lists match no nodes")
+
+		err = s.Post(query, &rsp, client.Var("where", map[string]interface{}{"statusIn": []string{}}))
+		s.Require().NoError(err)
+		s.Require().Zero(rsp.Todos.TotalCount)
+
+		err = s.Post(query, &rsp, client.Var("where", map[string]interface{}{"idNotIn": []int{}}))
+		s.Require().NoError(err)
+		s.Require().Equal(maxTodos, rsp.Todos.TotalCount, "empty not-in lists match all nodes")
+	})
+}
+
+func (s *todoTestSuite) TestUpdateTodo() {
+	const mutation = `mutation($id: ID!, $input: UpdateTodoInput!) {
+		updateTodo(id: $id, input: $input) {
+			text
+			priority
+			parent {
+				id
+			}
+			children {
+				edges {
+					node {
+						id
+					}
+				}
+			}
+		}
+	}`
+	var rsp struct {
+		UpdateTodo struct {
+			Text     string
+			Priority int
+			Parent   *struct {
+				ID string
+			}
+			Children struct {
+				Edges []struct {
+					Node struct {
+						ID string
+					}
+				}
+			}
+		}
+	}
+	err := s.Post(mutation, &rsp,
+		client.Var("id", 3),
+		client.Var("input", map[string]interface{}{
+			"text":           "updated",
+			"clearParent":    true,
+			"removeChildIDs": []int{5},
+		}),
+	)
+	s.Require().NoError(err)
+	s.Require().Equal("updated", rsp.UpdateTodo.Text)
+	s.Require().Equal(3, rsp.UpdateTodo.Priority)
+	s.Require().Nil(rsp.UpdateTodo.Parent)
+	s.Require().Empty(rsp.UpdateTodo.Children.Edges)
+
+	err = s.Post(mutation, &rsp,
+		client.Var("id", 3),
+		client.Var("input", map[string]interface{}{
+			"priority":    100,
+			"parentID":    2,
+			"addChildIDs": []int{5},
+		}),
+	)
+	s.Require().NoError(err)
+	s.Require().Equal("updated", rsp.UpdateTodo.Text)
+	s.Require().Equal(100, rsp.UpdateTodo.Priority)
+	s.Require().NotNil(rsp.UpdateTodo.Parent)
+	s.Require().Equal("2", rsp.UpdateTodo.Parent.ID)
+	s.Require().Len(rsp.UpdateTodo.Children.Edges, 1)
+	s.Require().Equal("5", rsp.UpdateTodo.Children.Edges[0].Node.ID)
+}
+
+func (s *todoTestSuite) TestFieldsProjection() {
+	var (
+		mu      sync.Mutex
+		queries []string
+	)
+	ec := enttest.Open(s.T(), dialect.SQLite,
+		fmt.Sprintf("file:%s-%d?mode=memory&cache=shared&_fk=1",
+			s.T().Name(), time.Now().UnixNano(),
+		),
+		enttest.WithOptions(ent.Debug(), ent.Log(func(v ...interface{}) {
+			mu.Lock()
+			defer mu.Unlock()
+			queries = append(queries, fmt.Sprint(v...))
+		})),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	root := ec.Todo.Create().SetText("root").SetStatus(todo.StatusInProgress).SaveX(context.Background())
+	ec.Todo.Create().SetText("child").SetStatus(todo.StatusCompleted).SetPriority(1).SetParent(root).SaveX(context.Background())
+	srv := handler.New(gen.NewSchema(ec))
+	srv.AddTransport(transport.POST{})
+	gql := client.New(srv)
+
+	// selects returns the columns of the todos that were selected by the executed queries.
+	selects := func(query string, options ...client.Option) []string {
+		mu.Lock()
+		queries = nil
+		mu.Unlock()
+		var rsp map[string]interface{}
+		err := gql.Post(query, &rsp, options...)
+		s.Require().NoError(err)
+		var columns []string
+		for _, q := range queries {
+			if i := strings.Index(q, " FROM `todos`"); strings.Contains(q, "SELECT") && i != -1 {
+				q = q[:i]
+				for _, c := range []string{"id", "created_at", "status", "priority", "text", "todo_children"} {
+					if strings.Contains(q, "`todos`.`"+c+"`") {
+						columns = append(columns, c)
+					}
+				}
+				return columns
+			}
+		}
+		return nil
+	}
+
+	s.Require().Equal([]string{"id", "status"}, selects(`query {
+		todos {
+			edges { node { id status } }
+		}
+	}`))
+	s.Require().Equal([]string{"id", "priority", "text"}, selects(`query {
+		todos(orderBy: {direction: ASC, field: PRIORITY}) {
+			edges { node { text } }
+		}
+	}`), "order field is selected for the cursors")
+	s.Require().Equal([]string{"id", "todo_children"}, selects(`query {
+		todos {
+			edges { node { __typename parent { text } } }
+		}
+	}`), "foreign-keys are selected for the edges")
+	s.Require().Equal([]string{"id", "created_at"}, selects(`query($id: ID!) {
+		node(id: $id) {
+			... on Todo { createdAt }
+		}
+	}`, client.Var("id", root.ID)))
+}
+
+func (s *todoTestSuite) TestEdgeLoader() {
+	var (
+		mu      sync.Mutex
+		queries int
+	)
+	ec := enttest.Open(s.T(), dialect.SQLite,
+		fmt.Sprintf("file:%s-%d?mode=memory&cache=shared&_fk=1",
+			s.T().Name(), time.Now().UnixNano(),
+		),
+		enttest.WithOptions(ent.Debug(), ent.Log(func(v ...interface{}) {
+			if q := fmt.Sprint(v...); strings.Contains(q, "SELECT") {
+				mu.Lock()
+				defer mu.Unlock()
+				queries++
+			}
+		})),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	root := ec.Todo.Create().SetText("root").SetStatus(todo.StatusInProgress).SaveX(context.Background())
+
+	// post executes the mutation, and returns the number of executed select queries.
+	post := func(extensions ...graphql.HandlerExtension) int {
+		srv := handler.New(gen.NewSchema(ec))
+		srv.AddTransport(transport.POST{})
+		srv.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+			return next(ent.NewContext(ctx, ec))
+		})
+		for _, ext := range extensions {
+			srv.Use(ext)
+		}
+		mu.Lock()
+		queries = 0
+		mu.Unlock()
+		var rsp struct {
+			CreateTodos []struct {
+				Parent struct{ Text string }
+			}
+		}
+		inputs := make([]map[string]interface{}, 5)
+		for i := range inputs {
+			inputs[i] = map[string]interface{}{"status": "IN_PROGRESS", "text": strconv.Itoa(i), "parentID": root.ID}
+		}
+		err := client.New(srv).Post(`mutation($inputs: [CreateTodoInput!]!) {
+			createTodos(inputs: $inputs) { parent { text } }
+		}`, &rsp, client.Var("inputs", inputs))
+		s.Require().NoError(err)
+		s.Require().Len(rsp.CreateTodos, len(inputs))
+		for _, td := range rsp.CreateTodos {
+			s.Require().Equal("root", td.Parent.Text)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		return queries
+	}
+
+	s.Require().Equal(5, post(), "parents are queried for each todo")
+	s.Require().Equal(2, post(entgql.EdgeLoader{}), "todos are reloaded with their parents in a batch")
+
+	// Resolvers of transactional mutations are serialized, and their edges are not batched.
+	start := time.Now()
+	s.Require().Equal(5, post(entgql.Transactioner{TxOpener: ec}, entgql.EdgeLoader{Wait: time.Second}))
+	s.Require().Less(int64(time.Since(start)), int64(time.Second), "serial resolvers do not wait for batches")
+}
+
+func (s *todoTestSuite) TestCostLimit() {
+	srv := handler.New(gen.NewSchema(s.ent))
+	srv.AddTransport(transport.POST{})
+	srv.Use(entgql.CostLimit{Budget: 100, MaxDepth: 6, Edges: ent.EdgeCardinality})
+	gql := client.New(srv)
+
+	// errCost returns the error message and code of the given query.
+	errCost := func(query string, options ...client.Option) (string, interface{}) {
+		var rsp map[string]interface{}
+		err := gql.Post(query, &rsp, options...)
+		if err == nil {
+			return "", nil
+		}
+		var jerr client.RawJsonError
+		s.Require().True(errors.As(err, &jerr))
+		var errs gqlerror.List
+		s.Require().NoError(json.Unmarshal(jerr.RawMessage, &errs))
+		s.Require().Len(errs, 1)
+		return errs[0].Message, errs[0].Extensions["code"]
+	}
+
+	msg, _ := errCost(`query {
+		todos(first: 10) {
+			edges { node { id parent { id } } }
+		}
+	}`)
+	s.Require().Empty(msg, "10 todos, 10 edges and 10 parents")
+	msg, _ = errCost(`query($n: Int) {
+		todos(first: $n) {
+			edges { node { children(first: 3) { totalCount } } }
+		}
+	}`, client.Var("n", 2))
+	s.Require().Empty(msg, "bounded by variables")
+	msg, code := errCost(`query {
+		todos(first: 10) {
+			edges { node { children(first: 5) { edges { node { id } } } } }
+		}
+	}`)
+	s.Require().Equal("operation has cost 180, which exceeds the limit of 100", msg)
+	s.Require().Equal("COST_LIMIT_EXCEEDED", code)
+	msg, _ = errCost(`query {
+		todos {
+			edges { node { id } }
+		}
+	}`)
+	s.Require().Equal("operation has cost 300, which exceeds the limit of 100", msg, "unbounded connection")
+	msg, _ = errCost(`query {
+		todos(first: 1) {
+			edges { node { parent { parent { parent { parent { id } } } } } }
+		}
+	}`)
+	s.Require().Equal("operation has depth 7, which exceeds the limit of 6", msg)
+}
+
+func (s *todoTestSuite) TestPageSize() {
+	// errPagination returns the pagination error of the given query.
+	errPagination := func(query string) string {
+		var rsp map[string]interface{}
+		err := s.Post(query, &rsp)
+		s.Require().Error(err)
+		var jerr client.RawJsonError
+		s.Require().True(errors.As(err, &jerr))
+		var errs gqlerror.List
+		s.Require().NoError(json.Unmarshal(jerr.RawMessage, &errs))
+		s.Require().Len(errs, 1)
+		s.Require().Equal("INVALID_PAGINATION", errs[0].Extensions["code"])
+		return errs[0].Message
+	}
+	s.Require().Equal("`first` on a connection cannot be greater than 100.", errPagination(`query {
+		todos(first: 101) { totalCount }
+	}`))
+	s.Require().Equal("`last` on a connection cannot be greater than 50.", errPagination(`query {
+		todos(first: 1) {
+			edges { node { children(last: 51) { totalCount } } }
+		}
+	}`), "edge annotation overrides the type annotation")
+
+	builders := make([]*ent.TodoCreate, 100)
+	for i := range builders {
+		builders[i] = s.ent.Todo.Create().SetText("bulk").SetStatus(todo.StatusInProgress)
+	}
+	s.ent.Todo.CreateBulk(builders...).SaveX(context.Background())
+	var rsp response
+	err := s.Post(queryAll, &rsp)
+	s.Require().NoError(err)
+	s.Require().Equal(maxTodos+100, rsp.Todos.TotalCount)
+	s.Require().Len(rsp.Todos.Edges, 100, "unbounded queries are limited by the max page size")
+	s.Require().True(rsp.Todos.PageInfo.HasNextPage)
+}
+
+func (s *todoTestSuite) TestSubscriptions() {
+	srv := handler.New(gen.NewSchema(s.ent))
+	srv.AddTransport(transport.Websocket{})
+	srv.AddTransport(transport.POST{})
+	srv.Use(entgql.Transactioner{TxOpener: s.ent})
+	c := client.New(srv)
+
+	// next reads the next response of the subscription, and executes the mutation until
+	// it is received, as the subscription may be started after the first mutations.
+	next := func(query, mutation string, rsp interface{}) {
+		sub := c.Websocket(query)
+		defer sub.Close()
+		done := make(chan error, 1)
+		go func() { done <- sub.Next(rsp) }()
+		for {
+			var mrsp map[string]interface{}
+			s.Require().NoError(c.Post(mutation, &mrsp))
+			select {
+			case err := <-done:
+				s.Require().NoError(err)
+				return
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+	}
+
+	var updated struct {
+		TodoUpdated struct {
+			ID   string
+			Text string
+		}
+	}
+	next(`subscription { todoUpdated(id: 1) { id text } }`, `mutation {
+		updateTodo(id: 1, input: {text: "updated"}) { id }
+	}`, &updated)
+	s.Require().Equal("1", updated.TodoUpdated.ID)
+	s.Require().Equal("updated", updated.TodoUpdated.Text)
+
+	var changed struct {
+		TodosChanged struct {
+			Op   string
+			Node struct {
+				Text string
+			}
+		}
+	}
+	next(`subscription { todosChanged(where: {textHasPrefix: "match"}) { op node { text } } }`, `mutation {
+		skip: createTodo(input: {status: COMPLETED, text: "skip"}) { id }
+		match: createTodo(input: {status: COMPLETED, text: "match"}) { id }
+	}`, &changed)
+	s.Require().Equal("CREATED", changed.TodosChanged.Op)
+	s.Require().Equal("match", changed.TodosChanged.Node.Text)
+
+	var edges struct {
+		TodosChanged struct {
+			Node *struct {
+				Text   string
+				Parent *struct {
+					Text string
+				}
+				Children struct {
+					TotalCount int
+				}
+			}
+		}
+	}
+	next(`subscription { todosChanged { node { text parent { text } children { totalCount } } } }`, `mutation {
+		createTodo(input: {status: COMPLETED, text: "child", parentID: 1}) { id }
+	}`, &edges)
+	s.Require().NotNil(edges.TodosChanged.Node, "edges are loaded after the commit of the mutation")
+	s.Require().Equal("child", edges.TodosChanged.Node.Text)
+	s.Require().NotNil(edges.TodosChanged.Node.Parent)
+	s.Require().Equal("updated", edges.TodosChanged.Node.Parent.Text)
+	s.Require().Zero(edges.TodosChanged.Node.Children.TotalCount)
+}
+
+func (s *todoTestSuite) TestSubscriptionSavepoints() {
+	ctx := context.Background()
+	ec := enttest.Open(s.T(), dialect.SQLite,
+		fmt.Sprintf("file:%s-%d?mode=memory&cache=shared&_fk=1", s.T().Name(), time.Now().UnixNano()),
+	)
+	defer ec.Close()
+	// Deletions fail after they were executed, and are rolled back to their savepoints.
+	// The hook is registered before the broker, so the deletions emit their events.
+	ec.Todo.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			if err == nil && m.Op().Is(ent.OpDelete) {
+				return nil, errors.New("deletion failed")
+			}
+			return v, err
+		})
+	})
+	td := ec.Todo.Create().SetText("todo").SetStatus(todo.StatusCompleted).SaveX(ctx)
+	srv := handler.New(gen.NewSchema(ec))
+	srv.AddTransport(transport.Websocket{})
+	srv.AddTransport(transport.POST{})
+	srv.Use(entgql.Transactioner{TxOpener: ec, Savepoints: true})
+	c := client.New(srv)
+
+	var rsp struct {
+		TodosChanged struct {
+			Op   string
+			Node *struct{ Text string }
+		}
+	}
+	sub := c.Websocket(`subscription { todosChanged { op node { text } } }`)
+	defer sub.Close()
+	done := make(chan error, 1)
+	go func() { done <- sub.Next(&rsp) }()
+	mutation := fmt.Sprintf(`mutation {
+		deleteTodos(where: {id: %d})
+		createTodo(input: {status: COMPLETED, text: "created"}) { id }
+	}`, td.ID)
+	for {
+		// The subscription may be started after the first mutations.
+		err := c.Post(mutation, &struct{}{})
+		s.Require().Error(err)
+		s.Require().Contains(err.Error(), "deletion failed")
+		select {
+		case err := <-done:
+			s.Require().NoError(err)
+			s.Require().Equal("CREATED", rsp.TodosChanged.Op, "events rolled back to a savepoint are not published")
+			s.Require().Equal("created", rsp.TodosChanged.Node.Text)
+			s.Require().True(ec.Todo.Query().Where(todo.ID(td.ID)).ExistX(ctx))
+			return
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func (s *todoTestSuite) TestErrorPresenter() {
+	// errExtensions returns the message and the extensions of the error of the given mutation.
+	errExtensions := func(presenter graphql.ErrorPresenterFunc, mutation string) (string, map[string]interface{}) {
+		srv := handler.New(gen.NewSchema(s.ent))
+		srv.AddTransport(transport.POST{})
+		srv.Use(entgql.Transactioner{TxOpener: s.ent})
+		srv.SetErrorPresenter(presenter)
+		var rsp map[string]interface{}
+		err := client.New(srv).Post(mutation, &rsp)
+		var jerr client.RawJsonError
+		s.Require().True(errors.As(err, &jerr))
+		var errs gqlerror.List
+		s.Require().NoError(json.Unmarshal(jerr.RawMessage, &errs))
+		s.Require().Len(errs, 1)
+		return errs[0].Message, errs[0].Extensions
+	}
+	const (
+		invalid  = `mutation { createTodo(input: {status: COMPLETED, text: ""}) { id } }`
+		notFound = `mutation { updateTodo(id: 1000, input: {text: "text"}) { id } }`
+	)
+	msg, ext := errExtensions(entgql.ErrorPresenter, invalid)
+	s.Require().Contains(msg, `validator failed for field "text"`)
+	s.Require().Equal(map[string]interface{}{"code": "VALIDATION_FAILED", "field": "text"}, ext)
+	msg, ext = errExtensions(entgql.ProductionErrorPresenter, invalid)
+	s.Require().Equal("Invalid value of field 'text'", msg)
+	s.Require().Equal("VALIDATION_FAILED", ext["code"])
+
+	msg, ext = errExtensions(entgql.ErrorPresenter, notFound)
+	s.Require().Equal("ent: todo not found", msg)
+	s.Require().Equal("NOT_FOUND", ext["code"])
+	msg, _ = errExtensions(entgql.ProductionErrorPresenter, notFound)
+	s.Require().Equal("Could not resolve to a node", msg)
+
+	err := &ent.ValidationError{Name: todo.FieldCreatedAt}
+	s.Require().Equal("createdAt", err.Extensions()["field"], "fields are reported by their graphql names")
+}
@@ -0,0 +1,98 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/todoglobalid/ent"
+	"entgo.io/contrib/entgql/internal/todoglobalid/ent/enttest"
+	"entgo.io/contrib/entgql/internal/todoglobalid/ent/todo"
+	"entgo.io/ent/dialect"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestGlobalIDs(t *testing.T) {
+	ec := enttest.Open(t, dialect.SQLite, "file:ent?mode=memory&cache=shared&_fk=1")
+	defer ec.Close()
+	ctx := context.Background()
+	td := ec.Todo.Create().SetText("todo").SetStatus(todo.StatusInProgress).SaveX(ctx)
+	cat := ec.Category.Create().SetName("category").AddTodos(td).SaveX(ctx)
+	catID, tdID := cat.GlobalID(), td.GlobalID()
+	require.Equal(t, entgql.EncodeGlobalID("Category", cat.ID), catID)
+	require.Equal(t, entgql.EncodeGlobalID("Todo", td.ID), tdID)
+
+	t.Run("Node", func(t *testing.T) {
+		n, err := ec.Node(ctx, catID)
+		require.NoError(t, err)
+		require.Equal(t, catID, n.ID)
+		require.Equal(t, "Category", n.Type)
+		require.Len(t, n.Edges, 1)
+		require.Equal(t, []string{tdID}, n.Edges[0].IDs)
+	})
+	t.Run("Noder", func(t *testing.T) {
+		noder, err := ec.Noder(ctx, catID)
+		require.NoError(t, err)
+		require.Equal(t, cat.ID, noder.(*ent.Category).ID)
+		noder, err = ec.Noder(ctx, tdID)
+		require.NoError(t, err)
+		require.Equal(t, td.ID, noder.(*ent.Todo).ID)
+
+		for _, id := range []string{
+			"invalid",
+			entgql.EncodeGlobalID("User", td.ID),
+			entgql.EncodeGlobalID("Todo", cat.ID),
+			entgql.EncodeGlobalID("Category", td.ID),
+		} {
+			_, err = ec.Noder(ctx, id)
+			require.True(t, ent.IsNotFound(err), id)
+		}
+	})
+	t.Run("Noders", func(t *testing.T) {
+		noders, err := ec.Noders(ctx, []string{tdID, catID, tdID})
+		require.NoError(t, err)
+		require.Len(t, noders, 3)
+		require.Equal(t, td.ID, noders[0].(*ent.Todo).ID)
+		require.Equal(t, cat.ID, noders[1].(*ent.Category).ID)
+		require.Equal(t, td.ID, noders[2].(*ent.Todo).ID)
+	})
+	t.Run("GraphQL", func(t *testing.T) {
+		// roundTrip marshals the id with the given marshaler, and
+		// unmarshals it as the argument of a GraphQL query.
+		roundTrip := func(m graphql.Marshaler) (string, error) {
+			var buf bytes.Buffer
+			m.MarshalGQL(&buf)
+			var v interface{}
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &v))
+			return entgql.UnmarshalGlobalID(v)
+		}
+		for _, m := range []graphql.Marshaler{entgql.MarshalGlobalID(tdID), graphql.MarshalID(tdID)} {
+			gid, err := roundTrip(m)
+			require.NoError(t, err)
+			noder, err := ec.Noder(ctx, gid)
+			require.NoError(t, err)
+			require.Equal(t, td.ID, noder.(*ent.Todo).ID)
+		}
+		_, err := roundTrip(graphql.MarshalIntID(cat.ID))
+		require.Error(t, err, "local ids of inputs are not global ids")
+	})
+}
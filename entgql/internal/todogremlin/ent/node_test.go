@@ -0,0 +1,164 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent_test
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"entgo.io/contrib/entgql/internal/todogremlin/ent"
+	"entgo.io/contrib/entgql/internal/todogremlin/ent/todo"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/gremlin"
+	"github.com/stretchr/testify/require"
+)
+
+// driver records the executed traversals, and responds with the given GraphSON data.
+type driver struct {
+	queries   []string
+	responses []string
+}
+
+func (d *driver) Exec(_ context.Context, query string, _, v interface{}) error {
+	d.queries = append(d.queries, query)
+	res := v.(*gremlin.Response)
+	res.Status.Code = gremlin.StatusSuccess
+	if len(d.responses) > 0 {
+		res.Result.Data = []byte(d.responses[0])
+		d.responses = d.responses[1:]
+	}
+	return nil
+}
+
+func (d *driver) Query(ctx context.Context, query string, args, v interface{}) error {
+	return d.Exec(ctx, query, args, v)
+}
+
+func (d *driver) Tx(context.Context) (dialect.Tx, error) { return dialect.NopTx(d), nil }
+func (*driver) Close() error                             { return nil }
+func (*driver) Dialect() string                          { return dialect.Gremlin }
+
+func list(values ...string) string {
+	return `{"@type": "g:List", "@value": [` + strings.Join(values, ", ") + `]}`
+}
+
+func count(n int) string {
+	return list(`{"@type": "g:Int64", "@value": ` + strconv.Itoa(n) + `}`)
+}
+
+func labels(names ...string) string {
+	for i := range names {
+		names[i] = strconv.Quote(names[i])
+	}
+	return list(names...)
+}
+
+func vertex(id, text string) string {
+	return `{"@type": "g:Map", "@value": [` +
+		`"id", ` + strconv.Quote(id) + `, ` +
+		`"text", ` + list(strconv.Quote(text)) + `, ` +
+		`"status", ` + list(strconv.Quote(todo.StatusInProgress.String())) +
+		`]}`
+}
+
+func TestNoder(t *testing.T) {
+	ctx := context.Background()
+	drv := &driver{}
+	ec := ent.NewClient(ent.Driver(drv))
+
+	t.Run("Label", func(t *testing.T) {
+		drv.queries, drv.responses = nil, []string{
+			labels(todo.Label),
+			list(vertex("1", "todo")),
+		}
+		noder, err := ec.Noder(ctx, "1")
+		require.NoError(t, err)
+		require.Equal(t, "1", noder.(*ent.Todo).ID)
+		require.Equal(t, "todo", noder.(*ent.Todo).Text)
+		require.Equal(t, "g.V($0).label()", drv.queries[0])
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		drv.queries, drv.responses = nil, []string{labels()}
+		_, err := ec.Noder(ctx, "1")
+		require.True(t, ent.IsNotFound(err))
+		require.Len(t, drv.queries, 1)
+	})
+	t.Run("FixedType", func(t *testing.T) {
+		drv.queries, drv.responses = nil, []string{
+			list(vertex("1", "todo")),
+		}
+		noder, err := ec.Noder(ctx, "1", ent.WithFixedNodeType(todo.Label))
+		require.NoError(t, err)
+		require.Equal(t, "1", noder.(*ent.Todo).ID)
+		require.Len(t, drv.queries, 1)
+	})
+	t.Run("Noders", func(t *testing.T) {
+		drv.queries, drv.responses = nil, []string{
+			list(`{"@type": "g:Map", "@value": [` + strconv.Quote(todo.Label) + `, ` + list(`"1"`, `"2"`) + `]}`),
+			list(vertex("2", "second"), vertex("1", "first")),
+		}
+		noders, err := ec.Noders(ctx, []string{"1", "2"})
+		require.NoError(t, err)
+		require.Len(t, noders, 2)
+		require.Equal(t, "first", noders[0].(*ent.Todo).Text)
+		require.Equal(t, "second", noders[1].(*ent.Todo).Text)
+		require.Len(t, drv.queries, 2, "labels are resolved in a single traversal")
+		require.Equal(t, "g.V($0, $1).group().by(__.label()).by(__.id().fold())", drv.queries[0])
+	})
+}
+
+func TestOpenTx(t *testing.T) {
+	ec := ent.NewClient(ent.Driver(&driver{}))
+	_, _, err := ec.OpenTx(context.Background())
+	require.Error(t, err, "gremlin driver does not support transactions")
+}
+
+func TestPaginate(t *testing.T) {
+	ctx := context.Background()
+	drv := &driver{}
+	ec := ent.NewClient(ent.Driver(drv))
+	first := 1
+
+	t.Run("ID", func(t *testing.T) {
+		drv.queries, drv.responses = nil, []string{
+			count(2),
+			list(vertex("2", "second"), vertex("3", "third")),
+		}
+		conn, err := ec.Todo.Query().Paginate(ctx, &ent.Cursor{ID: "1", Order: "id ASC"}, &first, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, 2, conn.TotalCount)
+		require.True(t, conn.PageInfo.HasNextPage)
+		require.Len(t, conn.Edges, 1)
+		require.Equal(t, "2", conn.Edges[0].Node.ID)
+		require.Len(t, drv.queries, 2)
+		require.Equal(t, "g.V().hasLabel($0).where(__.has(T.id, gt($1))).order().by(T.id, incr).limit($2).dedup().valueMap($3)", drv.queries[1])
+	})
+	t.Run("Field", func(t *testing.T) {
+		drv.queries, drv.responses = nil, []string{
+			count(2),
+			list(vertex("2", "second")),
+		}
+		order := []*ent.TodoOrder{{Direction: ent.OrderDirectionDesc, Field: ent.TodoOrderFieldText}}
+		after := ent.Cursor{ID: "1", Values: []ent.Value{"first"}, Order: "text DESC,id DESC"}
+		conn, err := ec.Todo.Query().Paginate(ctx, &after, &first, nil, nil, ent.WithTodoOrder(order))
+		require.NoError(t, err)
+		require.False(t, conn.PageInfo.HasNextPage)
+		require.Len(t, conn.Edges, 1)
+		require.Equal(t, []ent.Value{"second"}, conn.Edges[0].Cursor.Values)
+		require.Equal(t, "g.V().hasLabel($0).or(__.and(__.has($1, lt($2))), __.and(__.has($3, eq($4)), __.has(T.id, lt($5)))).order().by($6, decr).by(T.id, decr).limit($7).dedup().valueMap($8)", drv.queries[1])
+	})
+}
@@ -0,0 +1,152 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ent_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"entgo.io/contrib/entgql/internal/todomixed/ent"
+	"entgo.io/contrib/entgql/internal/todomixed/ent/category"
+	"entgo.io/contrib/entgql/internal/todomixed/ent/enttest"
+	"entgo.io/contrib/entgql/internal/todomixed/ent/migrate"
+	"entgo.io/contrib/entgql/internal/todomixed/ent/todo"
+	"entgo.io/ent/dialect"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestMixedNodeIDs(t *testing.T) {
+	ec := enttest.Open(t, dialect.SQLite, "file:ent?mode=memory&cache=shared&_fk=1",
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	defer ec.Close()
+	ctx := context.Background()
+	td := ec.Todo.Create().SetText("todo").SetStatus(todo.StatusInProgress).SaveX(ctx)
+	cat := ec.Category.Create().SetName("category").SetSecret("secret").SetNotes("notes").AddTodos(td).SaveX(ctx)
+	catID, tdID := strconv.Itoa(cat.ID), td.ID.String()
+
+	t.Run("Node", func(t *testing.T) {
+		n, err := ec.Node(ctx, catID)
+		require.NoError(t, err)
+		require.Equal(t, catID, n.ID)
+		require.Equal(t, "Category", n.Type)
+		require.Len(t, n.Fields, 1, "sensitive and skipped fields are omitted")
+		require.Equal(t, "name", n.Fields[0].Name)
+		require.Len(t, n.Edges, 1, "skipped edges are omitted")
+		require.Equal(t, []string{tdID}, n.Edges[0].IDs)
+	})
+	t.Run("Noder", func(t *testing.T) {
+		noder, err := ec.Noder(ctx, catID)
+		require.NoError(t, err)
+		require.Equal(t, cat.ID, noder.(*ent.Category).ID)
+
+		_, err = ec.Noder(ctx, tdID)
+		require.Error(t, err, "uuids cannot be resolved by the universal-id ranges")
+		noder, err = ec.Noder(ctx, tdID, ent.WithFixedNodeType(todo.Table))
+		require.NoError(t, err)
+		require.Equal(t, td.ID, noder.(*ent.Todo).ID)
+
+		_, err = ec.Noder(ctx, "invalid", ent.WithFixedNodeType(todo.Table))
+		require.True(t, ent.IsNotFound(err))
+	})
+	t.Run("NodeQuery", func(t *testing.T) {
+		// nodeType resolves the tables of the ids in the node(id:) resolver,
+		// as the UUIDs of todos are not resolved by the universal-id ranges.
+		nodeType := ent.WithNodeType(func(_ context.Context, id string) (string, error) {
+			if _, err := uuid.Parse(id); err == nil {
+				return todo.Table, nil
+			}
+			return category.Table, nil
+		})
+		_, err := ec.Node(ctx, tdID)
+		require.EqualError(t, err, fmt.Sprintf("cannot resolve noder (%s) without its type: non-integer ids require the WithNodeType option", tdID))
+		n, err := ec.Node(ctx, tdID, nodeType)
+		require.NoError(t, err)
+		require.Equal(t, tdID, n.ID)
+		require.Equal(t, "Todo", n.Type)
+		n, err = ec.Node(ctx, catID, nodeType)
+		require.NoError(t, err)
+		require.Equal(t, catID, n.ID)
+		require.Equal(t, "Category", n.Type)
+	})
+	t.Run("Noders", func(t *testing.T) {
+		noders, err := ec.Noders(ctx, []string{tdID, catID, tdID}, ent.WithNodeType(func(_ context.Context, id string) (string, error) {
+			if id == tdID {
+				return todo.Table, nil
+			}
+			return category.Table, nil
+		}))
+		require.NoError(t, err)
+		require.Len(t, noders, 3)
+		require.Equal(t, td.ID, noders[0].(*ent.Todo).ID)
+		require.Equal(t, cat.ID, noders[1].(*ent.Category).ID)
+		require.Equal(t, td.ID, noders[2].(*ent.Todo).ID)
+	})
+	t.Run("Entities", func(t *testing.T) {
+		ctx := graphql.WithResponseContext(ctx, graphql.DefaultErrorPresenter, graphql.DefaultRecover)
+		noders, err := ec.Entities(ctx, []map[string]interface{}{
+			{"__typename": "Category", "name": "category"},
+			{"__typename": "Todo", "id": tdID},
+			{"__typename": "Category", "name": "unknown"},
+		})
+		require.NoError(t, err)
+		require.Len(t, noders, 3)
+		require.Equal(t, cat.ID, noders[0].(*ent.Category).ID)
+		require.Equal(t, td.ID, noders[1].(*ent.Todo).ID)
+		require.Nil(t, noders[2])
+		errs := graphql.GetErrors(ctx)
+		require.Len(t, errs, 1)
+		require.Equal(t, "Could not resolve to a Category entity with the key {name: unknown}", errs[0].Message)
+
+		_, err = ec.Entities(ctx, []map[string]interface{}{{"id": tdID}})
+		require.Error(t, err, "representations must have a type")
+	})
+	t.Run("Cursor", func(t *testing.T) {
+		tdEdge, err := td.ToEdge(nil)
+		require.NoError(t, err)
+		require.Equal(t, tdID, tdEdge.Cursor.ID)
+		catEdge, err := cat.ToEdge(nil)
+		require.NoError(t, err)
+		require.Equal(t, catID, catEdge.Cursor.ID)
+	})
+	t.Run("Paginate", func(t *testing.T) {
+		td2 := ec.Todo.Create().SetText("todo2").SetStatus(todo.StatusCompleted).SaveX(ctx)
+		first := 1
+		conn, err := ec.Todo.Query().Paginate(ctx, nil, &first, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, conn.Edges, 1)
+		require.True(t, conn.PageInfo.HasNextPage)
+		conn, err = ec.Todo.Query().Paginate(ctx, conn.PageInfo.EndCursor, &first, nil, nil)
+		require.NoError(t, err, "string cursor ids are parsed to uuids")
+		require.Len(t, conn.Edges, 1)
+		require.Contains(t, []uuid.UUID{td.ID, td2.ID}, conn.Edges[0].Node.ID)
+
+		catEdge, err := cat.ToEdge(nil)
+		require.NoError(t, err)
+		c := ent.Cursor{ID: catEdge.Cursor.ID, Order: catEdge.Cursor.Order, Values: catEdge.Cursor.Values}
+		conn2, err := ec.Category.Query().Paginate(ctx, &c, &first, nil, nil)
+		require.NoError(t, err, "string cursor ids are parsed to ints")
+		require.Empty(t, conn2.Edges)
+		c.ID = "invalid"
+		_, err = ec.Category.Query().Paginate(ctx, &c, &first, nil, nil)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,64 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Category defines the category type schema. Unlike todos, categories
+// are keyed by integer ids, and federated entities are keyed by name.
+type Category struct {
+	ent.Schema
+}
+
+// Fields returns category fields.
+func (Category) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			Unique().
+			Annotations(
+				entgql.OrderField("NAME"),
+			),
+		field.String("secret").
+			Optional().
+			Sensitive(),
+		field.Text("notes").
+			Optional().
+			Annotations(entgql.Skip()),
+	}
+}
+
+// Annotations returns category annotations.
+func (Category) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Key("name"),
+	}
+}
+
+// Edges returns category edges.
+func (Category) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("todos", Todo.Type).
+			Annotations(entgql.Bind()),
+		edge.To("archived", Todo.Type).
+			Annotations(entgql.Skip()),
+	}
+}
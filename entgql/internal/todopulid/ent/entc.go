@@ -16,7 +16,7 @@ import (
 )
 
 func main() {
-	templates := entgql.AllTemplates
+	templates := append(entgql.AllTemplates, entgql.EventTemplate, entgql.FederationTemplate)
 
 	templates = append(templates, gen.MustParse(
 		gen.NewTemplate("pulid.tmpl").
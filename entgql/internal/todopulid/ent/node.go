@@ -24,6 +24,7 @@ import (
 	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todopulid/ent/schema/pulid"
 	"entgo.io/contrib/entgql/internal/todopulid/ent/todo"
+	"entgo.io/ent/dialect/sql"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/hashicorp/go-multierror"
 )
@@ -59,67 +60,107 @@ func (t *Todo) Node(ctx context.Context) (node *Node, err error) {
 	node = &Node{
 		ID:     t.ID,
 		Type:   "Todo",
-		Fields: make([]*Field, 4),
+		Fields: make([]*Field, 0, 4),
 		Edges:  make([]*Edge, 2),
 	}
 	var buf []byte
 	if buf, err = json.Marshal(t.CreatedAt); err != nil {
 		return nil, err
 	}
-	node.Fields[0] = &Field{
+	node.Fields = append(node.Fields, &Field{
 		Type:  "time.Time",
 		Name:  "created_at",
 		Value: string(buf),
-	}
+	})
 	if buf, err = json.Marshal(t.Status); err != nil {
 		return nil, err
 	}
-	node.Fields[1] = &Field{
+	node.Fields = append(node.Fields, &Field{
 		Type:  "todo.Status",
 		Name:  "status",
 		Value: string(buf),
-	}
+	})
 	if buf, err = json.Marshal(t.Priority); err != nil {
 		return nil, err
 	}
-	node.Fields[2] = &Field{
+	node.Fields = append(node.Fields, &Field{
 		Type:  "int",
 		Name:  "priority",
 		Value: string(buf),
-	}
+	})
 	if buf, err = json.Marshal(t.Text); err != nil {
 		return nil, err
 	}
-	node.Fields[3] = &Field{
+	node.Fields = append(node.Fields, &Field{
 		Type:  "string",
 		Name:  "text",
 		Value: string(buf),
+	})
+	loaded, err := t.nodeEdges(ctx)
+	if err != nil {
+		return nil, err
 	}
 	node.Edges[0] = &Edge{
 		Type: "Todo",
 		Name: "parent",
 	}
-	err = t.QueryParent().
-		Select(todo.FieldID).
-		Scan(ctx, &node.Edges[0].IDs)
-	if err != nil {
-		return nil, err
+	if n := loaded.Edges.Parent; n != nil {
+		node.Edges[0].IDs = []pulid.ID{n.ID}
 	}
 	node.Edges[1] = &Edge{
 		Type: "Todo",
 		Name: "children",
 	}
-	err = t.QueryChildren().
-		Select(todo.FieldID).
-		Scan(ctx, &node.Edges[1].IDs)
+	node.Edges[1].IDs = make([]pulid.ID, len(loaded.Edges.Children))
+	for j, n := range loaded.Edges.Children {
+		node.Edges[1].IDs[j] = n.ID
+	}
+	return node, nil
+}
+
+// nodeEdges returns the Todo with the ids of its edges loaded for the Node API, in a
+// batch with the other nodes of the response if an entgql.EdgeLoader was installed.
+func (t *Todo) nodeEdges(ctx context.Context) (*Todo, error) {
+	load := func(ctx context.Context, keys []interface{}) (map[interface{}]interface{}, error) {
+		query := (&TodoClient{config: t.config}).Query().
+			Where(func(s *sql.Selector) {
+				s.Where(sql.In(s.C(todo.FieldID), keys...))
+			})
+		query.fields = []string{todo.FieldID}
+		query.WithParent(func(q *TodoQuery) {
+			q.fields = []string{todo.FieldID}
+		})
+		query.WithChildren(func(q *TodoQuery) {
+			q.fields = []string{todo.FieldID}
+		})
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		values := make(map[interface{}]interface{}, len(nodes))
+		for _, n := range nodes {
+			values[n.ID] = n
+		}
+		return values, nil
+	}
+	v, ok, err := entgql.LoadEdge(ctx, "Todo.node", t.ID, load)
+	if err == nil && !ok {
+		var values map[interface{}]interface{}
+		values, err = load(ctx, []interface{}{t.ID})
+		v = values[t.ID]
+	}
 	if err != nil {
 		return nil, err
 	}
+	node, _ := v.(*Todo)
+	if node == nil {
+		return nil, &NotFoundError{todo.Label}
+	}
 	return node, nil
 }
 
-func (c *Client) Node(ctx context.Context, id pulid.ID) (*Node, error) {
-	n, err := c.Noder(ctx, id)
+func (c *Client) Node(ctx context.Context, id pulid.ID, opts ...NodeOption) (*Node, error) {
+	n, err := c.Noder(ctx, id, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -18,16 +18,18 @@ package ent
 
 import (
 	"context"
-	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
 	"strconv"
 	"strings"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todopulid/ent/schema/pulid"
 	"entgo.io/contrib/entgql/internal/todopulid/ent/todo"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/errcode"
 	"github.com/vektah/gqlparser/v2/gqlerror"
@@ -86,67 +88,127 @@ func (o OrderDirection) orderFunc(field string) OrderFunc {
 	return Asc(field)
 }
 
-func cursorsToPredicates(direction OrderDirection, after, before *Cursor, field, idField string) []func(s *sql.Selector) {
+// orderTerm is a single column of a pagination order.
+type orderTerm struct {
+	column    func(*sql.Selector) string
+	direction OrderDirection
+	// nullable reports if the column may hold NULL values.
+	nullable bool
+}
+
+func (o OrderDirection) orderExpr(expr func(*sql.Selector) string) OrderFunc {
+	return func(s *sql.Selector) {
+		if o == OrderDirectionDesc {
+			s.OrderBy(sql.Desc(expr(s)))
+		} else {
+			s.OrderBy(sql.Asc(expr(s)))
+		}
+	}
+}
+
+// subqueryExpr returns the given sub-query as an expression of the selector. The errors
+// of the sub-query, and arguments that cannot be embedded in the expression, are added
+// to the selector, and fail its query.
+func subqueryExpr(s, sub *sql.Selector) string {
+	query, args := sub.Query()
+	if err := sub.Err(); err != nil {
+		s.AddError(err)
+	} else if len(args) > 0 {
+		s.AddError(fmt.Errorf("ent: sub-query %q has arguments", query))
+	}
+	return "(" + query + ")"
+}
+
+func cursorsToPredicates(terms []orderTerm, key string, after, before *Cursor) ([]func(s *sql.Selector), error) {
 	var predicates []func(s *sql.Selector)
 	if after != nil {
-		if after.Value != nil {
-			var predicate func([]string, ...interface{}) *sql.Predicate
-			if direction == OrderDirectionAsc {
-				predicate = sql.CompositeGT
-			} else {
-				predicate = sql.CompositeLT
-			}
-			predicates = append(predicates, func(s *sql.Selector) {
-				s.Where(predicate(
-					s.Columns(field, idField),
-					after.Value, after.ID,
-				))
-			})
-		} else {
-			var predicate func(string, interface{}) *sql.Predicate
-			if direction == OrderDirectionAsc {
-				predicate = sql.GT
-			} else {
-				predicate = sql.LT
-			}
-			predicates = append(predicates, func(s *sql.Selector) {
-				s.Where(predicate(
-					s.C(idField),
-					after.ID,
-				))
-			})
+		values, err := after.values(terms, key)
+		if err != nil {
+			return nil, entgql.ErrInvalidCursor(err)
 		}
+		predicates = append(predicates, cursorPredicate(terms, values, true))
 	}
 	if before != nil {
-		if before.Value != nil {
-			var predicate func([]string, ...interface{}) *sql.Predicate
-			if direction == OrderDirectionAsc {
-				predicate = sql.CompositeLT
-			} else {
-				predicate = sql.CompositeGT
-			}
-			predicates = append(predicates, func(s *sql.Selector) {
-				s.Where(predicate(
-					s.Columns(field, idField),
-					before.Value, before.ID,
-				))
-			})
-		} else {
-			var predicate func(string, interface{}) *sql.Predicate
-			if direction == OrderDirectionAsc {
-				predicate = sql.LT
-			} else {
-				predicate = sql.GT
+		values, err := before.values(terms, key)
+		if err != nil {
+			return nil, entgql.ErrInvalidCursor(err)
+		}
+		predicates = append(predicates, cursorPredicate(terms, values, false))
+	}
+	return predicates, nil
+}
+
+// cursorPredicate returns the keyset predicate for selecting the rows that come
+// after (forward) or before the given cursor values, according to the order terms.
+func cursorPredicate(terms []orderTerm, values []interface{}, forward bool) func(s *sql.Selector) {
+	greater := func(t orderTerm) bool {
+		return (t.direction == OrderDirectionAsc) == forward
+	}
+	mixed, nullable := false, false
+	for _, t := range terms[1:] {
+		mixed = mixed || t.direction != terms[0].direction
+	}
+	for _, t := range terms {
+		nullable = nullable || t.nullable
+	}
+	return func(s *sql.Selector) {
+		columns := make([]string, len(terms))
+		for i, t := range terms {
+			columns[i] = t.column(s)
+		}
+		switch {
+		case len(terms) == 1 && greater(terms[0]):
+			s.Where(sql.GT(columns[0], values[0]))
+		case len(terms) == 1:
+			s.Where(sql.LT(columns[0], values[0]))
+		case !mixed && !nullable && greater(terms[0]):
+			s.Where(sql.CompositeGT(columns, values...))
+		case !mixed && !nullable:
+			s.Where(sql.CompositeLT(columns, values...))
+		default:
+			// (c1 > v1) OR (c1 = v1 AND c2 < v2) OR (c1 = v1 AND c2 = v2 AND c3 > v3) ...
+			nullsLargest := s.Dialect() == dialect.Postgres
+			or := make([]*sql.Predicate, 0, len(terms))
+			for i, t := range terms {
+				p := termPredicate(columns[i], values[i], greater(t), t.nullable, nullsLargest)
+				if p == nil {
+					continue
+				}
+				and := make([]*sql.Predicate, 0, i+1)
+				for j := 0; j < i; j++ {
+					if terms[j].nullable && values[j] == nil {
+						and = append(and, sql.IsNull(columns[j]))
+					} else {
+						and = append(and, sql.EQ(columns[j], values[j]))
+					}
+				}
+				or = append(or, sql.And(append(and, p)...))
 			}
-			predicates = append(predicates, func(s *sql.Selector) {
-				s.Where(predicate(
-					s.C(idField),
-					before.ID,
-				))
-			})
+			s.Where(sql.Or(or...))
 		}
 	}
-	return predicates
+}
+
+// termPredicate returns the predicate for selecting the rows whose column value is greater
+// or less than the given value, or nil if no such rows exist. NULL values are ordered as the
+// largest values in PostgreSQL, and as the smallest values in the other dialects.
+func termPredicate(column string, value interface{}, greater, nullable, nullsLargest bool) *sql.Predicate {
+	p := sql.LT
+	if greater {
+		p = sql.GT
+	}
+	switch {
+	case !nullable:
+		return p(column, value)
+	case value == nil && greater == nullsLargest:
+		return nil
+	case value == nil:
+		return sql.NotNull(column)
+	case greater == nullsLargest:
+		return sql.Or(p(column, value), sql.IsNull(column))
+	default:
+		return p(column, value)
+	}
 }
 
 // PageInfo of a connection type.
@@ -159,33 +221,98 @@ type PageInfo struct {
 
 // Cursor of an edge type.
 type Cursor struct {
-	ID    pulid.ID `msgpack:"i"`
-	Value Value    `msgpack:"v,omitempty"`
+	ID     pulid.ID `msgpack:"i"`
+	Values []Value  `msgpack:"v,omitempty"`
+	Order  string   `msgpack:"o,omitempty"`
+	// raw holds the encoded form of the cursor. It is set when the cursor is
+	// unmarshaled from GraphQL, and when it is encoded by the client codec.
+	raw string
 }
 
-// MarshalGQL implements graphql.Marshaler interface.
+// values returns the cursor values matching the order terms.
+// The last term is always the ID column.
+func (c *Cursor) values(terms []orderTerm, key string) ([]interface{}, error) {
+	if c.Order != key {
+		return nil, fmt.Errorf("cursor order %q does not match the pagination order %q", c.Order, key)
+	}
+	if len(c.Values) != len(terms)-1 {
+		return nil, fmt.Errorf("cursor has %d order values, expected %d", len(c.Values), len(terms)-1)
+	}
+	values := make([]interface{}, 0, len(terms))
+	for _, v := range c.Values {
+		values = append(values, v)
+	}
+	return append(values, c.ID), nil
+}
+
+// encode encodes the cursor using the given codec.
+func (c *Cursor) encode(codec entgql.CursorCodec) error {
+	buf, err := msgpack.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("cannot encode cursor: %w", err)
+	}
+	if c.raw, err = codec.Encode(buf); err != nil {
+		return fmt.Errorf("cannot encode cursor: %w", err)
+	}
+	return nil
+}
+
+// decode decodes the encoded form of the cursor using the given codec. Cursors
+// that were created in Go, and therefore have no encoded form, are left as is.
+func (c *Cursor) decode(codec entgql.CursorCodec) error {
+	if c == nil || c.raw == "" {
+		return nil
+	}
+	buf, err := codec.Decode(c.raw)
+	if err != nil {
+		return entgql.ErrInvalidCursor(err)
+	}
+	if err := msgpack.Unmarshal(buf, c); err != nil {
+		return entgql.ErrInvalidCursor(err)
+	}
+	return nil
+}
+
+// MarshalGQL implements graphql.Marshaler interface. The cursors of connections
+// are encoded by the codec of the client when the connections are built, and
+// cursors that were created in Go are encoded as plain cursors.
 func (c Cursor) MarshalGQL(w io.Writer) {
-	quote := []byte{'"'}
-	w.Write(quote)
-	defer w.Write(quote)
-	wc := base64.NewEncoder(base64.RawStdEncoding, w)
-	defer wc.Close()
-	_ = msgpack.NewEncoder(wc).Encode(c)
+	if c.raw == "" {
+		if err := c.encode(entgql.NewPlainCursorCodec()); err != nil {
+			// Marshaling errors cannot be reported, and therefore the
+			// cursor is omitted, as in the case of an unknown scalar.
+			io.WriteString(w, "null")
+			return
+		}
+	}
+	io.WriteString(w, strconv.Quote(c.raw))
 }
 
-// UnmarshalGQL implements graphql.Unmarshaler interface.
+// UnmarshalGQL implements graphql.Unmarshaler interface. The cursor is
+// decoded by the pagination, using the codec of the client.
 func (c *Cursor) UnmarshalGQL(v interface{}) error {
 	s, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("%T is not a string", v)
 	}
-	if err := msgpack.NewDecoder(
-		base64.NewDecoder(
-			base64.RawStdEncoding,
-			strings.NewReader(s),
-		),
-	).Decode(c); err != nil {
-		return fmt.Errorf("cannot decode cursor: %w", err)
+	*c = Cursor{raw: s}
+	return nil
+}
+
+// cursors returns the codec of the connection cursors of the client.
+func (c config) cursors() entgql.CursorCodec {
+	if c.cursorCodec == nil {
+		return entgql.NewPlainCursorCodec()
+	}
+	return c.cursorCodec
+}
+
+// decodeCursors decodes the given pagination cursors using the codec.
+func decodeCursors(codec entgql.CursorCodec, cursors ...*Cursor) error {
+	for _, c := range cursors {
+		if err := c.decode(codec); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -212,17 +339,52 @@ func validateFirstLast(first, last *int) (err *gqlerror.Error) {
 	return err
 }
 
+// applyPageSize returns the first and last arguments of a pagination, with the default page size
+// applied if neither was set, and validates them against the max page size. If no default page
+// size is set, the max page size is used. Backward paginations (i.e. before without after) are
+// limited using the last argument.
+func applyPageSize(defaultSize, maxSize int, after, before *Cursor, first, last *int) (*int, *int, error) {
+	if first == nil && last == nil {
+		size := defaultSize
+		if size == 0 {
+			size = maxSize
+		}
+		switch {
+		case size == 0:
+		case before != nil && after == nil:
+			last = &size
+		default:
+			first = &size
+		}
+	}
+	if maxSize == 0 {
+		return first, last, nil
+	}
+	switch {
+	case first != nil && *first > maxSize:
+		err := gqlerror.Errorf("`first` on a connection cannot be greater than %d.", maxSize)
+		errcode.Set(err, errInvalidPagination)
+		return nil, nil, err
+	case last != nil && *last > maxSize:
+		err := gqlerror.Errorf("`last` on a connection cannot be greater than %d.", maxSize)
+		errcode.Set(err, errInvalidPagination)
+		return nil, nil, err
+	}
+	return first, last, nil
+}
+
 func getCollectedField(ctx context.Context, path ...string) *graphql.CollectedField {
 	fc := graphql.GetFieldContext(ctx)
 	if fc == nil {
 		return nil
 	}
-	oc := graphql.GetOperationContext(ctx)
-	field := fc.Field
+	return collectedField(graphql.GetOperationContext(ctx), fc.Field, path...)
+}
 
+func collectedField(ctx *graphql.OperationContext, field graphql.CollectedField, path ...string) *graphql.CollectedField {
 walk:
 	for _, name := range path {
-		for _, f := range graphql.CollectFields(oc, field.Selections, nil) {
+		for _, f := range graphql.CollectFields(ctx, field.Selections, nil) {
 			if f.Name == name {
 				field = f
 				continue walk
@@ -247,6 +409,170 @@ const (
 	totalCountField = "totalCount"
 )
 
+// cursorValues holds the values of the order fields that are computed by SQL expressions
+// (i.e. from the node edges). The expressions are selected by the query of the nodes, and
+// their values are read for building the node cursors.
+type cursorValues struct {
+	// indexes are the indexes of the computed fields in the order terms.
+	indexes []int
+	// exprs are the expressions of the computed fields.
+	exprs []func(*sql.Selector) string
+	// values holds the computed values of the loaded nodes by their ID.
+	values map[interface{}][]Value
+}
+
+// columns returns the expressions of the computed fields in the
+// given selector, followed by the ID column of the nodes.
+func (v *cursorValues) columns(s *sql.Selector, id string) []string {
+	columns := make([]string, 0, len(v.exprs)+1)
+	for _, expr := range v.exprs {
+		columns = append(columns, expr(s))
+	}
+	return append(columns, s.C(id))
+}
+
+// apply adds the columns of the computed values as the last columns of the query.
+func (v *cursorValues) apply(spec *sqlgraph.QuerySpec) {
+	predicate := spec.Predicate
+	spec.Predicate = func(s *sql.Selector) {
+		if predicate != nil {
+			predicate(s)
+		}
+		s.Select(append(s.Columns(spec.Node.Columns...), v.columns(s, spec.Node.ID.Column)...)...)
+	}
+}
+
+// set records the computed values of the node with the given ID.
+func (v *cursorValues) set(id interface{}, values []interface{}) {
+	record := make([]Value, len(values))
+	for i, value := range values {
+		// Compare textual values as strings, and not as blobs.
+		if b, ok := value.([]byte); ok {
+			value = string(b)
+		}
+		record[i] = value
+	}
+	v.values[id] = record
+}
+
+// edgeWindow holds the pagination window of a connection edge that is eager-loaded for
+// multiple nodes in one query. The neighbours of each node are numbered and counted using
+// window functions partitioned by the edge foreign-key, and only the rows of the window
+// are loaded.
+type edgeWindow struct {
+	// key identifies the pagination arguments of the window.
+	key string
+	// partition is the foreign-key column of the edge.
+	partition string
+	// terms are the order terms of the window, reversed for backward pagination.
+	terms []orderTerm
+	// predicates are the cursor predicates of the window.
+	predicates []func(*sql.Selector)
+	// limit is the maximum number of rows per partition, or zero for all rows.
+	limit int
+	// totals holds the partition size (total count) of the loaded neighbours by their ID.
+	totals map[interface{}]int
+	// columns are the columns of the order fields, that are read for building the cursors.
+	columns []string
+	// values holds the computed values of the order fields, if there are any.
+	values *cursorValues
+}
+
+const (
+	windowRowColumn   = "window_row_number"
+	windowTotalColumn = "window_total_count"
+)
+
+func newEdgeWindow(partition string, terms []orderTerm, key string, after *Cursor, first *int, before *Cursor, last *int) (*edgeWindow, error) {
+	predicates, err := cursorsToPredicates(terms, key, after, before)
+	if err != nil {
+		return nil, err
+	}
+	windowKey, err := edgeWindowKey(after, first, before, last, key)
+	if err != nil {
+		return nil, err
+	}
+	w := &edgeWindow{
+		key:        windowKey,
+		partition:  partition,
+		terms:      make([]orderTerm, len(terms)),
+		predicates: predicates,
+		totals:     make(map[interface{}]int),
+	}
+	for i, t := range terms {
+		if last != nil {
+			t.direction = t.direction.reverse()
+		}
+		w.terms[i] = t
+	}
+	if first != nil {
+		w.limit = *first + 1
+	} else if last != nil {
+		w.limit = *last + 1
+	}
+	return w, nil
+}
+
+// edgeWindowKey returns the key identifying the pagination arguments of a window.
+func edgeWindowKey(after *Cursor, first *int, before *Cursor, last *int, order string) (string, error) {
+	buf, err := msgpack.Marshal([]interface{}{after, first, before, last, order})
+	if err != nil {
+		return "", fmt.Errorf("cannot encode window key: %w", err)
+	}
+	return string(buf), nil
+}
+
+// apply wraps the query of the spec with a sub-query that numbers and counts the rows
+// of each partition, and selects the rows of the window with their partition size.
+// The size and the ID of each row are added as the last columns of the query.
+func (w *edgeWindow) apply(spec *sqlgraph.QuerySpec) {
+	var (
+		table     = spec.Node.Table
+		columns   = spec.Node.Columns
+		predicate = spec.Predicate
+	)
+	spec.Predicate = func(s *sql.Selector) {
+		// The total count is computed before applying the cursors, the same as in Paginate.
+		c := sql.Dialect(s.Dialect()).Select().From(sql.Table(table))
+		if predicate != nil {
+			predicate(c)
+		}
+		partition := "PARTITION BY " + c.C(w.partition)
+		c.Select(append(
+			c.Columns(columns...),
+			sql.As(fmt.Sprintf("COUNT(*) OVER (%s)", partition), windowTotalColumn),
+		)...)
+		// The columns of the wrapping selectors are resolved
+		// before their tables are replaced by the sub-queries.
+		t := sql.Dialect(s.Dialect()).Select().From(sql.Table(table))
+		for _, p := range w.predicates {
+			p(t)
+		}
+		orders := make([]string, len(w.terms))
+		for i, term := range w.terms {
+			if term.direction == OrderDirectionDesc {
+				orders[i] = sql.Desc(term.column(t))
+			} else {
+				orders[i] = sql.Asc(term.column(t))
+			}
+		}
+		t.Select(append(
+			t.Columns(append(columns, windowTotalColumn)...),
+			sql.As(fmt.Sprintf("ROW_NUMBER() OVER (PARTITION BY %s ORDER BY %s)", t.C(w.partition), strings.Join(orders, ", ")), windowRowColumn),
+		)...).From(c.As(table))
+		row := s.C(windowRowColumn)
+		selected := s.Columns(columns...)
+		if w.values != nil {
+			selected = append(selected, w.values.columns(s, spec.Node.ID.Column)...)
+		}
+		s.Select(append(selected, s.C(windowTotalColumn), s.C(spec.Node.ID.Column))...)
+		if w.limit > 0 {
+			s.Where(sql.LTE(row, w.limit))
+		}
+		s.OrderBy(row).From(t.As(table))
+	}
+}
+
 // TodoEdge is the edge representation of Todo.
 type TodoEdge struct {
 	Node   *Todo  `json:"node"`
@@ -263,20 +589,19 @@ type TodoConnection struct {
 // TodoPaginateOption enables pagination customization.
 type TodoPaginateOption func(*todoPager) error
 
-// WithTodoOrder configures pagination ordering.
-func WithTodoOrder(order *TodoOrder) TodoPaginateOption {
-	if order == nil {
-		order = DefaultTodoOrder
-	}
-	o := *order
+// WithTodoOrder configures pagination ordering. The terms are applied in the given
+// order, and the ID is used as a tiebreaker unless one of the terms orders by it.
+func WithTodoOrder(order []*TodoOrder) TodoPaginateOption {
 	return func(pager *todoPager) error {
-		if err := o.Direction.Validate(); err != nil {
-			return err
-		}
-		if o.Field == nil {
-			o.Field = DefaultTodoOrder.Field
+		for _, o := range order {
+			if o == nil {
+				return errors.New("TodoOrder cannot be nil")
+			}
+			if err := o.Direction.Validate(); err != nil {
+				return err
+			}
 		}
-		pager.order = &o
+		pager.order = todoOrderTerms(order)
 		return nil
 	}
 }
@@ -292,20 +617,32 @@ func WithTodoFilter(filter func(*TodoQuery) (*TodoQuery, error)) TodoPaginateOpt
 	}
 }
 
+// withTodoPageSize overrides the default and the max page size of the pagination.
+func withTodoPageSize(defaultSize, maxSize int) TodoPaginateOption {
+	return func(pager *todoPager) error {
+		pager.defaultSize, pager.maxSize = defaultSize, maxSize
+		return nil
+	}
+}
+
 type todoPager struct {
-	order  *TodoOrder
+	order  []*TodoOrder
 	filter func(*TodoQuery) (*TodoQuery, error)
+	// defaultSize and maxSize are the default and the max page size of the pagination.
+	defaultSize, maxSize int
 }
 
 func newTodoPager(opts []TodoPaginateOption) (*todoPager, error) {
-	pager := &todoPager{}
+	pager := &todoPager{
+		maxSize: 100,
+	}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
 	if pager.order == nil {
-		pager.order = DefaultTodoOrder
+		pager.order = todoOrderTerms(nil)
 	}
 	return pager, nil
 }
@@ -318,27 +655,84 @@ func (p *todoPager) applyFilter(query *TodoQuery) (*TodoQuery, error) {
 }
 
 func (p *todoPager) toCursor(t *Todo) Cursor {
-	return p.order.Field.toCursor(t)
+	return todoOrderCursor(p.order, t)
 }
 
-func (p *todoPager) applyCursors(query *TodoQuery, after, before *Cursor) *TodoQuery {
-	for _, predicate := range cursorsToPredicates(
-		p.order.Direction, after, before,
-		p.order.Field.field, DefaultTodoOrder.Field.field,
-	) {
+// cursorValues returns the holder of the order field values that are computed
+// from the edges of the nodes, or nil if the order has no such fields.
+func (p *todoPager) cursorValues() *cursorValues {
+	var v *cursorValues
+	for i, o := range p.order {
+		if o.Field.value != nil {
+			continue
+		}
+		if v == nil {
+			v = &cursorValues{values: make(map[interface{}][]Value)}
+		}
+		v.indexes = append(v.indexes, i)
+		v.exprs = append(v.exprs, o.Field.expr)
+	}
+	return v
+}
+
+// setCursorValues sets the computed order field values in the cursors of the edges.
+func (c *TodoConnection) setCursorValues(v *cursorValues) {
+	if v == nil {
+		return
+	}
+	for _, e := range c.Edges {
+		values, ok := v.values[e.Node.ID]
+		if !ok {
+			continue
+		}
+		for j, i := range v.indexes {
+			e.Cursor.Values[i] = values[j]
+		}
+	}
+}
+
+// orderColumns returns the columns of the order fields
+// that are read from the nodes for building their cursors.
+func (p *todoPager) orderColumns() []string {
+	columns := make([]string, 0, len(p.order))
+	for _, o := range p.order {
+		if o.Field.value != nil {
+			columns = append(columns, o.Field.field)
+		}
+	}
+	return columns
+}
+
+func (p *todoPager) terms() []orderTerm {
+	terms := make([]orderTerm, len(p.order))
+	for i, o := range p.order {
+		terms[i] = orderTerm{column: o.Field.column, direction: o.Direction, nullable: o.Field.nullable}
+	}
+	return terms
+}
+
+func (p *todoPager) applyCursors(query *TodoQuery, after, before *Cursor) (*TodoQuery, error) {
+	predicates, err := cursorsToPredicates(p.terms(), todoOrderKey(p.order), after, before)
+	if err != nil {
+		return nil, err
+	}
+	for _, predicate := range predicates {
 		query = query.Where(predicate)
 	}
-	return query
+	return query, nil
 }
 
 func (p *todoPager) applyOrder(query *TodoQuery, reverse bool) *TodoQuery {
-	direction := p.order.Direction
-	if reverse {
-		direction = direction.reverse()
-	}
-	query = query.Order(direction.orderFunc(p.order.Field.field))
-	if p.order.Field != DefaultTodoOrder.Field {
-		query = query.Order(direction.orderFunc(DefaultTodoOrder.Field.field))
+	for _, o := range p.order {
+		direction := o.Direction
+		if reverse {
+			direction = direction.reverse()
+		}
+		if o.Field.expr != nil {
+			query = query.Order(direction.orderExpr(o.Field.expr))
+		} else {
+			query = query.Order(direction.orderFunc(o.Field.field))
+		}
 	}
 	return query
 }
@@ -351,10 +745,16 @@ func (t *TodoQuery) Paginate(
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
+	if err := decodeCursors(t.cursors(), after, before); err != nil {
+		return nil, err
+	}
 	pager, err := newTodoPager(opts)
 	if err != nil {
 		return nil, err
 	}
+	if first, last, err = applyPageSize(pager.defaultSize, pager.maxSize, after, before, first, last); err != nil {
+		return nil, err
+	}
 
 	if t, err = pager.applyFilter(t); err != nil {
 		return nil, err
@@ -383,7 +783,9 @@ func (t *TodoQuery) Paginate(
 		conn.TotalCount = count
 	}
 
-	t = pager.applyCursors(t, after, before)
+	if t, err = pager.applyCursors(t, after, before); err != nil {
+		return nil, err
+	}
 	t = pager.applyOrder(t, last != nil)
 	var limit int
 	if first != nil {
@@ -397,19 +799,37 @@ func (t *TodoQuery) Paginate(
 
 	if field := getCollectedField(ctx, edgesField, nodeField); field != nil {
 		t = t.collectField(graphql.GetOperationContext(ctx), *field)
+		t = t.selectColumns(pager.orderColumns()...)
 	}
+	values := pager.cursorValues()
+	t.cursorValues = values
 
 	nodes, err := t.All(ctx)
 	if err != nil || len(nodes) == 0 {
 		return conn, err
 	}
+	conn.build(nodes, pager, first, last)
+	conn.setCursorValues(values)
+	if err := conn.encodeCursors(t.cursors()); err != nil {
+		return nil, err
+	}
+	if conn.TotalCount == 0 {
+		conn.TotalCount = len(conn.Edges)
+	}
+	return conn, nil
+}
 
-	if len(nodes) == limit {
-		conn.PageInfo.HasNextPage = first != nil
-		conn.PageInfo.HasPreviousPage = last != nil
+// build sets the edges and the page info of the connection from the queried nodes.
+// The nodes are in the query order, which is reversed for backward pagination, and
+// may contain an additional node that indicates the existence of another page.
+func (c *TodoConnection) build(nodes []*Todo, pager *todoPager, first, last *int) {
+	if first != nil && *first+1 == len(nodes) {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:len(nodes)-1]
+	} else if last != nil && *last+1 == len(nodes) {
+		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:len(nodes)-1]
 	}
-
 	var nodeAt func(int) *Todo
 	if last != nil {
 		n := len(nodes) - 1
@@ -421,64 +841,92 @@ func (t *TodoQuery) Paginate(
 			return nodes[i]
 		}
 	}
-
-	conn.Edges = make([]*TodoEdge, len(nodes))
+	c.Edges = make([]*TodoEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		conn.Edges[i] = &TodoEdge{
+		c.Edges[i] = &TodoEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
 	}
-
-	conn.PageInfo.StartCursor = &conn.Edges[0].Cursor
-	conn.PageInfo.EndCursor = &conn.Edges[len(conn.Edges)-1].Cursor
-	if conn.TotalCount == 0 {
-		conn.TotalCount = len(nodes)
+	if n := len(c.Edges); n > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[n-1].Cursor
 	}
+}
 
-	return conn, nil
+// encodeCursors encodes the cursors of the connection edges using the given codec.
+func (c *TodoConnection) encodeCursors(codec entgql.CursorCodec) error {
+	for _, e := range c.Edges {
+		if err := e.Cursor.encode(codec); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 var (
 	// TodoOrderFieldCreatedAt orders Todo by created_at.
 	TodoOrderFieldCreatedAt = &TodoOrderField{
 		field: todo.FieldCreatedAt,
-		toCursor: func(t *Todo) Cursor {
-			return Cursor{
-				ID:    t.ID,
-				Value: t.CreatedAt,
-			}
+		value: func(t *Todo) Value {
+			return t.CreatedAt
 		},
 	}
-	// TodoOrderFieldStatus orders Todo by status.
+	// TodoOrderFieldStatus orders Todo by status, in the declaration order of its values.
 	TodoOrderFieldStatus = &TodoOrderField{
 		field: todo.FieldStatus,
-		toCursor: func(t *Todo) Cursor {
-			return Cursor{
-				ID:    t.ID,
-				Value: t.Status,
+		value: func(t *Todo) Value {
+			switch t.Status {
+			case todo.StatusInProgress:
+				return 0
+			case todo.StatusCompleted:
+				return 1
 			}
+			return nil
+		},
+		expr: func(s *sql.Selector) string {
+			return "CASE " + s.C(todo.FieldStatus) + " WHEN 'in_progress' THEN 0 WHEN 'completed' THEN 1 END"
 		},
 	}
 	// TodoOrderFieldPriority orders Todo by priority.
 	TodoOrderFieldPriority = &TodoOrderField{
 		field: todo.FieldPriority,
-		toCursor: func(t *Todo) Cursor {
-			return Cursor{
-				ID:    t.ID,
-				Value: t.Priority,
-			}
+		value: func(t *Todo) Value {
+			return t.Priority
 		},
 	}
 	// TodoOrderFieldText orders Todo by text.
 	TodoOrderFieldText = &TodoOrderField{
 		field: todo.FieldText,
-		toCursor: func(t *Todo) Cursor {
-			return Cursor{
-				ID:    t.ID,
-				Value: t.Text,
-			}
+		value: func(t *Todo) Value {
+			return t.Text
+		},
+	}
+	// TodoOrderFieldParentPriority orders Todo by the priority of its parent.
+	TodoOrderFieldParentPriority = &TodoOrderField{
+		field: "parent.priority",
+		expr: func(s *sql.Selector) string {
+			t := sql.Table(todo.Table).As("parent_order")
+			sub := sql.Dialect(s.Dialect()).
+				Select(t.C(todo.FieldPriority)).
+				From(t).
+				Where(sql.ColumnsEQ(t.C(todo.FieldID), s.C(todo.ParentColumn)))
+			return subqueryExpr(s, sub)
+		},
+		// Nodes without parent are ordered by a NULL value.
+		nullable: true,
+	}
+	// TodoOrderFieldChildrenCount orders Todo by the count of its children.
+	TodoOrderFieldChildrenCount = &TodoOrderField{
+		field: "children.count",
+		expr: func(s *sql.Selector) string {
+			t := sql.Table(todo.ChildrenTable).As("children_order")
+			sub := sql.Dialect(s.Dialect()).
+				Select(sql.Count("*")).
+				From(t).
+				Where(sql.ColumnsEQ(t.C(todo.ChildrenColumn), s.C(todo.FieldID)))
+			return subqueryExpr(s, sub)
 		},
 	}
 )
@@ -495,6 +943,10 @@ func (f TodoOrderField) String() string {
 		str = "PRIORITY"
 	case todo.FieldText:
 		str = "TEXT"
+	case TodoOrderFieldParentPriority.field:
+		str = "PARENT_PRIORITY"
+	case TodoOrderFieldChildrenCount.field:
+		str = "CHILDREN_COUNT"
 	}
 	return str
 }
@@ -519,6 +971,10 @@ func (f *TodoOrderField) UnmarshalGQL(v interface{}) error {
 		*f = *TodoOrderFieldPriority
 	case "TEXT":
 		*f = *TodoOrderFieldText
+	case "PARENT_PRIORITY":
+		*f = *TodoOrderFieldParentPriority
+	case "CHILDREN_COUNT":
+		*f = *TodoOrderFieldChildrenCount
 	default:
 		return fmt.Errorf("%s is not a valid TodoOrderField", str)
 	}
@@ -527,8 +983,21 @@ func (f *TodoOrderField) UnmarshalGQL(v interface{}) error {
 
 // TodoOrderField defines the ordering field of Todo.
 type TodoOrderField struct {
-	field    string
-	toCursor func(*Todo) Cursor
+	field string
+	value func(*Todo) Value
+	// expr is set for fields that are ordered by an SQL expression,
+	// and returns it in the selector. Fields that are computed from
+	// the node edges have an expr, but no value.
+	expr func(*sql.Selector) string
+	// nullable reports if the field values may be NULL.
+	nullable bool
+}
+
+func (f *TodoOrderField) column(s *sql.Selector) string {
+	if f.expr != nil {
+		return f.expr(s)
+	}
+	return s.C(f.field)
 }
 
 // TodoOrder defines the ordering of Todo.
@@ -542,19 +1011,190 @@ var DefaultTodoOrder = &TodoOrder{
 	Direction: OrderDirectionAsc,
 	Field: &TodoOrderField{
 		field: todo.FieldID,
-		toCursor: func(t *Todo) Cursor {
-			return Cursor{ID: t.ID}
+		value: func(t *Todo) Value {
+			return t.ID
 		},
 	},
 }
 
-// ToEdge converts Todo into TodoEdge.
-func (t *Todo) ToEdge(order *TodoOrder) *TodoEdge {
-	if order == nil {
-		order = DefaultTodoOrder
+// todoOrderTerms normalizes the given ordering into the list of terms used
+// for pagination. Terms without a field order by ID, terms following an ID term
+// are dropped, and the ID is appended as a tiebreaker if it is not ordered by.
+func todoOrderTerms(order []*TodoOrder) []*TodoOrder {
+	terms := make([]*TodoOrder, 0, len(order)+1)
+	for _, o := range order {
+		if o == nil {
+			continue
+		}
+		t := *o
+		if t.Field == nil || t.Field.field == DefaultTodoOrder.Field.field {
+			t.Field = DefaultTodoOrder.Field
+		}
+		terms = append(terms, &t)
+		if t.Field == DefaultTodoOrder.Field {
+			return terms
+		}
+	}
+	tiebreaker := *DefaultTodoOrder
+	if n := len(terms); n > 0 {
+		tiebreaker.Direction = terms[n-1].Direction
+	}
+	return append(terms, &tiebreaker)
+}
+
+// todoOrderKey returns the key identifying the given order terms in cursors.
+func todoOrderKey(terms []*TodoOrder) string {
+	keys := make([]string, len(terms))
+	for i, o := range terms {
+		keys[i] = o.Field.field + " " + o.Direction.String()
+	}
+	return strings.Join(keys, ",")
+}
+
+// todoOrderCursor returns the cursor of Todo for the given order terms.
+func todoOrderCursor(terms []*TodoOrder, t *Todo) Cursor {
+	cursor := Cursor{ID: t.ID, Order: todoOrderKey(terms)}
+	for _, o := range terms[:len(terms)-1] {
+		var v Value
+		if o.Field.value != nil {
+			v = o.Field.value(t)
+		}
+		cursor.Values = append(cursor.Values, v)
 	}
-	return &TodoEdge{
+	return cursor
+}
+
+// ToEdge converts Todo into TodoEdge, and encodes its cursor using the codec of the
+// client. Note that the cursor values of order fields computed from the node edges are not
+// loaded by ToEdge.
+func (t *Todo) ToEdge(order []*TodoOrder) (*TodoEdge, error) {
+	e := &TodoEdge{
 		Node:   t,
-		Cursor: order.Field.toCursor(t),
+		Cursor: todoOrderCursor(todoOrderTerms(order), t),
+	}
+	if err := e.Cursor.encode(t.cursors()); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// unmarshalTodoOrders unmarshals the orderBy argument of a Todo connection field.
+func unmarshalTodoOrders(v interface{}) ([]*TodoOrder, error) {
+	list, ok := v.([]interface{})
+	if !ok && v != nil {
+		// A single input value is coerced into a list.
+		list = []interface{}{v}
+	}
+	order := make([]*TodoOrder, 0, len(list))
+	for _, v := range list {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%T is not a TodoOrder", v)
+		}
+		o := &TodoOrder{}
+		if err := o.Direction.UnmarshalGQL(m["direction"]); err != nil {
+			return nil, err
+		}
+		if v := m["field"]; v != nil {
+			o.Field = &TodoOrderField{}
+			if err := o.Field.UnmarshalGQL(v); err != nil {
+				return nil, err
+			}
+		}
+		order = append(order, o)
+	}
+	return order, nil
+}
+
+// newTodoWindow returns the window for eager-loading Todo nodes as the connection
+// edge of other nodes, using the pagination arguments of the given connection field.
+func newTodoWindow(ctx *graphql.OperationContext, field graphql.CollectedField, codec entgql.CursorCodec, partition string, opts ...TodoPaginateOption) (*edgeWindow, error) {
+	var (
+		args          = field.ArgumentMap(ctx.Variables)
+		after, before *Cursor
+		first, last   *int
+	)
+	for name, c := range map[string]**Cursor{"after": &after, "before": &before} {
+		if v := args[name]; v != nil {
+			*c = &Cursor{}
+			if err := (*c).UnmarshalGQL(v); err != nil {
+				return nil, err
+			}
+			if err := (*c).decode(codec); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for name, n := range map[string]**int{"first": &first, "last": &last} {
+		if v := args[name]; v != nil {
+			i, err := graphql.UnmarshalInt(v)
+			if err != nil {
+				return nil, err
+			}
+			*n = &i
+		}
+	}
+	order, err := unmarshalTodoOrders(args["orderBy"])
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, WithTodoOrder(order))
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newTodoPager(opts)
+	if err != nil {
+		return nil, err
+	}
+	if first, last, err = applyPageSize(pager.defaultSize, pager.maxSize, after, before, first, last); err != nil {
+		return nil, err
+	}
+	w, err := newEdgeWindow(partition, pager.terms(), todoOrderKey(pager.order), after, first, before, last)
+	if err != nil {
+		return nil, err
+	}
+	w.columns = pager.orderColumns()
+	w.values = pager.cursorValues()
+	return w, nil
+}
+
+// paginateTodoWindow returns the connection of the Todo nodes that were eager-loaded
+// within the given window. It reports false if the window has different pagination arguments,
+// or if the nodes were not loaded by the window.
+func paginateTodoWindow(
+	codec entgql.CursorCodec, w *edgeWindow, nodes []*Todo,
+	after *Cursor, first *int, before *Cursor, last *int, opts ...TodoPaginateOption,
+) (*TodoConnection, bool, error) {
+	if err := decodeCursors(codec, after, before); err != nil {
+		return nil, false, err
+	}
+	pager, err := newTodoPager(opts)
+	if err != nil {
+		return nil, false, err
+	}
+	if first, last, err = applyPageSize(pager.defaultSize, pager.maxSize, after, before, first, last); err != nil {
+		return nil, false, err
+	}
+	key, err := edgeWindowKey(after, first, before, last, todoOrderKey(pager.order))
+	if err != nil {
+		return nil, false, err
+	}
+	if w.key != key {
+		return nil, false, nil
+	}
+	conn := &TodoConnection{Edges: []*TodoEdge{}}
+	if len(nodes) == 0 {
+		return conn, true, nil
+	}
+	total, ok := w.totals[nodes[0].ID]
+	if !ok {
+		return nil, false, nil
+	}
+	conn.TotalCount = total
+	conn.build(nodes, pager, first, last)
+	conn.setCursorValues(w.values)
+	if err := conn.encodeCursors(codec); err != nil {
+		return nil, false, err
 	}
+	return conn, true, nil
 }
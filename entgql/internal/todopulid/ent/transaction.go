@@ -18,8 +18,12 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
 	"database/sql/driver"
 	"errors"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 )
 
 // OpenTx opens a transaction and returns a transactional
@@ -29,11 +33,87 @@ func (c *Client) OpenTx(ctx context.Context) (context.Context, driver.Tx, error)
 	if err != nil {
 		return nil, nil, err
 	}
+	unwrapOnCommit(tx)
+	ctx = NewTxContext(ctx, tx)
+	ctx = NewContext(ctx, tx.Client())
+	return ctx, tx, nil
+}
+
+// OpenTxWithOptions opens a transaction with the given options (e.g. isolation
+// level) and returns a transactional context along with the created transaction.
+func (c *Client) OpenTxWithOptions(ctx context.Context, opts *sql.TxOptions) (context.Context, driver.Tx, error) {
+	tx, err := c.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	unwrapOnCommit(tx)
 	ctx = NewTxContext(ctx, tx)
 	ctx = NewContext(ctx, tx.Client())
 	return ctx, tx, nil
 }
 
+// unwrapOnCommit makes the entities of the given transaction query through the
+// driver of the client after it was committed, as their graphql selections may
+// be resolved after the commit (e.g. in the per-field mode of the Transactioner).
+func unwrapOnCommit(tx *Tx) {
+	tx.OnCommit(func(next Committer) Committer {
+		return CommitFunc(func(ctx context.Context, tx *Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+			drv := tx.config.driver.(*txDriver)
+			drv.tx = committedTx{Driver: drv.drv}
+			return nil
+		})
+	})
+}
+
+// committedTx is a committed transaction, whose
+// statements are executed by the driver that opened it.
+type committedTx struct {
+	dialect.Driver
+}
+
+// Commit implements the driver.Tx interface.
+func (committedTx) Commit() error { return stdsql.ErrTxDone }
+
+// Rollback implements the driver.Tx interface.
+func (committedTx) Rollback() error { return stdsql.ErrTxDone }
+
+// Savepoint creates a savepoint with the given name in the transaction.
+func (tx *Tx) Savepoint(ctx context.Context, name string) error {
+	if err := tx.driver.Exec(ctx, "SAVEPOINT "+name, []interface{}{}, nil); err != nil {
+		return err
+	}
+	if events := txEvents(tx.config); events != nil {
+		events.savepoint(name)
+	}
+	return nil
+}
+
+// RollbackToSavepoint rolls back the work that was done in the transaction
+// after the savepoint with the given name was created.
+func (tx *Tx) RollbackToSavepoint(ctx context.Context, name string) error {
+	if err := tx.driver.Exec(ctx, "ROLLBACK TO SAVEPOINT "+name, []interface{}{}, nil); err != nil {
+		return err
+	}
+	if events := txEvents(tx.config); events != nil {
+		events.rollbackTo(name)
+	}
+	return nil
+}
+
+// ReleaseSavepoint releases the savepoint with the given name.
+func (tx *Tx) ReleaseSavepoint(ctx context.Context, name string) error {
+	if err := tx.driver.Exec(ctx, "RELEASE SAVEPOINT "+name, []interface{}{}, nil); err != nil {
+		return err
+	}
+	if events := txEvents(tx.config); events != nil {
+		events.release(name)
+	}
+	return nil
+}
+
 // OpenTxFromContext open transactions from client stored in context.
 func OpenTxFromContext(ctx context.Context) (context.Context, driver.Tx, error) {
 	client := FromContext(ctx)
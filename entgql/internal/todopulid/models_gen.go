@@ -1,15 +0,0 @@
-// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
-
-package todopulid
-
-import (
-	"entgo.io/contrib/entgql/internal/todopulid/ent/schema/pulid"
-	"entgo.io/contrib/entgql/internal/todopulid/ent/todo"
-)
-
-type TodoInput struct {
-	Status   todo.Status `json:"status"`
-	Priority *int        `json:"priority"`
-	Text     string      `json:"text"`
-	Parent   *pulid.ID   `json:"parent"`
-}
@@ -15,16 +15,31 @@
 package todopulid
 
 import (
+	"context"
+
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todopulid/ent"
 	"github.com/99designs/gqlgen/graphql"
 )
 
 // Resolver is the resolver root.
-type Resolver struct{ client *ent.Client }
+type Resolver struct {
+	client *ent.Client
+	broker entgql.Broker
+}
 
-// NewSchema creates a graphql executable schema.
+// NewSchema creates a graphql executable schema. The changes
+// of the client are published to the schema subscriptions.
 func NewSchema(client *ent.Client) graphql.ExecutableSchema {
+	broker := entgql.NewMemoryBroker(0)
+	client.UseBroker(broker)
 	return NewExecutableSchema(Config{
-		Resolvers: &Resolver{client},
+		Resolvers: &Resolver{client, broker},
 	})
 }
+
+// Entities implements the EntityResolver interface by resolving
+// the Apollo Federation entities using the Node API of the client.
+func (r *Resolver) Entities(ctx context.Context, representations []map[string]interface{}) ([]ent.Noder, error) {
+	return r.client.Entities(ctx, representations)
+}
@@ -17,6 +17,7 @@
 package ent
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 )
@@ -34,6 +35,13 @@ type config struct {
 	log func(...interface{})
 	// hooks to execute on mutations.
 	hooks *hooks
+
+	// cursorCodec used for encoding and decoding the cursors of connections.
+	cursorCodec entgql.CursorCodec
+
+	// windows holds the windows of the connection edges
+	// that were eager-loaded by the query of the nodes.
+	windows map[string]*edgeWindow
 }
 
 // hooks per client, for fast access.
@@ -71,3 +79,17 @@ func Driver(driver dialect.Driver) Option {
 		c.driver = driver
 	}
 }
+
+// CursorCodec configures the codec used for encoding and decoding the cursors of the
+// client connections. For example, a signed codec rejects cursors forged by clients:
+//
+//	codec, err := entgql.NewSignedCursorCodec(key)
+//	if err != nil {
+//		return err
+//	}
+//	client := ent.NewClient(ent.Driver(drv), ent.CursorCodec(codec))
+func CursorCodec(codec entgql.CursorCodec) Option {
+	return func(c *config) {
+		c.cursorCodec = codec
+	}
+}
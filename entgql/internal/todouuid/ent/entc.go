@@ -34,7 +34,7 @@ func main() {
 			//
 			// Code generated by entc, DO NOT EDIT.
 		`,
-		Templates: entgql.AllTemplates,
+		Templates: append(entgql.AllTemplates, entgql.EventTemplate, entgql.FederationTemplate),
 	})
 	if err != nil {
 		log.Fatalf("running ent codegen: %v", err)
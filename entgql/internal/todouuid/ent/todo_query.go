@@ -44,6 +44,13 @@ type TodoQuery struct {
 	withParent   *TodoQuery
 	withChildren *TodoQuery
 	withFKs      bool
+
+	// cursorValues holds the computed order values that are selected by the query.
+	cursorValues *cursorValues
+
+	// window of the connection edge that is eager-loaded by the query.
+	window *edgeWindow
+
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -345,11 +352,11 @@ func (tq *TodoQuery) WithChildren(opts ...func(*TodoQuery)) *TodoQuery {
 //		Count int `json:"count,omitempty"`
 //	}
 //
+//
 //	client.Todo.Query().
 //		GroupBy(todo.FieldCreatedAt).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
-//
 func (tq *TodoQuery) GroupBy(field string, fields ...string) *TodoGroupBy {
 	group := &TodoGroupBy{config: tq.config}
 	group.fields = append([]string{field}, fields...)
@@ -371,10 +378,10 @@ func (tq *TodoQuery) GroupBy(field string, fields ...string) *TodoGroupBy {
 //		CreatedAt time.Time `json:"created_at,omitempty"`
 //	}
 //
+//
 //	client.Todo.Query().
 //		Select(todo.FieldCreatedAt).
 //		Scan(ctx, &v)
-//
 func (tq *TodoQuery) Select(field string, fields ...string) *TodoSelect {
 	tq.fields = append([]string{field}, fields...)
 	return &TodoSelect{TodoQuery: tq}
@@ -425,6 +432,50 @@ func (tq *TodoQuery) sqlAll(ctx context.Context) ([]*Todo, error) {
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if v := tq.cursorValues; v != nil && _spec.ScanValues != nil {
+		v.apply(_spec)
+		var (
+			id     uuid.UUID
+			values = make([]interface{}, len(v.exprs))
+			n      = len(values) + 1
+			scan   = _spec.ScanValues
+			assign = _spec.Assign
+		)
+		_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+			dest, err := scan(columns[:len(columns)-n])
+			if err != nil {
+				return nil, err
+			}
+			for i := range values {
+				dest = append(dest, &values[i])
+			}
+			return append(dest, &id), nil
+		}
+		_spec.Assign = func(columns []string, dest []interface{}) error {
+			v.set(id, values)
+			return assign(columns[:len(columns)-n], dest[:len(dest)-n])
+		}
+	}
+	if w := tq.window; w != nil && _spec.ScanValues != nil {
+		w.apply(_spec)
+		var (
+			id     uuid.UUID
+			total  sql.NullInt64
+			scan   = _spec.ScanValues
+			assign = _spec.Assign
+		)
+		_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+			values, err := scan(columns[:len(columns)-2])
+			if err != nil {
+				return nil, err
+			}
+			return append(values, &total, &id), nil
+		}
+		_spec.Assign = func(columns []string, values []interface{}) error {
+			w.totals[id] = int(total.Int64)
+			return assign(columns[:len(columns)-2], values[:len(values)-2])
+		}
+	}
 	if err := sqlgraph.QueryNodes(ctx, tq.driver, _spec); err != nil {
 		return nil, err
 	}
@@ -495,6 +546,50 @@ func (tq *TodoQuery) sqlAll(ctx context.Context) ([]*Todo, error) {
 
 func (tq *TodoQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := tq.querySpec()
+	if v := tq.cursorValues; v != nil && _spec.ScanValues != nil {
+		v.apply(_spec)
+		var (
+			id     uuid.UUID
+			values = make([]interface{}, len(v.exprs))
+			n      = len(values) + 1
+			scan   = _spec.ScanValues
+			assign = _spec.Assign
+		)
+		_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+			dest, err := scan(columns[:len(columns)-n])
+			if err != nil {
+				return nil, err
+			}
+			for i := range values {
+				dest = append(dest, &values[i])
+			}
+			return append(dest, &id), nil
+		}
+		_spec.Assign = func(columns []string, dest []interface{}) error {
+			v.set(id, values)
+			return assign(columns[:len(columns)-n], dest[:len(dest)-n])
+		}
+	}
+	if w := tq.window; w != nil && _spec.ScanValues != nil {
+		w.apply(_spec)
+		var (
+			id     uuid.UUID
+			total  sql.NullInt64
+			scan   = _spec.ScanValues
+			assign = _spec.Assign
+		)
+		_spec.ScanValues = func(columns []string) ([]interface{}, error) {
+			values, err := scan(columns[:len(columns)-2])
+			if err != nil {
+				return nil, err
+			}
+			return append(values, &total, &id), nil
+		}
+		_spec.Assign = func(columns []string, values []interface{}) error {
+			w.totals[id] = int(total.Int64)
+			return assign(columns[:len(columns)-2], values[:len(values)-2])
+		}
+	}
 	return sqlgraph.CountNodes(ctx, tq.driver, _spec)
 }
 
@@ -0,0 +1,43 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// +build ignore
+
+package main
+
+import (
+	"log"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/entc"
+	"entgo.io/ent/entc/gen"
+	"github.com/99designs/gqlgen/api"
+	"github.com/99designs/gqlgen/codegen/config"
+)
+
+func main() {
+	graph, err := entc.LoadGraph("./ent/schema", &gen.Config{
+		Templates: append(entgql.AllTemplates, entgql.EventTemplate, entgql.FederationTemplate),
+	})
+	if err != nil {
+		log.Fatalf("loading ent graph: %v", err)
+	}
+	cfg, err := config.LoadConfigFromDefaultLocations()
+	if err != nil {
+		log.Fatalf("loading gqlgen config: %v", err)
+	}
+	if err := api.Generate(cfg, entgql.NewPlugin(graph).Option()); err != nil {
+		log.Fatalf("running gqlgen: %v", err)
+	}
+}
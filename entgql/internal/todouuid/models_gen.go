@@ -1,15 +0,0 @@
-// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
-
-package todo
-
-import (
-	"entgo.io/contrib/entgql/internal/todouuid/ent/todo"
-	"github.com/google/uuid"
-)
-
-type TodoInput struct {
-	Status   todo.Status `json:"status"`
-	Priority *int        `json:"priority"`
-	Text     string      `json:"text"`
-	Parent   *uuid.UUID  `json:"parent"`
-}
@@ -20,18 +20,25 @@ package todo
 import (
 	"context"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todouuid/ent"
+	"entgo.io/contrib/entgql/internal/todouuid/ent/todo"
 	"github.com/google/uuid"
 )
 
-func (r *mutationResolver) CreateTodo(ctx context.Context, todo TodoInput) (*ent.Todo, error) {
+func (r *mutationResolver) CreateTodo(ctx context.Context, input ent.CreateTodoInput) (*ent.Todo, error) {
 	client := ent.FromContext(ctx)
 	return client.Todo.
 		Create().
-		SetStatus(todo.Status).
-		SetNillablePriority(todo.Priority).
-		SetText(todo.Text).
-		SetNillableParentID(todo.Parent).
+		SetInput(input).
+		Save(ctx)
+}
+
+func (r *mutationResolver) UpdateTodo(ctx context.Context, id uuid.UUID, input ent.UpdateTodoInput) (*ent.Todo, error) {
+	client := ent.FromContext(ctx)
+	return client.Todo.
+		UpdateOneID(id).
+		SetInput(input).
 		Save(ctx)
 }
 
@@ -42,6 +49,21 @@ func (r *mutationResolver) ClearTodos(ctx context.Context) (int, error) {
 		Exec(ctx)
 }
 
+func (r *mutationResolver) CreateTodos(ctx context.Context, inputs []*ent.CreateTodoInput) ([]*ent.Todo, error) {
+	client := ent.FromContext(ctx)
+	return client.Todo.CreateFromInputs(ctx, inputs)
+}
+
+func (r *mutationResolver) UpdateTodos(ctx context.Context, where ent.TodoWhereInput, input ent.UpdateTodoInput) ([]*ent.Todo, error) {
+	client := ent.FromContext(ctx)
+	return client.Todo.UpdateWhere(ctx, &where, input)
+}
+
+func (r *mutationResolver) DeleteTodos(ctx context.Context, where ent.TodoWhereInput) (int, error) {
+	client := ent.FromContext(ctx)
+	return client.Todo.DeleteWhere(ctx, &where)
+}
+
 func (r *queryResolver) Node(ctx context.Context, id uuid.UUID) (ent.Noder, error) {
 	return r.client.Noder(ctx, id)
 }
@@ -50,18 +72,58 @@ func (r *queryResolver) Nodes(ctx context.Context, ids []uuid.UUID) ([]ent.Noder
 	return r.client.Noders(ctx, ids)
 }
 
-func (r *queryResolver) Todos(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy *ent.TodoOrder) (*ent.TodoConnection, error) {
+func (r *queryResolver) Todos(ctx context.Context, after *ent.Cursor, first *int, before *ent.Cursor, last *int, orderBy []*ent.TodoOrder, where *ent.TodoWhereInput) (*ent.TodoConnection, error) {
 	return r.client.Todo.Query().
 		Paginate(ctx, after, first, before, last,
 			ent.WithTodoOrder(orderBy),
+			ent.WithTodoFilter(where.Filter),
 		)
 }
 
+func (r *subscriptionResolver) TodoUpdated(ctx context.Context, id uuid.UUID) (<-chan *ent.Todo, error) {
+	var (
+		events = r.client.Todo.Subscribe(ctx, r.broker, todo.ID(id))
+		ch     = make(chan *ent.Todo)
+	)
+	go func() {
+		defer close(ch)
+		for e := range events {
+			switch {
+			case e.ID != id:
+			case e.Op == entgql.EventDeleted:
+				return
+			case e.Op == entgql.EventUpdated:
+				select {
+				case ch <- e.Node:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
+
+func (r *subscriptionResolver) TodosChanged(ctx context.Context, where *ent.TodoWhereInput) (<-chan *ent.TodoEvent, error) {
+	p, err := where.P()
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return r.client.Todo.Subscribe(ctx, r.broker), nil
+	}
+	return r.client.Todo.Subscribe(ctx, r.broker, p), nil
+}
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
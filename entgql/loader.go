@@ -0,0 +1,162 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// DefaultLoaderWait is the default duration a batch of the EdgeLoader waits for more keys.
+const DefaultLoaderWait = time.Millisecond
+
+// EdgeLoader batches the resolution of edges that were not eager-loaded (e.g. fields
+// that were not collected by CollectFields), into one query per edge. It installs a
+// loader for each graphql response, that is used by the generated edge resolvers.
+//
+// Note that the Transactioner executes the resolvers of mutations, and of queries with
+// SnapshotQueries, serially. A serial resolver cannot be batched with the others, so
+// the loader is skipped for it, and its edges are queried without waiting for a batch.
+type EdgeLoader struct {
+	// Wait is the duration a batch waits for more keys
+	// before it is executed. Defaults to DefaultLoaderWait.
+	Wait time.Duration
+
+	// MaxBatch is the max number of keys in a batch.
+	// Zero means no limit.
+	MaxBatch int
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = EdgeLoader{}
+
+// ExtensionName returns the extension name.
+func (EdgeLoader) ExtensionName() string {
+	return "EntGQLEdgeLoader"
+}
+
+// Validate is called when adding an extension to the server, it allows validation against the servers schema.
+func (l EdgeLoader) Validate(graphql.ExecutableSchema) error {
+	if l.Wait < 0 {
+		return errors.New("entgql: loader wait cannot be negative")
+	}
+	if l.MaxBatch < 0 {
+		return errors.New("entgql: loader max batch cannot be negative")
+	}
+	return nil
+}
+
+// InterceptResponse installs the loader of the response in the context.
+func (l EdgeLoader) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	return next(context.WithValue(ctx, loaderKey{}, &loader{
+		EdgeLoader: l,
+		batches:    make(map[string]*batch),
+	}))
+}
+
+// BatchFunc loads the values of the given keys. The returned values are
+// mapped by their keys, and keys without a value were not found.
+type BatchFunc func(ctx context.Context, keys []interface{}) (map[interface{}]interface{}, error)
+
+// LoadEdge adds the given key to the current batch of the named edge, and returns its
+// value after the batch was executed, or nil if it was not found. The batch function
+// and the context of the first key in the batch are used for its execution. It reports
+// false if no EdgeLoader was installed in the context, or the resolver is executed serially.
+func LoadEdge(ctx context.Context, name string, key interface{}, fn BatchFunc) (interface{}, bool, error) {
+	l, ok := ctx.Value(loaderKey{}).(*loader)
+	if serial, _ := ctx.Value(serialKey{}).(bool); !ok || serial {
+		return nil, false, nil
+	}
+	b := l.add(ctx, name, key, fn)
+	<-b.done
+	if b.err != nil {
+		return nil, true, b.err
+	}
+	return b.values[key], true, nil
+}
+
+type (
+	loaderKey struct{}
+
+	// loader holds the pending batches of a response.
+	loader struct {
+		EdgeLoader
+		mu      sync.Mutex
+		batches map[string]*batch
+	}
+
+	// batch holds the keys of an edge that are loaded together.
+	batch struct {
+		keys   []interface{}
+		seen   map[interface{}]struct{}
+		timer  *time.Timer
+		done   chan struct{}
+		values map[interface{}]interface{}
+		err    error
+	}
+)
+
+// add adds the key to the pending batch of the named edge, or to a new one.
+func (l *loader) add(ctx context.Context, name string, key interface{}, fn BatchFunc) *batch {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b, ok := l.batches[name]
+	if !ok {
+		b = &batch{
+			seen: make(map[interface{}]struct{}),
+			done: make(chan struct{}),
+		}
+		wait := l.Wait
+		if wait == 0 {
+			wait = DefaultLoaderWait
+		}
+		b.timer = time.AfterFunc(wait, func() { l.exec(ctx, name, b, fn) })
+		l.batches[name] = b
+	}
+	if _, ok := b.seen[key]; !ok {
+		b.seen[key] = struct{}{}
+		b.keys = append(b.keys, key)
+	}
+	// A full batch is executed without waiting,
+	// unless its timer has already fired.
+	if l.MaxBatch > 0 && len(b.keys) >= l.MaxBatch && b.timer.Stop() {
+		delete(l.batches, name)
+		go l.exec(ctx, name, b, fn)
+	}
+	return b
+}
+
+// exec executes the given batch, and releases its waiting keys.
+func (l *loader) exec(ctx context.Context, name string, b *batch, fn BatchFunc) {
+	l.mu.Lock()
+	if l.batches[name] == b {
+		delete(l.batches, name)
+	}
+	l.mu.Unlock()
+	defer close(b.done)
+	defer func() {
+		if r := recover(); r != nil {
+			b.err = fmt.Errorf("entgql: loading edge %s: %v", name, r)
+		}
+	}()
+	b.values, b.err = fn(ctx, b.keys)
+}
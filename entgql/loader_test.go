@@ -0,0 +1,108 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEdgeLoader(t *testing.T) {
+	// load loads the given keys concurrently under the loader, and returns
+	// the batches that were executed along with the loaded values.
+	load := func(l entgql.EdgeLoader, fn entgql.BatchFunc, keys ...int) ([][]interface{}, []interface{}, []error) {
+		var (
+			mu      sync.Mutex
+			batches [][]interface{}
+			values  = make([]interface{}, len(keys))
+			errs    = make([]error, len(keys))
+		)
+		l.InterceptResponse(context.Background(), func(ctx context.Context) *graphql.Response {
+			var wg sync.WaitGroup
+			for i, k := range keys {
+				wg.Add(1)
+				go func(i, k int) {
+					defer wg.Done()
+					values[i], _, errs[i] = entgql.LoadEdge(ctx, "T.e", k, func(ctx context.Context, keys []interface{}) (map[interface{}]interface{}, error) {
+						mu.Lock()
+						batches = append(batches, keys)
+						mu.Unlock()
+						return fn(ctx, keys)
+					})
+				}(i, k)
+			}
+			wg.Wait()
+			return &graphql.Response{}
+		})
+		return batches, values, errs
+	}
+	double := func(_ context.Context, keys []interface{}) (map[interface{}]interface{}, error) {
+		values := make(map[interface{}]interface{})
+		for _, k := range keys {
+			if k := k.(int); k > 0 {
+				values[k] = 2 * k
+			}
+		}
+		return values, nil
+	}
+
+	t.Run("NoLoader", func(t *testing.T) {
+		v, ok, err := entgql.LoadEdge(context.Background(), "T.e", 1, double)
+		require.NoError(t, err)
+		require.False(t, ok)
+		require.Nil(t, v)
+	})
+	t.Run("Validate", func(t *testing.T) {
+		require.Error(t, entgql.EdgeLoader{Wait: -1}.Validate(nil))
+		require.Error(t, entgql.EdgeLoader{MaxBatch: -1}.Validate(nil))
+		require.NoError(t, entgql.EdgeLoader{}.Validate(nil))
+	})
+	t.Run("Batch", func(t *testing.T) {
+		batches, values, errs := load(entgql.EdgeLoader{Wait: 10 * time.Millisecond}, double, 1, 2, 2, 0, 3)
+		require.Len(t, batches, 1)
+		require.ElementsMatch(t, []interface{}{0, 1, 2, 3}, batches[0])
+		require.Equal(t, []interface{}{2, 4, 4, nil, 6}, values)
+		require.Equal(t, make([]error, 5), errs)
+	})
+	t.Run("MaxBatch", func(t *testing.T) {
+		batches, values, _ := load(entgql.EdgeLoader{Wait: time.Second, MaxBatch: 2}, double, 1, 2, 3, 4)
+		require.Len(t, batches, 2)
+		for _, b := range batches {
+			require.Len(t, b, 2)
+		}
+		require.Equal(t, []interface{}{2, 4, 6, 8}, values)
+	})
+	t.Run("Err", func(t *testing.T) {
+		_, _, errs := load(entgql.EdgeLoader{}, func(context.Context, []interface{}) (map[interface{}]interface{}, error) {
+			return nil, errors.New("boom")
+		}, 1, 2)
+		for _, err := range errs {
+			require.EqualError(t, err, "boom")
+		}
+	})
+	t.Run("Panic", func(t *testing.T) {
+		_, _, errs := load(entgql.EdgeLoader{}, func(context.Context, []interface{}) (map[interface{}]interface{}, error) {
+			panic("boom")
+		}, 1)
+		require.EqualError(t, errs[0], "entgql: loading edge T.e: boom")
+	})
+}
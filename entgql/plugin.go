@@ -0,0 +1,243 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"github.com/99designs/gqlgen/api"
+	"github.com/99designs/gqlgen/codegen"
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/codegen/templates"
+	"github.com/99designs/gqlgen/plugin"
+)
+
+// Plugin is a gqlgen plugin that binds the GraphQL types generated for an ent graph
+// to their Go types, instead of listing them in the models section of gqlgen.yml.
+// It binds the Node interface, the node types and their connections, orderings and
+// inputs, the enums of their fields, the Cursor scalar and the ID scalar, and the
+// Apollo Federation types that are added by the FederationTemplate.
+//
+//	graph, err := entc.LoadGraph("./ent/schema", &gen.Config{
+//		Templates: entgql.AllTemplates,
+//	})
+//	if err != nil {
+//		log.Fatalf("loading ent graph: %v", err)
+//	}
+//	cfg, err := config.LoadConfigFromDefaultLocations()
+//	if err != nil {
+//		log.Fatalf("loading gqlgen config: %v", err)
+//	}
+//	if err := api.Generate(cfg, entgql.NewPlugin(graph).Option()); err != nil {
+//		log.Fatalf("running gqlgen: %v", err)
+//	}
+//
+// Types that are bound in the config are kept as is. Note that gqlgen binds the ID
+// scalar to its builtin types when it is not bound in the config, and therefore the
+// plugin replaces these bindings with the Go type of the node ids.
+type Plugin struct {
+	graph *gen.Graph
+}
+
+var (
+	_ plugin.Plugin        = (*Plugin)(nil)
+	_ plugin.ConfigMutator = (*Plugin)(nil)
+	_ plugin.CodeGenerator = (*Plugin)(nil)
+)
+
+// NewPlugin returns a gqlgen plugin that binds the types of the given graph.
+func NewPlugin(graph *gen.Graph) *Plugin {
+	return &Plugin{graph: graph}
+}
+
+// Name implements plugin.Plugin interface.
+func (*Plugin) Name() string {
+	return "entgql"
+}
+
+// Option returns the gqlgen API option for adding the plugin. Unlike api.AddPlugin,
+// it adds the plugin before the plugins of gqlgen, as the model generation plugin
+// generates models for the types that are not bound when it mutates the config.
+func (p *Plugin) Option() api.Option {
+	return func(_ *config.Config, plugins *[]plugin.Plugin) {
+		*plugins = append([]plugin.Plugin{p}, *plugins...)
+	}
+}
+
+// MutateConfig implements plugin.ConfigMutator interface.
+func (p *Plugin) MutateConfig(cfg *config.Config) error {
+	models, err := p.models()
+	if err != nil {
+		return err
+	}
+	if cfg.Models == nil {
+		cfg.Models = make(config.TypeMap)
+	}
+	for name, model := range models {
+		// Skip types that are not defined by the GraphQL schema.
+		if cfg.Schema != nil && cfg.Schema.Types[name] == nil {
+			continue
+		}
+		if !cfg.Models.UserDefined(name) {
+			cfg.Models.Add(name, model)
+		}
+	}
+	// The @key directive of the federation is used only by the gateway.
+	if _, ok := cfg.Directives[keyDirective]; !ok && cfg.Schema != nil && cfg.Schema.Directives[keyDirective] != nil {
+		if cfg.Directives == nil {
+			cfg.Directives = make(map[string]config.DirectiveConfig)
+		}
+		cfg.Directives[keyDirective] = config.DirectiveConfig{SkipRuntime: true}
+	}
+	if hasTemplate(p.graph, "globalid") || !builtinID(cfg.Models) {
+		return nil
+	}
+	model, err := p.idModel()
+	if err != nil {
+		return err
+	}
+	cfg.Models["ID"] = config.TypeMapEntry{Model: config.StringList{model}}
+	return nil
+}
+
+// GenerateCode implements plugin.CodeGenerator interface. If the schema was generated
+// with the FederationTemplate, it generates the resolution of the _entities and the
+// _service fields in the federation.go file of the executable schema package. Note
+// that gqlgen resolves these fields using the execution context, rather than the
+// resolvers, and therefore the root resolver is expected to implement the generated
+// EntityResolver interface.
+func (p *Plugin) GenerateCode(data *codegen.Data) error {
+	if data.Schema.Query == nil || data.Schema.Query.Fields.ForName(entitiesField) == nil {
+		return nil
+	}
+	return templates.Render(templates.Options{
+		PackageName:     data.Config.Exec.Package,
+		Filename:        filepath.Join(data.Config.Exec.Dir(), "federation.go"),
+		Template:        federationExec,
+		Data:            p.graph.Config,
+		GeneratedHeader: true,
+		Packages:        data.Config.Packages,
+	})
+}
+
+// models returns the Go types of the GraphQL types of the graph, by their name.
+func (p *Plugin) models() (map[string]string, error) {
+	pkg := p.graph.Config.Package
+	models := map[string]string{
+		nodeInterface:  pkg + ".Noder",
+		cursorScalar:   pkg + ".Cursor",
+		pageInfoType:   pkg + ".PageInfo",
+		orderDirection: pkg + ".OrderDirection",
+		"EventOp":      "entgo.io/contrib/entgql.EventOp",
+		entityUnion:    pkg + ".Noder",
+		anyScalar:      "github.com/99designs/gqlgen/graphql.Map",
+		fieldSetScalar: "github.com/99designs/gqlgen/graphql.String",
+		serviceType:    "github.com/99designs/gqlgen/plugin/federation/fedruntime.Service",
+	}
+	for _, t := range p.graph.Nodes {
+		for _, name := range []string{
+			t.Name,
+			t.Name + "Connection",
+			t.Name + "Edge",
+			t.Name + "Order",
+			t.Name + "OrderField",
+			t.Name + "WhereInput",
+			t.Name + "Event",
+			"Create" + t.Name + "Input",
+			"Update" + t.Name + "Input",
+		} {
+			models[name] = pkg + "." + name
+		}
+		fields, err := gqlFields(t)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range fields {
+			ant, err := annotation(f.Annotations)
+			if err != nil {
+				return nil, err
+			}
+			if !f.IsEnum() || ant.Type != "" {
+				continue
+			}
+			name := enumName(f)
+			switch {
+			case f.Type.PkgPath != "":
+				models[name] = f.Type.PkgPath + "." + name
+			default:
+				models[name] = path.Join(pkg, t.Package()) + "." + name
+			}
+		}
+	}
+	return models, nil
+}
+
+// idModel returns the Go type that the ID scalar is bound to. That is, the
+// gqlgen type that matches the id type of the nodes, or the type itself if
+// it implements the graphql.Marshaler interface.
+func (p *Plugin) idModel() (string, error) {
+	t := nodeIDType(p.graph.Nodes, p.graph.IDType)
+	switch {
+	case marshaler(t):
+		return t.RType.PkgPath + "." + t.RType.Name, nil
+	case t.Type == field.TypeInt:
+		return "github.com/99designs/gqlgen/graphql.IntID", nil
+	case t.Type == field.TypeInt64:
+		return "github.com/99designs/gqlgen/graphql.Int64", nil
+	case t.Type == field.TypeInt32:
+		return "github.com/99designs/gqlgen/graphql.Int32", nil
+	case t.Type == field.TypeString:
+		return "github.com/99designs/gqlgen/graphql.ID", nil
+	default:
+		return "", fmt.Errorf("entgql: id type %s does not implement graphql.Marshaler, bind the ID scalar in the gqlgen config", t)
+	}
+}
+
+// builtinIDs are the types that gqlgen binds the ID scalar to by default.
+var builtinIDs = config.StringList{
+	"github.com/99designs/gqlgen/graphql.ID",
+	"github.com/99designs/gqlgen/graphql.IntID",
+}
+
+// builtinID reports if the ID scalar is not bound,
+// or bound only to the builtin types of gqlgen.
+func builtinID(models config.TypeMap) bool {
+	ids := models["ID"].Model
+	if len(ids) == 0 {
+		return true
+	}
+	if len(ids) != len(builtinIDs) {
+		return false
+	}
+	for i := range ids {
+		if ids[i] != builtinIDs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// marshaler reports if the given Go type implements the graphql.Marshaler interface.
+func marshaler(t *field.TypeInfo) bool {
+	if t.RType == nil {
+		return false
+	}
+	_, ok := t.RType.Methods["MarshalGQL"]
+	return ok
+}
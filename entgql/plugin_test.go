@@ -0,0 +1,159 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/todo/ent/schema"
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/99designs/gqlgen/codegen/config"
+	"github.com/99designs/gqlgen/plugin"
+	"github.com/99designs/gqlgen/plugin/modelgen"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestPlugin(t *testing.T) {
+	t.Parallel()
+	const pkg = "entgo.io/contrib/entgql/internal/todo/ent"
+	g := newGraph(t, schema.Todo{})
+	p := entgql.NewPlugin(g)
+	require.Equal(t, "entgql", p.Name())
+
+	t.Run("Models", func(t *testing.T) {
+		cfg := config.DefaultConfig()
+		require.NoError(t, p.MutateConfig(cfg))
+		for name, model := range map[string]string{
+			"Node":            pkg + ".Noder",
+			"Cursor":          pkg + ".Cursor",
+			"Todo":            pkg + ".Todo",
+			"TodoConnection":  pkg + ".TodoConnection",
+			"TodoOrderField":  pkg + ".TodoOrderField",
+			"TodoWhereInput":  pkg + ".TodoWhereInput",
+			"CreateTodoInput": pkg + ".CreateTodoInput",
+			"Status":          pkg + "/todo.Status",
+			"ID":              "github.com/99designs/gqlgen/graphql.IntID",
+		} {
+			require.Equal(t, config.StringList{model}, cfg.Models[name].Model, name)
+		}
+	})
+
+	t.Run("UserDefined", func(t *testing.T) {
+		cfg := config.DefaultConfig()
+		cfg.Models = config.TypeMap{
+			"ID":     {Model: config.StringList{"example.com/ids.ID"}},
+			"Status": {Model: config.StringList{"example.com/todo.Status"}},
+		}
+		require.NoError(t, p.MutateConfig(cfg))
+		require.Equal(t, config.StringList{"example.com/ids.ID"}, cfg.Models["ID"].Model)
+		require.Equal(t, config.StringList{"example.com/todo.Status"}, cfg.Models["Status"].Model)
+		require.Equal(t, config.StringList{pkg + ".Todo"}, cfg.Models["Todo"].Model)
+	})
+
+	t.Run("Schema", func(t *testing.T) {
+		buf, err := entgql.GenerateSchema(g)
+		require.NoError(t, err)
+		cfg := config.DefaultConfig()
+		s, gerr := gqlparser.LoadSchema(&ast.Source{Name: "ent.graphql", Input: string(buf)})
+		require.Nil(t, gerr)
+		cfg.Schema = s
+		// Builtin bindings of the ID scalar are replaced.
+		cfg.Models["ID"] = config.TypeMapEntry{Model: config.StringList{
+			"github.com/99designs/gqlgen/graphql.ID",
+			"github.com/99designs/gqlgen/graphql.IntID",
+		}}
+		require.NoError(t, p.MutateConfig(cfg))
+		require.Equal(t, config.StringList{"github.com/99designs/gqlgen/graphql.IntID"}, cfg.Models["ID"].Model)
+		require.True(t, cfg.Models.Exists("TodoConnection"))
+		require.False(t, cfg.Models.Exists("TodoEvent"), "types that are not in the schema are not bound")
+		require.False(t, cfg.Models.Exists("EventOp"), "types that are not in the schema are not bound")
+	})
+
+	t.Run("Federation", func(t *testing.T) {
+		buf, err := entgql.GenerateSchema(newFederatedGraph(t, schema.Todo{}))
+		require.NoError(t, err)
+		cfg := config.DefaultConfig()
+		s, gerr := gqlparser.LoadSchema(&ast.Source{Name: "ent.graphql", Input: "type Query { todos: [Todo] }\n" + string(buf)})
+		require.Nil(t, gerr)
+		cfg.Schema = s
+		require.NoError(t, p.MutateConfig(cfg))
+		require.Equal(t, config.StringList{pkg + ".Noder"}, cfg.Models["_Entity"].Model)
+		require.Equal(t, config.StringList{"github.com/99designs/gqlgen/graphql.Map"}, cfg.Models["_Any"].Model)
+		require.Equal(t, config.StringList{"github.com/99designs/gqlgen/plugin/federation/fedruntime.Service"}, cfg.Models["_Service"].Model)
+		require.True(t, cfg.Directives["key"].SkipRuntime, "the key directive is not resolved at runtime")
+	})
+
+	t.Run("IDs", func(t *testing.T) {
+		for _, tt := range []struct {
+			schema ent.Interface
+			model  string
+		}{
+			{schema: Int64Node{}, model: "github.com/99designs/gqlgen/graphql.Int64"},
+			{schema: Int32Node{}, model: "github.com/99designs/gqlgen/graphql.Int32"},
+			{schema: Uint64Node{}},
+		} {
+			cfg := config.DefaultConfig()
+			err := entgql.NewPlugin(newGraph(t, tt.schema)).MutateConfig(cfg)
+			if tt.model == "" {
+				require.Error(t, err, "unsigned ids have no gqlgen marshaler")
+				continue
+			}
+			require.NoError(t, err)
+			require.Equal(t, config.StringList{tt.model}, cfg.Models["ID"].Model)
+		}
+	})
+
+	t.Run("GlobalID", func(t *testing.T) {
+		g := newGraphWith(t, append(entgql.AllTemplates[:len(entgql.AllTemplates):len(entgql.AllTemplates)], entgql.GlobalIDTemplate), schema.Todo{})
+		cfg := config.DefaultConfig()
+		require.NoError(t, entgql.NewPlugin(g).MutateConfig(cfg))
+		require.Empty(t, cfg.Models["ID"].Model, "global and local ids keep the default bindings of the ID scalar")
+	})
+
+	t.Run("Option", func(t *testing.T) {
+		plugins := []plugin.Plugin{modelgen.New()}
+		p.Option()(config.DefaultConfig(), &plugins)
+		require.Len(t, plugins, 2)
+		require.Equal(t, p, plugins[0], "plugin is added before the model generation")
+	})
+}
+
+type Int64Node struct{ ent.Schema }
+
+func (Int64Node) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+	}
+}
+
+type Int32Node struct{ ent.Schema }
+
+func (Int32Node) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int32("id"),
+	}
+}
+
+type Uint64Node struct{ ent.Schema }
+
+func (Uint64Node) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint64("id"),
+	}
+}
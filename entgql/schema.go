@@ -0,0 +1,685 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+)
+
+// SchemaGenerator returns a codegen hook that generates the GraphQL schema (SDL) of
+// the graph and writes it to the given path. The schema covers the types, enums,
+// connections and orderings that are generated by the templates in this package.
+//
+//	entc.Generate("./schema", &gen.Config{
+//		Templates: entgql.AllTemplates,
+//		Hooks: []gen.Hook{
+//			entgql.SchemaGenerator("../ent.graphql"),
+//		},
+//	})
+//
+func SchemaGenerator(path string) gen.Hook {
+	return func(next gen.Generator) gen.Generator {
+		return gen.GenerateFunc(func(g *gen.Graph) error {
+			if err := next.Generate(g); err != nil {
+				return err
+			}
+			buf, err := GenerateSchema(g)
+			if err != nil {
+				return err
+			}
+			if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+				return fmt.Errorf("entgql: create schema dir: %w", err)
+			}
+			if err := ioutil.WriteFile(path, buf, 0644); err != nil {
+				return fmt.Errorf("entgql: write schema file: %w", err)
+			}
+			return nil
+		})
+	}
+}
+
+// GenerateSchema returns the GraphQL schema (SDL) of the given graph.
+func GenerateSchema(g *gen.Graph) ([]byte, error) {
+	doc, err := newSchema(g).build()
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	b.WriteString("# Code generated by entgql, DO NOT EDIT.\n")
+	for _, dir := range doc.Directives {
+		b.WriteByte('\n')
+		formatter.NewFormatter(&b).FormatSchemaDocument(&ast.SchemaDocument{
+			Directives: ast.DirectiveDefinitionList{dir},
+		})
+	}
+	for _, def := range doc.Definitions {
+		b.WriteByte('\n')
+		formatter.NewFormatter(&b).FormatSchemaDocument(&ast.SchemaDocument{
+			Definitions: ast.DefinitionList{def},
+		})
+	}
+	for _, def := range doc.Extensions {
+		b.WriteByte('\n')
+		formatter.NewFormatter(&b).FormatSchemaDocument(&ast.SchemaDocument{
+			Extensions: ast.DefinitionList{def},
+		})
+	}
+	return b.Bytes(), nil
+}
+
+const (
+	nodeInterface  = "Node"
+	cursorScalar   = "Cursor"
+	pageInfoType   = "PageInfo"
+	orderDirection = "OrderDirection"
+)
+
+type gqlSchema struct {
+	graph *gen.Graph
+	doc   *ast.SchemaDocument
+	// scalars that are used by the node fields.
+	scalars []string
+	// enums holds the enum definitions by their name.
+	enums map[string]*ast.Definition
+}
+
+func newSchema(g *gen.Graph) *gqlSchema {
+	return &gqlSchema{
+		graph: g,
+		doc:   &ast.SchemaDocument{},
+		enums: make(map[string]*ast.Definition),
+	}
+}
+
+// build builds the schema document from the graph.
+func (s *gqlSchema) build() (*ast.SchemaDocument, error) {
+	var defs ast.DefinitionList
+	for _, t := range s.graph.Nodes {
+		tdefs, err := s.typeDefs(t)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, tdefs...)
+	}
+	if hasTemplate(s.graph, "node") {
+		s.doc.Definitions = append(s.doc.Definitions, &ast.Definition{
+			Kind: ast.Interface,
+			Name: nodeInterface,
+			Fields: ast.FieldList{
+				{Name: "id", Type: ast.NonNullNamedType("ID", nil)},
+			},
+		})
+	}
+	if hasTemplate(s.graph, "pagination") {
+		s.scalars = append([]string{cursorScalar}, s.scalars...)
+	}
+	for _, name := range s.scalars {
+		s.doc.Definitions = append(s.doc.Definitions, &ast.Definition{
+			Kind: ast.Scalar,
+			Name: name,
+		})
+	}
+	if hasTemplate(s.graph, "pagination") {
+		s.doc.Definitions = append(s.doc.Definitions,
+			&ast.Definition{
+				Kind: ast.Object,
+				Name: pageInfoType,
+				Fields: ast.FieldList{
+					{Name: "hasNextPage", Type: ast.NonNullNamedType("Boolean", nil)},
+					{Name: "hasPreviousPage", Type: ast.NonNullNamedType("Boolean", nil)},
+					{Name: "startCursor", Type: ast.NamedType(cursorScalar, nil)},
+					{Name: "endCursor", Type: ast.NamedType(cursorScalar, nil)},
+				},
+			},
+			&ast.Definition{
+				Kind: ast.Enum,
+				Name: orderDirection,
+				EnumValues: ast.EnumValueList{
+					{Name: "ASC"},
+					{Name: "DESC"},
+				},
+			},
+		)
+	}
+	s.doc.Definitions = append(s.doc.Definitions, defs...)
+	if hasTemplate(s.graph, "federation") {
+		s.federation()
+	}
+	return s.doc, nil
+}
+
+// federation adds the types, directives and fields of the Apollo Federation spec.
+// The _entities field resolves the entity representations using Client.Entities.
+func (s *gqlSchema) federation() {
+	entities := make([]string, 0, len(s.graph.Nodes))
+	for _, t := range s.graph.Nodes {
+		entities = append(entities, t.Name)
+	}
+	s.doc.Directives = append(s.doc.Directives, &ast.DirectiveDefinition{
+		Name: keyDirective,
+		Arguments: ast.ArgumentDefinitionList{
+			{Name: "fields", Type: ast.NonNullNamedType(fieldSetScalar, nil)},
+		},
+		Locations: []ast.DirectiveLocation{ast.LocationObject, ast.LocationInterface},
+		// The formatter skips the builtin directives by their source.
+		Position: &ast.Position{Src: &ast.Source{}},
+	})
+	s.doc.Definitions = append(s.doc.Definitions,
+		&ast.Definition{Kind: ast.Scalar, Name: anyScalar},
+		&ast.Definition{Kind: ast.Scalar, Name: fieldSetScalar},
+		&ast.Definition{Kind: ast.Union, Name: entityUnion, Types: entities},
+		&ast.Definition{
+			Kind: ast.Object,
+			Name: serviceType,
+			Fields: ast.FieldList{
+				{Name: "sdl", Type: ast.NamedType("String", nil)},
+			},
+		},
+	)
+	s.doc.Extensions = append(s.doc.Extensions, &ast.Definition{
+		Kind: ast.Object,
+		Name: "Query",
+		Fields: ast.FieldList{
+			{
+				Name: entitiesField,
+				Arguments: ast.ArgumentDefinitionList{
+					{Name: "representations", Type: ast.NonNullListType(ast.NonNullNamedType(anyScalar, nil), nil)},
+				},
+				Type: ast.NonNullListType(ast.NamedType(entityUnion, nil), nil),
+			},
+			{Name: serviceField, Type: ast.NonNullNamedType(serviceType, nil)},
+		},
+	})
+}
+
+// entityKey returns the @key directive of the given type, with the fields of its
+// Apollo Federation key.
+func (s *gqlSchema) entityKey(t *gen.Type) (*ast.Directive, error) {
+	keys, err := federationKeys(t, nodeIDType(s.graph.Nodes, s.graph.IDType))
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]string, len(keys))
+	for i, k := range keys {
+		fields[i] = k.Name
+	}
+	return &ast.Directive{
+		Name: keyDirective,
+		Arguments: ast.ArgumentList{
+			{Name: "fields", Value: &ast.Value{Kind: ast.StringValue, Raw: strings.Join(fields, " ")}},
+		},
+	}, nil
+}
+
+// typeDefs returns the definitions of the given type, its enums and its connection types.
+func (s *gqlSchema) typeDefs(t *gen.Type) (ast.DefinitionList, error) {
+	var defs ast.DefinitionList
+	def := &ast.Definition{
+		Kind: ast.Object,
+		Name: t.Name,
+		Fields: ast.FieldList{
+			{Name: "id", Type: ast.NonNullNamedType("ID", nil)},
+		},
+	}
+	if hasTemplate(s.graph, "node") {
+		def.Interfaces = append(def.Interfaces, nodeInterface)
+	}
+	fields, err := gqlFields(t)
+	if err != nil {
+		return nil, err
+	}
+	edges, err := gqlEdges(t)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range fields {
+		if f.Sensitive() {
+			continue
+		}
+		typ, enum, err := s.fieldType(t, f)
+		if err != nil {
+			return nil, err
+		}
+		if enum != nil {
+			defs = append(defs, enum)
+		}
+		def.Fields = append(def.Fields, &ast.FieldDefinition{
+			Name:        camel(f.Name),
+			Type:        typ,
+			Description: f.Comment(),
+		})
+	}
+	for _, e := range edges {
+		fd, err := s.edgeField(t, e)
+		if err != nil {
+			return nil, err
+		}
+		def.Fields = append(def.Fields, fd)
+	}
+	if hasTemplate(s.graph, "federation") {
+		key, err := s.entityKey(t)
+		if err != nil {
+			return nil, err
+		}
+		def.Directives = append(def.Directives, key)
+	}
+	defs = append(defs, def)
+	if hasTemplate(s.graph, "where_input") {
+		input, err := s.whereInputDef(t)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, input)
+	}
+	if hasTemplate(s.graph, "mutation_input") {
+		inputs, err := s.mutationInputDefs(t)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, inputs...)
+	}
+	if hasTemplate(s.graph, "pagination") {
+		order, err := s.orderDefs(t)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, s.connectionDefs(t)...)
+		defs = append(defs, order...)
+	}
+	return defs, nil
+}
+
+// edgeField returns the field definition of the given edge. Connection edges
+// are exposed with the pagination arguments of their neighbours connection.
+func (s *gqlSchema) edgeField(t *gen.Type, e *gen.Edge) (*ast.FieldDefinition, error) {
+	fd := &ast.FieldDefinition{
+		Name: camel(e.Name),
+		Type: ast.NamedType(e.Type.Name, nil),
+	}
+	conn, err := isConnection(t, e)
+	switch {
+	case err != nil:
+		return nil, err
+	case conn && !hasTemplate(s.graph, "pagination"):
+		return nil, fmt.Errorf("entgql: connection edge %s.%s requires the pagination template", t.Name, e.Name)
+	case conn:
+		fd.Type = ast.NonNullNamedType(e.Type.Name+"Connection", nil)
+		fd.Arguments = ast.ArgumentDefinitionList{
+			{Name: "after", Type: ast.NamedType(cursorScalar, nil)},
+			{Name: "first", Type: ast.NamedType("Int", nil)},
+			{Name: "before", Type: ast.NamedType(cursorScalar, nil)},
+			{Name: "last", Type: ast.NamedType("Int", nil)},
+		}
+		order, err := hasOrder(e.Type)
+		if err != nil {
+			return nil, err
+		}
+		if order {
+			fd.Arguments = append(fd.Arguments, &ast.ArgumentDefinition{
+				Name: "orderBy",
+				Type: ast.ListType(ast.NonNullNamedType(e.Type.Name+"Order", nil), nil),
+			})
+		}
+	case !e.Unique:
+		fd.Type = ast.ListType(ast.NonNullNamedType(e.Type.Name, nil), nil)
+	case !e.Optional:
+		fd.Type.NonNull = true
+	}
+	return fd, nil
+}
+
+// connectionDefs returns the connection and the edge types of the given type.
+func (s *gqlSchema) connectionDefs(t *gen.Type) ast.DefinitionList {
+	return ast.DefinitionList{
+		{
+			Kind: ast.Object,
+			Name: t.Name + "Connection",
+			Fields: ast.FieldList{
+				{Name: "totalCount", Type: ast.NonNullNamedType("Int", nil)},
+				{Name: "pageInfo", Type: ast.NonNullNamedType(pageInfoType, nil)},
+				{Name: "edges", Type: ast.ListType(ast.NamedType(t.Name+"Edge", nil), nil)},
+			},
+		},
+		{
+			Kind: ast.Object,
+			Name: t.Name + "Edge",
+			Fields: ast.FieldList{
+				{Name: "node", Type: ast.NamedType(t.Name, nil)},
+				{Name: "cursor", Type: ast.NonNullNamedType(cursorScalar, nil)},
+			},
+		},
+	}
+}
+
+// whereInputDef returns the where input of the given type. Its fields are
+// aligned with the ones that are generated by the where_input template.
+func (s *gqlSchema) whereInputDef(t *gen.Type) (*ast.Definition, error) {
+	name := t.Name + "WhereInput"
+	def := &ast.Definition{
+		Kind: ast.InputObject,
+		Name: name,
+		Fields: ast.FieldList{
+			{Name: "not", Type: ast.NamedType(name, nil)},
+			{Name: "and", Type: ast.ListType(ast.NonNullNamedType(name, nil), nil)},
+			{Name: "or", Type: ast.ListType(ast.NonNullNamedType(name, nil), nil)},
+		},
+	}
+	fields, err := whereFields(t)
+	if err != nil {
+		return nil, err
+	}
+	edges, err := gqlEdges(t)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range fields {
+		typ := ast.NamedType("ID", nil)
+		if f != t.ID {
+			ft, _, err := s.fieldType(t, f)
+			if err != nil {
+				return nil, err
+			}
+			typ = ast.NamedType(ft.NamedType, nil)
+		}
+		for _, op := range whereOps(t, f) {
+			fd := &ast.FieldDefinition{
+				Name: camel(f.Name) + whereOpSuffix(op),
+				Type: typ,
+			}
+			switch {
+			case op.Niladic():
+				fd.Type = ast.NamedType("Boolean", nil)
+			case op.Variadic():
+				fd.Type = ast.ListType(ast.NonNullNamedType(typ.NamedType, nil), nil)
+			}
+			def.Fields = append(def.Fields, fd)
+		}
+	}
+	for _, e := range edges {
+		def.Fields = append(def.Fields,
+			&ast.FieldDefinition{
+				Name: "has" + e.StructField(),
+				Type: ast.NamedType("Boolean", nil),
+			},
+			&ast.FieldDefinition{
+				Name: "has" + e.StructField() + "With",
+				Type: ast.ListType(ast.NonNullNamedType(e.Type.Name+"WhereInput", nil), nil),
+			},
+		)
+	}
+	return def, nil
+}
+
+// mutationInputDefs returns the create and update inputs of the given type. Their
+// fields are aligned with the ones that are generated by the mutation_input template.
+func (s *gqlSchema) mutationInputDefs(t *gen.Type) (ast.DefinitionList, error) {
+	create := &ast.Definition{Kind: ast.InputObject, Name: "Create" + t.Name + "Input"}
+	update := &ast.Definition{Kind: ast.InputObject, Name: "Update" + t.Name + "Input"}
+	fields, err := gqlFields(t)
+	if err != nil {
+		return nil, err
+	}
+	edges, err := gqlEdges(t)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range fields {
+		if f.IsEdgeField() {
+			continue
+		}
+		typ, _, err := s.fieldType(t, f)
+		if err != nil {
+			return nil, err
+		}
+		create.Fields = append(create.Fields, &ast.FieldDefinition{
+			Name: camel(f.Name),
+			Type: &ast.Type{NamedType: typ.NamedType, NonNull: !f.Optional && !f.Default},
+		})
+		if f.Immutable {
+			continue
+		}
+		update.Fields = append(update.Fields, &ast.FieldDefinition{
+			Name: camel(f.Name),
+			Type: ast.NamedType(typ.NamedType, nil),
+		})
+		if f.Optional {
+			update.Fields = append(update.Fields, &ast.FieldDefinition{
+				Name: "clear" + f.StructField(),
+				Type: ast.NamedType("Boolean", nil),
+			})
+		}
+	}
+	for _, e := range edges {
+		if !e.Unique {
+			create.Fields = append(create.Fields, &ast.FieldDefinition{
+				Name: camel(singular(e.Name)) + "IDs",
+				Type: ast.ListType(ast.NonNullNamedType("ID", nil), nil),
+			})
+			update.Fields = append(update.Fields,
+				&ast.FieldDefinition{
+					Name: "add" + strings.TrimPrefix(e.MutationAdd(), "Add"),
+					Type: ast.ListType(ast.NonNullNamedType("ID", nil), nil),
+				},
+				&ast.FieldDefinition{
+					Name: "remove" + strings.TrimPrefix(e.MutationRemove(), "Remove"),
+					Type: ast.ListType(ast.NonNullNamedType("ID", nil), nil),
+				},
+			)
+			continue
+		}
+		name := camel(e.Name) + "ID"
+		create.Fields = append(create.Fields, &ast.FieldDefinition{
+			Name: name,
+			Type: &ast.Type{NamedType: "ID", NonNull: !e.Optional},
+		})
+		update.Fields = append(update.Fields, &ast.FieldDefinition{
+			Name: name,
+			Type: ast.NamedType("ID", nil),
+		})
+		if e.Optional {
+			update.Fields = append(update.Fields, &ast.FieldDefinition{
+				Name: "clear" + strings.TrimPrefix(e.MutationClear(), "Clear"),
+				Type: ast.NamedType("Boolean", nil),
+			})
+		}
+	}
+	return ast.DefinitionList{create, update}, nil
+}
+
+// orderDefs returns the order field enum and the order input of the given type.
+// The order field values follow the pagination template, which orders by the ID
+// field and the fields of the type that were annotated with an OrderField.
+func (s *gqlSchema) orderDefs(t *gen.Type) (ast.DefinitionList, error) {
+	enum := &ast.Definition{
+		Kind: ast.Enum,
+		Name: t.Name + "OrderField",
+	}
+	fields, err := orderFields(t)
+	if err != nil {
+		return nil, err
+	}
+	edges, err := orderEdges(t)
+	if err != nil {
+		return nil, err
+	}
+	annotations := make([]gen.Annotations, 0, len(fields)+len(edges))
+	for _, f := range fields {
+		annotations = append(annotations, f.Annotations)
+	}
+	for _, e := range edges {
+		annotations = append(annotations, e.Annotations)
+	}
+	for _, ants := range annotations {
+		ant, err := annotation(ants)
+		if err != nil {
+			return nil, err
+		}
+		enum.EnumValues = append(enum.EnumValues, &ast.EnumValueDefinition{
+			Name: ant.OrderField,
+		})
+	}
+	if len(enum.EnumValues) == 0 {
+		return nil, nil
+	}
+	return ast.DefinitionList{
+		enum,
+		{
+			Kind: ast.InputObject,
+			Name: t.Name + "Order",
+			Fields: ast.FieldList{
+				{Name: "direction", Type: ast.NonNullNamedType(orderDirection, nil)},
+				{Name: "field", Type: ast.NamedType(enum.Name, nil)},
+			},
+		},
+	}, nil
+}
+
+// fieldType returns the GraphQL type of the given field. For enum fields, it returns also
+// the enum definition, if it was not defined before by another field of the graph.
+func (s *gqlSchema) fieldType(t *gen.Type, f *gen.Field) (*ast.Type, *ast.Definition, error) {
+	ant, err := annotation(f.Annotations)
+	if err != nil {
+		return nil, nil, err
+	}
+	var (
+		name string
+		enum *ast.Definition
+	)
+	switch ft := f.Type.Type; {
+	case ant.Type != "":
+		name = ant.Type
+	case ft == field.TypeBool:
+		name = "Boolean"
+	case ft == field.TypeString:
+		name = "String"
+	case ft.Integer():
+		name = "Int"
+	case ft.Float():
+		name = "Float"
+	case ft == field.TypeTime:
+		name = "Time"
+		s.scalar(name)
+	case ft == field.TypeUUID:
+		name = "UUID"
+		s.scalar(name)
+	case ft == field.TypeEnum:
+		if name, enum, err = s.enum(t, f); err != nil {
+			return nil, nil, err
+		}
+	default:
+		return nil, nil, fmt.Errorf("entgql: unsupported type %s for field %s.%s, use the entgql.Type annotation to set its GraphQL type", f.Type, t.Name, f.Name)
+	}
+	return &ast.Type{NamedType: name, NonNull: !f.Optional && !f.Nillable}, enum, nil
+}
+
+// enum returns the name of the enum type of the given field and its definition,
+// if it was not defined before by another field that shares the same enum type.
+// The values of the enum are named by the EnumValues annotation of the field.
+func (s *gqlSchema) enum(t *gen.Type, f *gen.Field) (string, *ast.Definition, error) {
+	name := enumName(f)
+	def := &ast.Definition{Kind: ast.Enum, Name: name}
+	values, err := enumValues(t, f)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, v := range values {
+		def.EnumValues = append(def.EnumValues, &ast.EnumValueDefinition{Name: v.Name})
+	}
+	if values == nil {
+		for _, v := range f.EnumValues() {
+			if !validName(v) {
+				return "", nil, fmt.Errorf("entgql: enum value %q of field %s.%s is not a valid GraphQL name", v, t.Name, f.Name)
+			}
+			def.EnumValues = append(def.EnumValues, &ast.EnumValueDefinition{Name: v})
+		}
+	}
+	prev, ok := s.enums[name]
+	if !ok {
+		s.enums[name] = def
+		return name, def, nil
+	}
+	if len(prev.EnumValues) != len(def.EnumValues) {
+		return "", nil, fmt.Errorf("entgql: enum %s of field %s.%s conflicts with a previously defined enum", name, t.Name, f.Name)
+	}
+	for i := range prev.EnumValues {
+		if prev.EnumValues[i].Name != def.EnumValues[i].Name {
+			return "", nil, fmt.Errorf("entgql: enum %s of field %s.%s conflicts with a previously defined enum", name, t.Name, f.Name)
+		}
+	}
+	return name, nil, nil
+}
+
+// enumName returns the GraphQL name of the enum type of the given
+// field, which is the name of its Go type without the package.
+func enumName(f *gen.Field) string {
+	name := f.Type.Ident
+	if i := strings.LastIndexByte(name, '.'); i != -1 {
+		name = name[i+1:]
+	}
+	return name
+}
+
+// scalar adds a custom scalar to the schema, if it was not added before.
+func (s *gqlSchema) scalar(name string) {
+	for i := range s.scalars {
+		if s.scalars[i] == name {
+			return
+		}
+	}
+	s.scalars = append(s.scalars, name)
+}
+
+// annotation decodes the entgql annotation from the given annotations map.
+func annotation(ants gen.Annotations) (*Annotation, error) {
+	ant := &Annotation{}
+	if ants != nil && ants[ant.Name()] != nil {
+		if err := ant.Decode(ants[ant.Name()]); err != nil {
+			return nil, err
+		}
+	}
+	return ant, nil
+}
+
+// validName reports if the given string is a valid GraphQL name.
+func validName(s string) bool {
+	return nameRegexp.MatchString(s)
+}
+
+// hasTemplate reports if the graph is generated with the given template.
+func hasTemplate(g *gen.Graph, name string) bool {
+	for _, t := range g.Templates {
+		if t.Lookup(name) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	singular   = gen.Funcs["singular"].(func(string) string)
+	camel      = gen.Funcs["camel"].(func(string) string)
+	nameRegexp = regexp.MustCompile("^[_A-Za-z][_0-9A-Za-z]*$")
+)
@@ -0,0 +1,271 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/todo/ent/schema"
+	"entgo.io/ent"
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/entc/load"
+	entschema "entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateSchema(t *testing.T) {
+	t.Parallel()
+	g := newFederatedGraph(t, schema.Todo{})
+	buf, err := entgql.GenerateSchema(g)
+	require.NoError(t, err)
+	expected, err := ioutil.ReadFile("internal/todo/ent.graphql")
+	require.NoError(t, err)
+	require.Equal(t, string(expected), string(buf))
+}
+
+type User struct{ ent.Schema }
+
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.String("password").
+			Sensitive(),
+		field.JSON("labels", []string{}).
+			Optional().
+			Annotations(entgql.Type("[String!]")),
+	}
+}
+
+type Group struct{ ent.Schema }
+
+func (Group) Fields() []ent.Field {
+	return []ent.Field{
+		field.Bytes("blob"),
+	}
+}
+
+type Team struct{ ent.Schema }
+
+func (Team) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("members", User.Type).
+			Annotations(entgql.OrderEdgeField("MEMBERS_NAME", "name")),
+	}
+}
+
+type Token struct{ ent.Schema }
+
+func (Token) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.String("hash").
+			Optional().
+			Annotations(entgql.Skip()),
+	}
+}
+
+func (Token) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("owner", User.Type).
+			Unique().
+			Annotations(entgql.Skip()),
+	}
+}
+
+type Tag struct{ ent.Schema }
+
+func (Tag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Annotations(entgql.Skip(), entgql.OrderField("NAME")),
+	}
+}
+
+type Task struct{ ent.Schema }
+
+func (Task) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("state").
+			Values("todo", "in_review", "done").
+			Annotations(entgql.EnumValues(map[string]string{
+				"in_review": "IN_REVIEW",
+			})),
+	}
+}
+
+type Job struct{ ent.Schema }
+
+func (Job) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("state").
+			Values("queued", "running").
+			Annotations(entgql.EnumValues(map[string]string{
+				"queued":  "QUEUED",
+				"stopped": "STOPPED",
+			})),
+	}
+}
+
+type Account struct{ ent.Schema }
+
+func (Account) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("bank_code"),
+		field.Int("number"),
+		field.String("nickname").
+			Optional(),
+	}
+}
+
+func (Account) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("bank_code", "number").
+			Unique(),
+	}
+}
+
+func (Account) Annotations() []entschema.Annotation {
+	return []entschema.Annotation{
+		entgql.Key("bank_code", "number"),
+	}
+}
+
+type Branch struct{ ent.Schema }
+
+func (Branch) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("bank_code"),
+		field.String("name"),
+	}
+}
+
+func (Branch) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("bank_code", "name"),
+	}
+}
+
+func (Branch) Annotations() []entschema.Annotation {
+	return []entschema.Annotation{
+		entgql.Key("bank_code", "name"),
+	}
+}
+
+type Card struct{ ent.Schema }
+
+func (Card) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("number").
+			Optional(),
+	}
+}
+
+func (Card) Annotations() []entschema.Annotation {
+	return []entschema.Annotation{
+		entgql.Key("number"),
+	}
+}
+
+func TestGenerateSchemaFields(t *testing.T) {
+	t.Parallel()
+	buf, err := entgql.GenerateSchema(newGraph(t, User{}))
+	require.NoError(t, err)
+	require.Contains(t, string(buf), "type User implements Node {\n\tid: ID!\n\tname: String!\n\tlabels: [String!]\n}")
+	require.NotContains(t, string(buf), "passwordNEQ", "sensitive fields are not filterable")
+	require.Contains(t, string(buf), "input CreateUserInput {\n\tname: String!\n\tpassword: String!\n")
+
+	_, err = entgql.GenerateSchema(newGraph(t, Group{}))
+	require.EqualError(t, err, "entgql: unsupported type []byte for field Group.blob, use the entgql.Type annotation to set its GraphQL type")
+
+	_, err = entgql.GenerateSchema(newGraph(t, User{}, Team{}))
+	require.EqualError(t, err, "entgql: non-unique edge Team.members can be ordered only by its count")
+}
+
+func TestGenerateSchemaSkip(t *testing.T) {
+	t.Parallel()
+	buf, err := entgql.GenerateSchema(newGraph(t, User{}, Token{}))
+	require.NoError(t, err)
+	require.Contains(t, string(buf), "type Token implements Node {\n\tid: ID!\n\tname: String!\n}")
+	require.Contains(t, string(buf), "input CreateTokenInput {\n\tname: String!\n}")
+	require.NotContains(t, string(buf), "hash", "skipped fields are omitted from the type and its inputs")
+	require.NotContains(t, string(buf), "owner", "skipped edges are omitted from the type and its inputs")
+
+	_, err = entgql.GenerateSchema(newGraph(t, Tag{}))
+	require.EqualError(t, err, "entgql: skipped field Tag.name cannot be an order field")
+}
+
+func TestGenerateSchemaEnumValues(t *testing.T) {
+	t.Parallel()
+	buf, err := entgql.GenerateSchema(newGraph(t, Task{}))
+	require.NoError(t, err)
+	require.Contains(t, string(buf), "enum State {\n\ttodo\n\tIN_REVIEW\n\tdone\n}")
+
+	_, err = entgql.GenerateSchema(newGraph(t, Job{}))
+	require.EqualError(t, err, `entgql: enum values annotation of field Job.state maps unknown values ["stopped"]`)
+}
+
+func TestGenerateSchemaFederation(t *testing.T) {
+	t.Parallel()
+	buf, err := entgql.GenerateSchema(newFederatedGraph(t, User{}, Account{}))
+	require.NoError(t, err)
+	require.Contains(t, string(buf), "directive @key(fields: _FieldSet!) on OBJECT | INTERFACE\n")
+	require.Contains(t, string(buf), "type User implements Node @key(fields: \"id\") {")
+	require.Contains(t, string(buf), "type Account implements Node @key(fields: \"bankCode number\") {")
+	require.Contains(t, string(buf), "union _Entity = User | Account\n")
+	require.Contains(t, string(buf), "extend type Query {\n\t_entities(representations: [_Any!]!): [_Entity]!\n\t_service: _Service!\n}")
+
+	buf, err = entgql.GenerateSchema(newGraph(t, Account{}))
+	require.NoError(t, err)
+	require.NotContains(t, string(buf), "@key", "federation is added only by its template")
+
+	_, err = entgql.GenerateSchema(newFederatedGraph(t, Card{}))
+	require.EqualError(t, err, `entgql: key of Card cannot reference the optional field "number"`)
+
+	_, err = entgql.GenerateSchema(newFederatedGraph(t, Branch{}))
+	require.EqualError(t, err, "entgql: key of Branch must be backed by a unique field or a unique index")
+}
+
+func newGraph(t *testing.T, schemas ...ent.Interface) *gen.Graph {
+	return newGraphWith(t, entgql.AllTemplates, schemas...)
+}
+
+func newFederatedGraph(t *testing.T, schemas ...ent.Interface) *gen.Graph {
+	return newGraphWith(t, append(entgql.AllTemplates[:len(entgql.AllTemplates):len(entgql.AllTemplates)], entgql.FederationTemplate), schemas...)
+}
+
+func newGraphWith(t *testing.T, templates []*gen.Template, schemas ...ent.Interface) *gen.Graph {
+	storage, err := gen.NewStorage("sql")
+	require.NoError(t, err)
+	var loaded []*load.Schema
+	for _, s := range schemas {
+		buf, err := load.MarshalSchema(s)
+		require.NoError(t, err)
+		ls, err := load.UnmarshalSchema(buf)
+		require.NoError(t, err)
+		loaded = append(loaded, ls)
+	}
+	g, err := gen.NewGraph(&gen.Config{
+		Package:   "entgo.io/contrib/entgql/internal/todo/ent",
+		Storage:   storage,
+		Templates: templates,
+	}, loaded...)
+	require.NoError(t, err)
+	return g
+}
@@ -15,9 +15,14 @@
 package entgql
 
 import (
+	"fmt"
+	"sort"
+	"text/template"
+
 	"entgo.io/contrib/entgql/internal"
 
 	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
 	_ "github.com/go-bindata/go-bindata"
 )
 
@@ -40,9 +45,51 @@ var (
 	// middleware. See transaction.go for for information.
 	TransactionTemplate = parse("template/transaction.tmpl")
 
+	// EventTemplate adds the change-event hooks of the types annotated with Events,
+	// and the Subscribe methods of their clients. See event.go for more information.
+	//
+	// Note that it is not part of the AllTemplates, and that it requires the
+	// TransactionTemplate for awaiting the commit of transactional mutations.
+	EventTemplate = parse("template/event.tmpl")
+
 	// EdgeTemplate adds edge resolution using eager-loading with a query fallback.
 	EdgeTemplate = parse("template/edge.tmpl")
 
+	// ErrorTemplate adds the GraphQL error extensions of the ent errors,
+	// which are used by the ErrorPresenter for presenting them.
+	ErrorTemplate = parse("template/error.tmpl")
+
+	// MutationInputTemplate adds the Create<T>Input and Update<T>Input types for
+	// applying GraphQL mutation inputs on the ent builders, and the bulk mutations
+	// of the clients (i.e. CreateFromInputs, UpdateWhere and DeleteWhere).
+	MutationInputTemplate = parse("template/mutation_input.tmpl")
+
+	// WhereTemplate adds the <T>WhereInput types for filtering queries using GraphQL inputs.
+	WhereTemplate = parse("template/where_input.tmpl")
+
+	// GlobalIDTemplate adds Relay global ids to the Node API. The ids are the base64 encoding of the
+	// type name and the id of the nodes, and their tables are resolved without the universal-id
+	// ranges. The GlobalID methods of the types can be used for exposing their id fields:
+	//
+	//	models:
+	//	  Todo:
+	//	    fields:
+	//	      id:
+	//	        fieldName: GlobalID
+	//
+	// Note that it is not part of the AllTemplates, and that inputs referencing nodes by id,
+	// such as the mutation inputs, keep using their local ids. Therefore, the plugin does not
+	// bind the ID scalar, and it should be bound to both the string global ids and the types
+	// of the local ids (e.g. the default gqlgen bindings for int ids).
+	GlobalIDTemplate = parse("template/globalid.tmpl")
+
+	// FederationTemplate adds Apollo Federation entity resolution to the Node API. Entities
+	// are keyed by their id, or by the fields of their Key annotation, and their nodes are
+	// loaded in batches by the Client.Entities method that resolves the _entities field.
+	//
+	// Note that it is not part of the AllTemplates, and that it requires the NodeTemplate.
+	FederationTemplate = parse("template/federation.tmpl")
+
 	// AllTemplates holds all templates for extending ent to support GraphQL.
 	AllTemplates = []*gen.Template{
 		CollectionTemplate,
@@ -51,6 +98,32 @@ var (
 		PaginationTemplate,
 		TransactionTemplate,
 		EdgeTemplate,
+		WhereTemplate,
+		MutationInputTemplate,
+		ErrorTemplate,
+	}
+
+	// TemplateFuncs contains the extra template functions used by entgql.
+	TemplateFuncs = template.FuncMap{
+		"whereFields":    whereFields,
+		"whereOps":       whereOps,
+		"whereOpSuffix":  whereOpSuffix,
+		"orderFields":    orderFields,
+		"orderEdges":     orderEdges,
+		"orderEdgeField": orderEdgeField,
+		"hasOrder":       hasOrder,
+		"isConnection":   isConnection,
+		"windowEdges":    windowEdges,
+		"nodeIDType":     nodeIDType,
+		"pageSize":       pageSize,
+		"edgePageSize":   edgePageSize,
+		"eventNodes":     eventNodes,
+		"errorFields":    errorFields,
+		"gqlFields":      gqlFields,
+		"gqlEdges":       gqlEdges,
+		"enumValues":     enumValues,
+		"federationKeys": federationKeys,
+		"stringType":     func() *field.TypeInfo { return stringType },
 	}
 )
 
@@ -60,5 +133,497 @@ func parse(path string) *gen.Template {
 	text := string(internal.MustAsset(path))
 	return gen.MustParse(gen.NewTemplate(path).
 		Funcs(gen.Funcs).
+		Funcs(TemplateFuncs).
 		Parse(text))
 }
+
+// gqlFields returns the fields of the given type that are exposed in
+// the GraphQL API. That is, the fields that are not annotated with Skip.
+func gqlFields(t *gen.Type) ([]*gen.Field, error) {
+	fields := make([]*gen.Field, 0, len(t.Fields))
+	for _, f := range t.Fields {
+		ant, err := annotation(f.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if !ant.Skip {
+			fields = append(fields, f)
+		}
+	}
+	return fields, nil
+}
+
+// gqlEdges returns the edges of the given type that are exposed in
+// the GraphQL API. That is, the edges that are not annotated with Skip.
+func gqlEdges(t *gen.Type) ([]*gen.Edge, error) {
+	edges := make([]*gen.Edge, 0, len(t.Edges))
+	for _, e := range t.Edges {
+		ant, err := annotation(e.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if !ant.Skip {
+			edges = append(edges, e)
+		}
+	}
+	return edges, nil
+}
+
+// whereFields returns the fields of the given type that are used by its where input.
+// The ID field comes first, and sensitive and skipped fields are omitted.
+func whereFields(t *gen.Type) ([]*gen.Field, error) {
+	exposed, err := gqlFields(t)
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]*gen.Field, 0, len(exposed)+1)
+	for _, f := range append([]*gen.Field{t.ID}, exposed...) {
+		if !f.Sensitive() && len(whereOps(t, f)) > 0 {
+			fields = append(fields, f)
+		}
+	}
+	return fields, nil
+}
+
+// whereOps returns the predicate operations of the given field that are
+// exposed by the where input. The same as the predicates of the field in
+// the generated where.go file.
+func whereOps(t *gen.Type, f *gen.Field) []gen.Op {
+	ops := gen.Funcs["ops"].(func(*gen.Field) []gen.Op)(f)
+	if f != t.ID && t.Storage != nil && t.Storage.Ops != nil {
+		ops = append(ops[:len(ops):len(ops)], t.Storage.Ops(f)...)
+	}
+	return ops
+}
+
+// whereOpSuffix returns the suffix of the where input field for the given operation.
+func whereOpSuffix(op gen.Op) string {
+	if op == gen.EQ {
+		return ""
+	}
+	return op.Name()
+}
+
+// orderFields returns the fields of the given type that are annotated with an order field.
+func orderFields(t *gen.Type) ([]*gen.Field, error) {
+	var fields []*gen.Field
+	for _, f := range append(t.Fields[:len(t.Fields):len(t.Fields)], t.ID) {
+		ant, err := annotation(f.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if ant.OrderField == "" {
+			continue
+		}
+		if ant.Skip {
+			return nil, fmt.Errorf("entgql: skipped field %s.%s cannot be an order field", t.Name, f.Name)
+		}
+		if !f.Type.Comparable() {
+			return nil, fmt.Errorf("entgql: annotated field %s.%s must be comparable", t.Name, f.Name)
+		}
+		values, err := enumValues(t, f)
+		if err != nil {
+			return nil, err
+		}
+		if values != nil && t.Storage.Name != "sql" {
+			return nil, fmt.Errorf("entgql: ordering %s by enum %s in declaration order requires SQL storage", t.Name, f.Name)
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+// orderEdges returns the edges of the given type that are annotated with an order field.
+func orderEdges(t *gen.Type) ([]*gen.Edge, error) {
+	var edges []*gen.Edge
+	for _, e := range t.Edges {
+		ant, err := annotation(e.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if ant.OrderField == "" {
+			continue
+		}
+		if ant.Skip {
+			return nil, fmt.Errorf("entgql: skipped edge %s.%s cannot be an order field", t.Name, e.Name)
+		}
+		if t.Storage.Name != "sql" {
+			return nil, fmt.Errorf("entgql: ordering %s by edge %s requires SQL storage", t.Name, e.Name)
+		}
+		if _, err := orderEdgeField(t, e); err != nil {
+			return nil, err
+		}
+		edges = append(edges, e)
+	}
+	return edges, nil
+}
+
+// orderEdgeField returns the neighbour field used for ordering by the given edge,
+// or nil if the edge is ordered by the count of its neighbours.
+func orderEdgeField(t *gen.Type, e *gen.Edge) (*gen.Field, error) {
+	ant, err := annotation(e.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case ant.OrderEdgeField == "" && e.Unique:
+		return nil, fmt.Errorf("entgql: unique edge %s.%s can be ordered only by a neighbour field", t.Name, e.Name)
+	case ant.OrderEdgeField == "":
+		return nil, nil
+	case !e.Unique:
+		return nil, fmt.Errorf("entgql: non-unique edge %s.%s can be ordered only by its count", t.Name, e.Name)
+	}
+	for _, f := range e.Type.Fields {
+		if f.Name != ant.OrderEdgeField {
+			continue
+		}
+		if !f.Type.Comparable() {
+			return nil, fmt.Errorf("entgql: order field %s.%s of edge %s.%s must be comparable", e.Type.Name, f.Name, t.Name, e.Name)
+		}
+		return f, nil
+	}
+	return nil, fmt.Errorf("entgql: order field %q of edge %s.%s was not found in %s", ant.OrderEdgeField, t.Name, e.Name, e.Type.Name)
+}
+
+// enumValue is a value of an enum field and its name in the GraphQL enum.
+type enumValue struct {
+	// Const is the Go constant of the value (e.g. StatusInProgress).
+	Const string
+	// Value is the value that is stored in the database.
+	Value string
+	// Name is the name of the value in the GraphQL enum.
+	Name string
+}
+
+// enumValues returns the values of the given enum field in their declaration order, with
+// their GraphQL names, or nil if the field is not annotated with EnumValues.
+func enumValues(t *gen.Type, f *gen.Field) ([]*enumValue, error) {
+	ant, err := annotation(f.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	if len(ant.EnumValues) == 0 {
+		return nil, nil
+	}
+	switch {
+	case !f.IsEnum():
+		return nil, fmt.Errorf("entgql: enum values annotation of field %s.%s requires an enum field", t.Name, f.Name)
+	case f.HasGoType():
+		return nil, fmt.Errorf("entgql: enum values annotation of field %s.%s cannot be used with its Go type, which implements its own GraphQL marshaling", t.Name, f.Name)
+	}
+	declared := make(map[string]bool, len(f.Enums))
+	for _, e := range f.Enums {
+		declared[e.Value] = true
+	}
+	unknown := make([]string, 0, len(ant.EnumValues))
+	for v := range ant.EnumValues {
+		if !declared[v] {
+			unknown = append(unknown, v)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("entgql: enum values annotation of field %s.%s maps unknown values %q", t.Name, f.Name, unknown)
+	}
+	values := make([]*enumValue, 0, len(f.Enums))
+	names := make(map[string]string, len(f.Enums))
+	for _, e := range f.Enums {
+		name, ok := ant.EnumValues[e.Value]
+		if !ok {
+			name = e.Value
+		}
+		if !validName(name) {
+			return nil, fmt.Errorf("entgql: enum value %q of field %s.%s is not a valid GraphQL name", name, t.Name, f.Name)
+		}
+		if prev, ok := names[name]; ok {
+			return nil, fmt.Errorf("entgql: enum values %q and %q of field %s.%s have the same GraphQL name %q", prev, e.Value, t.Name, f.Name, name)
+		}
+		names[name] = e.Value
+		values = append(values, &enumValue{Const: e.Name, Value: e.Value, Name: name})
+	}
+	return values, nil
+}
+
+// hasOrder reports if the given type has fields or edges that are annotated with
+// an order field, and therefore its connections accept an orderBy argument.
+func hasOrder(t *gen.Type) (bool, error) {
+	fields, err := orderFields(t)
+	if err != nil {
+		return false, err
+	}
+	edges, err := orderEdges(t)
+	if err != nil {
+		return false, err
+	}
+	return len(fields) > 0 || len(edges) > 0, nil
+}
+
+// isConnection reports if the given edge of the type is annotated with RelayConnection,
+// and is not skipped.
+func isConnection(t *gen.Type, e *gen.Edge) (bool, error) {
+	ant, err := annotation(e.Annotations)
+	if err != nil {
+		return false, err
+	}
+	if ant.RelayConnection && e.Unique {
+		return false, fmt.Errorf("entgql: unique edge %s.%s cannot be a connection", t.Name, e.Name)
+	}
+	return ant.RelayConnection && !ant.Skip, nil
+}
+
+// windowEdges returns the connection edges whose neighbours are the given type, and
+// can be eager-loaded with a window partitioned by the foreign-key of the type. That
+// is, all O2M connection edges to the type. Windows are supported only by SQL storage.
+func windowEdges(t *gen.Type) ([]*gen.Edge, error) {
+	if t.Storage.Name != "sql" {
+		return nil, nil
+	}
+	var edges []*gen.Edge
+	for _, fk := range t.ForeignKeys {
+		// The assoc-edge is held by its owner, and
+		// the inverse-edge is held by the assoc type.
+		holders := [...]struct {
+			edge *gen.Edge
+			typ  *gen.Type
+		}{{fk.Edge, fk.Edge.Owner}, {fk.Edge.Ref, fk.Edge.Type}}
+		for _, h := range holders {
+			if h.edge == nil || h.edge.Type != t || !h.edge.O2M() {
+				continue
+			}
+			ok, err := isConnection(h.typ, h.edge)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				edges = append(edges, h.edge)
+			}
+		}
+	}
+	return edges, nil
+}
+
+// pageSize returns the default and the max page size of the connections of the given type.
+func pageSize(t *gen.Type) ([]int, error) {
+	ant, err := annotation(t.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	return validPageSize(t.Name, ant.DefaultPageSize, ant.MaxPageSize)
+}
+
+// edgePageSize returns the default and the max page size of the given connection edge,
+// where the edge annotation overrides the annotation of its type. It returns nil if the
+// edge is not annotated with a page size.
+func edgePageSize(e *gen.Edge) ([]int, error) {
+	ant, err := annotation(e.Annotations)
+	if err != nil || ant.DefaultPageSize == 0 && ant.MaxPageSize == 0 {
+		return nil, err
+	}
+	size, err := pageSize(e.Type)
+	if err != nil {
+		return nil, err
+	}
+	if ant.DefaultPageSize != 0 {
+		size[0] = ant.DefaultPageSize
+	}
+	if ant.MaxPageSize != 0 {
+		size[1] = ant.MaxPageSize
+	}
+	return validPageSize("edge "+e.Name, size[0], size[1])
+}
+
+func validPageSize(name string, defaultSize, maxSize int) ([]int, error) {
+	switch {
+	case defaultSize < 0 || maxSize < 0:
+		return nil, fmt.Errorf("entgql: page size of %s cannot be negative", name)
+	case maxSize > 0 && defaultSize > maxSize:
+		return nil, fmt.Errorf("entgql: default page size of %s cannot be greater than its max page size", name)
+	}
+	return []int{defaultSize, maxSize}, nil
+}
+
+// errorFields returns the GraphQL names of the fields and edges of the given types, keyed
+// by their ent names, for the field extension of the validation errors. Only names that
+// differ from the ent names are returned. Edges are named by their first MapsTo name, and
+// names that are exposed differently by the types fall back to their camel-case form.
+func errorFields(nodes []*gen.Type) (map[string]string, error) {
+	names := make(map[string]string)
+	add := func(name, gqlName string) {
+		if prev, ok := names[name]; ok && prev != gqlName {
+			gqlName = camel(name)
+		}
+		names[name] = gqlName
+	}
+	for _, t := range nodes {
+		for _, f := range t.Fields {
+			add(f.Name, camel(f.Name))
+		}
+		for _, e := range t.Edges {
+			ant, err := annotation(e.Annotations)
+			if err != nil {
+				return nil, err
+			}
+			name := camel(e.Name)
+			if len(ant.Mapping) > 0 {
+				name = ant.Mapping[0]
+			}
+			add(e.Name, name)
+		}
+	}
+	for name, gqlName := range names {
+		if name == gqlName {
+			delete(names, name)
+		}
+	}
+	return names, nil
+}
+
+// eventNodes returns the types that are annotated with Events.
+func eventNodes(nodes []*gen.Type) ([]*gen.Type, error) {
+	var events []*gen.Type
+	for _, n := range nodes {
+		ant, err := annotation(n.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if ant.Events {
+			events = append(events, n)
+		}
+	}
+	return events, nil
+}
+
+// federationKey is a key field of an Apollo Federation entity.
+type federationKey struct {
+	// Field is the key field of the entity type.
+	Field *gen.Field
+	// Name is the name of the field in the entity representations.
+	Name string
+	// Type is the Go type that the field is decoded to.
+	Type *field.TypeInfo
+	// Decoder is the way the field is decoded from its representation. One of "gql",
+	// "text", "int", "float", "string" and "bool". That is, using the UnmarshalGQL or
+	// UnmarshalText methods of the type, or the gqlgen unmarshaler of its basic type.
+	Decoder string
+}
+
+// federationKeys returns the fields of the Apollo Federation key of the given type. That is,
+// the fields of its Key annotation, or its id field, which is decoded to the ID type of the
+// Node API (see nodeIDType).
+func federationKeys(t *gen.Type, idType *field.TypeInfo) ([]*federationKey, error) {
+	ant, err := annotation(t.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	if len(ant.Key) == 0 || len(ant.Key) == 1 && ant.Key[0] == t.ID.Name {
+		return []*federationKey{{Field: t.ID, Name: t.ID.Name, Type: idType, Decoder: keyDecoder(idType)}}, nil
+	}
+	exposed, err := gqlFields(t)
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]*gen.Field, len(exposed))
+	for _, f := range exposed {
+		fields[f.Name] = f
+	}
+	keys := make([]*federationKey, 0, len(ant.Key))
+	for _, name := range ant.Key {
+		f, ok := fields[name]
+		switch {
+		case name == t.ID.Name:
+			return nil, fmt.Errorf("entgql: key of %s cannot combine its id with other fields", t.Name)
+		case !ok:
+			return nil, fmt.Errorf("entgql: key of %s references an unknown or skipped field %q", t.Name, name)
+		case f.Sensitive():
+			return nil, fmt.Errorf("entgql: key of %s cannot reference the sensitive field %q", t.Name, name)
+		case f.Optional || f.Nillable:
+			return nil, fmt.Errorf("entgql: key of %s cannot reference the optional field %q", t.Name, name)
+		case keyDecoder(f.Type) == "":
+			return nil, fmt.Errorf("entgql: key of %s cannot reference the field %q of type %s", t.Name, name, f.Type)
+		}
+		for _, k := range keys {
+			if k.Field == f {
+				return nil, fmt.Errorf("entgql: key of %s references the field %q more than once", t.Name, name)
+			}
+		}
+		keys = append(keys, &federationKey{Field: f, Name: camel(f.Name), Type: f.Type, Decoder: keyDecoder(f.Type)})
+	}
+	if !uniqueKey(t, keys) {
+		return nil, fmt.Errorf("entgql: key of %s must be backed by a unique field or a unique index", t.Name)
+	}
+	return keys, nil
+}
+
+// uniqueKey reports if the given key fields identify a single node of the type. That is,
+// one of them is unique, or they cover the columns of one of the unique indexes.
+func uniqueKey(t *gen.Type, keys []*federationKey) bool {
+	columns := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		if k.Field.Unique {
+			return true
+		}
+		columns[k.Field.StorageKey()] = true
+	}
+	for _, idx := range t.Indexes {
+		covered := idx.Unique
+		for _, c := range idx.Columns {
+			covered = covered && columns[c]
+		}
+		if covered {
+			return true
+		}
+	}
+	return false
+}
+
+// keyDecoder returns the way a key field of the given type is decoded from its
+// representation, or an empty string if fields of this type cannot be keys.
+func keyDecoder(t *field.TypeInfo) string {
+	if t.RType != nil {
+		if _, ok := t.RType.Methods["UnmarshalGQL"]; ok {
+			return "gql"
+		}
+		if _, ok := t.RType.Methods["UnmarshalText"]; ok {
+			return "text"
+		}
+	}
+	switch {
+	case t.Type == field.TypeEnum:
+		// Enums implement the graphql.Unmarshaler
+		// interface using the EnumTemplate.
+		return "gql"
+	case t.Type == field.TypeUUID:
+		// UUID types are expected to implement the encoding.TextUnmarshaler
+		// interface, as their reflect types are not kept in the schema.
+		return "text"
+	case t.Type.Integer():
+		return "int"
+	case t.Type == field.TypeFloat32 || t.Type == field.TypeFloat64:
+		return "float"
+	case t.Type == field.TypeString:
+		return "string"
+	case t.Type == field.TypeBool:
+		return "bool"
+	default:
+		return ""
+	}
+}
+
+// stringType is the type of string ids that are parsed to the ID type of their table.
+var stringType = &field.TypeInfo{Type: field.TypeString}
+
+// nodeIDType returns the ID type used by the Node API and the cursors. If the types
+// in the graph do not share the same ID type, IDs are passed as strings and parsed
+// to the ID type of their table.
+func nodeIDType(nodes []*gen.Type, defaultType *field.TypeInfo) *field.TypeInfo {
+	if len(nodes) == 0 {
+		return defaultType
+	}
+	t := nodes[0].ID.Type
+	for _, n := range nodes[1:] {
+		if n.ID.Type.String() != t.String() {
+			return stringType
+		}
+	}
+	return t
+}
@@ -16,8 +16,10 @@ package entgql
 
 import (
 	"context"
+	"database/sql"
 	"database/sql/driver"
 	"errors"
+	"fmt"
 	"sync"
 
 	"github.com/99designs/gqlgen/graphql"
@@ -39,12 +41,64 @@ func (f TxOpenerFunc) OpenTx(ctx context.Context) (context.Context, driver.Tx, e
 	return f(ctx)
 }
 
+// TxOptionsOpener represents types than can open transactions with options.
+type TxOptionsOpener interface {
+	OpenTxWithOptions(ctx context.Context, opts *sql.TxOptions) (context.Context, driver.Tx, error)
+}
+
+// TxSavepointer represents transactions that support savepoints.
+type TxSavepointer interface {
+	Savepoint(ctx context.Context, name string) error
+	RollbackToSavepoint(ctx context.Context, name string) error
+	ReleaseSavepoint(ctx context.Context, name string) error
+}
+
 // Transactioner for graphql mutations.
-type Transactioner struct{ TxOpener }
+type Transactioner struct {
+	TxOpener
+
+	// TxOptions holds the isolation level and the read-only flag of the
+	// transactions. It requires the TxOpener to implement TxOptionsOpener.
+	TxOptions *sql.TxOptions
+
+	// MaxRetries is the number of times a mutation is executed again in a new
+	// transaction after it failed on a retryable error. Zero disables retries.
+	MaxRetries int
+
+	// Retryable reports if an error of a mutation is retryable. If nil,
+	// serialization failures and deadlocks are retried. That is, errors
+	// with the SQLSTATE codes 40001 and 40P01 (e.g. PostgreSQL errors).
+	Retryable func(error) bool
+
+	// Savepoints runs each root field of a mutation under a savepoint. A failing
+	// field is rolled back to its savepoint, and the work of the other fields is
+	// committed. It requires the transactions to implement TxSavepointer.
+	Savepoints bool
+
+	// PerField runs each root field of a mutation in its own transaction, which is
+	// committed or rolled back independently of the other fields. The response holds
+	// the results of the fields that succeeded, along with the errors of the failed
+	// ones. A transaction is committed before the selection of its field is resolved,
+	// so a failed commit fails the field, and retryable errors execute the field again.
+	// The entities of transactions opened by the generated OpenTx are queried through
+	// the client after the commit.
+	PerField bool
+
+	// SnapshotQueries runs graphql queries under read-only transactions with the
+	// repeatable-read isolation level, so all their resolvers see a consistent
+	// snapshot of the database. The resolvers are executed serially, and should
+	// use the transactional client of the context (i.e. ent.FromContext). It
+	// requires the TxOpener to implement TxOptionsOpener.
+	SnapshotQueries bool
+}
+
+// snapshotTxOptions are the options of the snapshot transactions of queries.
+var snapshotTxOptions = &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}
 
 var _ interface {
 	graphql.HandlerExtension
 	graphql.OperationContextMutator
+	graphql.OperationInterceptor
 	graphql.ResponseInterceptor
 } = Transactioner{}
 
@@ -58,35 +112,102 @@ func (t Transactioner) Validate(graphql.ExecutableSchema) error {
 	if t.TxOpener == nil {
 		return errors.New("entgql: tx opener is nil")
 	}
+	if _, ok := t.TxOpener.(TxOptionsOpener); (t.TxOptions != nil || t.SnapshotQueries) && !ok {
+		return fmt.Errorf("entgql: tx opener %T does not support tx options", t.TxOpener)
+	}
+	if t.MaxRetries < 0 {
+		return errors.New("entgql: max retries cannot be negative")
+	}
+	if t.Savepoints && t.PerField {
+		return errors.New("entgql: savepoints cannot be used with per-field transactions")
+	}
 	return nil
 }
 
-// MutateOperationContext serializes field resolvers during mutations.
-func (Transactioner) MutateOperationContext(_ context.Context, oc *graphql.OperationContext) *gqlerror.Error {
-	if op := oc.Operation; op != nil && op.Operation == ast.Mutation {
+// MutateOperationContext serializes field resolvers during mutations and snapshot queries.
+func (t Transactioner) MutateOperationContext(_ context.Context, oc *graphql.OperationContext) *gqlerror.Error {
+	if op := oc.Operation; op != nil && (op.Operation == ast.Mutation || op.Operation == ast.Query && t.SnapshotQueries) {
 		previous := oc.ResolverMiddleware
 		var mu sync.Mutex
 		oc.ResolverMiddleware = func(ctx context.Context, next graphql.Resolver) (interface{}, error) {
 			mu.Lock()
 			defer mu.Unlock()
+			ctx = context.WithValue(ctx, serialKey{}, true)
+			if len(graphql.GetFieldContext(ctx).Path()) == 1 {
+				resolve := func(ctx context.Context) (interface{}, error) {
+					return previous(ctx, next)
+				}
+				switch s := ctx.Value(txStateKey{}).(type) {
+				case *txState:
+					return s.savepoint(ctx, resolve)
+				case *txFields:
+					return s.resolve(ctx, resolve)
+				}
+			}
 			return previous(ctx, next)
 		}
 	}
 	return nil
 }
 
-// InterceptResponse runs graphql mutations under a transaction.
+// InterceptOperation executes graphql mutations again if they failed on retryable errors.
+func (t Transactioner) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	if op := graphql.GetOperationContext(ctx).Operation; op == nil || op.Operation != ast.Mutation || t.MaxRetries == 0 || t.PerField {
+		return next(ctx)
+	}
+	var (
+		done    bool
+		handler = next(ctx)
+	)
+	return func(rctx context.Context) *graphql.Response {
+		if done {
+			return nil
+		}
+		done = true
+		r := &txRetry{left: t.MaxRetries}
+		for {
+			rsp := handler(context.WithValue(rctx, txRetryKey{}, r))
+			if !r.retry || rctx.Err() != nil {
+				return rsp
+			}
+			r.left--
+			r.retry = false
+			// Each attempt executes the operation from scratch.
+			handler = next(ctx)
+		}
+	}
+}
+
+// InterceptResponse runs graphql mutations, and optionally queries, under a transaction.
 func (t Transactioner) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
-	if op := graphql.GetOperationContext(ctx).Operation; op == nil || op.Operation != ast.Mutation {
+	op := graphql.GetOperationContext(ctx).Operation
+	switch {
+	case op == nil:
 		return next(ctx)
+	case op.Operation == ast.Query && t.SnapshotQueries:
+		return t.interceptQuery(ctx, next)
+	case op.Operation != ast.Mutation:
+		return next(ctx)
+	case t.PerField:
+		return t.interceptFields(ctx, next)
 	}
-	txCtx, tx, err := t.OpenTx(ctx)
+	txCtx, tx, err := t.openTx(ctx)
 	if err != nil {
 		return graphql.ErrorResponse(ctx,
 			"cannot create transaction: %s", err.Error(),
 		)
 	}
 	ctx = txCtx
+	r, _ := ctx.Value(txRetryKey{}).(*txRetry)
+	s := &txState{}
+	if t.Savepoints {
+		if s.tx, err = savepointer(tx); err != nil {
+			_ = tx.Rollback()
+			return graphql.ErrorResponse(ctx, err.Error())
+		}
+		s.rolledBack = make(map[string]struct{})
+		ctx = context.WithValue(ctx, txStateKey{}, s)
+	}
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -96,15 +217,206 @@ func (t Transactioner) InterceptResponse(ctx context.Context, next graphql.Respo
 	}()
 	rsp := next(ctx)
 	if len(rsp.Errors) > 0 {
-		_ = tx.Rollback()
-		return &graphql.Response{
-			Errors: rsp.Errors,
+		retry := r != nil && r.left > 0 && t.retryableErrors(rsp.Errors)
+		if retry || !s.committable(rsp.Errors) {
+			_ = tx.Rollback()
+			if retry {
+				r.retry = true
+			}
+			return &graphql.Response{
+				Errors: rsp.Errors,
+			}
 		}
 	}
 	if err := tx.Commit(); err != nil {
+		if r != nil && r.left > 0 && t.retryable(err) {
+			r.retry = true
+		}
 		return graphql.ErrorResponse(ctx,
 			"cannot commit transaction: %s", err.Error(),
 		)
 	}
 	return rsp
 }
+
+// interceptQuery runs a graphql query under a read-only snapshot transaction.
+func (t Transactioner) interceptQuery(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	txCtx, tx, err := t.openTxWithOptions(ctx, snapshotTxOptions)
+	if err != nil {
+		return graphql.ErrorResponse(ctx,
+			"cannot create transaction: %s", err.Error(),
+		)
+	}
+	// The transaction is read-only, and
+	// rolled back after the query was resolved.
+	defer func() { _ = tx.Rollback() }()
+	return next(txCtx)
+}
+
+// interceptFields runs the root fields of a mutation under their own transactions.
+func (t Transactioner) interceptFields(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	return next(context.WithValue(ctx, txStateKey{}, &txFields{Transactioner: t}))
+}
+
+// openTx opens a transaction with the configured options.
+func (t Transactioner) openTx(ctx context.Context) (context.Context, driver.Tx, error) {
+	return t.openTxWithOptions(ctx, t.TxOptions)
+}
+
+// openTxWithOptions opens a transaction with the given options, if not nil.
+func (t Transactioner) openTxWithOptions(ctx context.Context, opts *sql.TxOptions) (context.Context, driver.Tx, error) {
+	if opts == nil {
+		return t.OpenTx(ctx)
+	}
+	opener, ok := t.TxOpener.(TxOptionsOpener)
+	if !ok {
+		return nil, nil, fmt.Errorf("tx opener %T does not support tx options", t.TxOpener)
+	}
+	return opener.OpenTxWithOptions(ctx, opts)
+}
+
+// retryable reports if the given error is retryable.
+func (t Transactioner) retryable(err error) bool {
+	if t.Retryable != nil {
+		return t.Retryable(err)
+	}
+	var e interface{ SQLState() string }
+	if !errors.As(err, &e) {
+		return false
+	}
+	switch e.SQLState() {
+	case "40001", "40P01":
+		return true
+	default:
+		return false
+	}
+}
+
+// retryableErrors reports if one of the given errors is retryable.
+func (t Transactioner) retryableErrors(errs gqlerror.List) bool {
+	for _, err := range errs {
+		if t.retryable(err) {
+			return true
+		}
+	}
+	return false
+}
+
+type (
+	txRetryKey struct{}
+	txStateKey struct{}
+	// serialKey marks the context of resolvers that are executed serially.
+	serialKey struct{}
+
+	// txRetry holds the retries state of a mutation.
+	txRetry struct {
+		left  int  // retries left.
+		retry bool // the last attempt failed on a retryable error.
+	}
+
+	// txFields runs the root fields of a mutation under their own transactions.
+	txFields struct {
+		Transactioner
+	}
+
+	// txState holds the savepoints state of a mutation transaction.
+	txState struct {
+		tx         TxSavepointer
+		savepoints int
+		// rolledBack holds the root fields that were
+		// rolled back to their savepoints, by their alias.
+		rolledBack map[string]struct{}
+	}
+)
+
+// savepointer returns the TxSavepointer of the given transaction.
+func savepointer(tx driver.Tx) (TxSavepointer, error) {
+	sp, ok := tx.(TxSavepointer)
+	if !ok {
+		return nil, fmt.Errorf("transaction %T does not support savepoints", tx)
+	}
+	return sp, nil
+}
+
+// savepoint runs the resolver of a root field under a savepoint, and rolls
+// back to the savepoint if the resolver failed or panicked.
+func (s *txState) savepoint(ctx context.Context, next func(context.Context) (interface{}, error)) (_ interface{}, err error) {
+	s.savepoints++
+	name := fmt.Sprintf("entgql_%d", s.savepoints)
+	if err := s.tx.Savepoint(ctx, name); err != nil {
+		return nil, err
+	}
+	defer func() {
+		r := recover()
+		if r == nil && err == nil {
+			err = s.tx.ReleaseSavepoint(ctx, name)
+			return
+		}
+		if s.tx.RollbackToSavepoint(ctx, name) == nil {
+			s.rolledBack[graphql.GetFieldContext(ctx).Field.Alias] = struct{}{}
+		}
+		if r != nil {
+			panic(r)
+		}
+	}()
+	return next(ctx)
+}
+
+// resolve runs the resolver of a root field under a new transaction, which is committed
+// before the field is returned. Thus, a failed commit fails the field.
+func (s *txFields) resolve(ctx context.Context, next func(context.Context) (interface{}, error)) (interface{}, error) {
+	for retries := s.MaxRetries; ; retries-- {
+		res, err := s.resolveTx(ctx, next)
+		if err == nil {
+			return res, nil
+		}
+		if retries == 0 || !s.retryable(err) {
+			return nil, err
+		}
+	}
+}
+
+// resolveTx runs the resolver of a root field under a new transaction, and commits
+// the transaction if the resolver succeeded, or rolls it back otherwise.
+func (s *txFields) resolveTx(ctx context.Context, next func(context.Context) (interface{}, error)) (interface{}, error) {
+	txCtx, tx, err := s.openTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create transaction: %w", err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			_ = tx.Rollback()
+			panic(r)
+		}
+	}()
+	res, err := next(txCtx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("cannot commit transaction: %w", err)
+	}
+	return res, nil
+}
+
+// committable reports if all errors occurred in root fields that
+// were rolled back, and the transaction can be committed.
+func (s *txState) committable(errs gqlerror.List) bool {
+	if s.rolledBack == nil {
+		return false
+	}
+	for _, err := range errs {
+		if len(err.Path) == 0 {
+			return false
+		}
+		name, ok := err.Path[0].(ast.PathName)
+		if !ok {
+			return false
+		}
+		if _, ok := s.rolledBack[string(name)]; !ok {
+			return false
+		}
+	}
+	return true
+}
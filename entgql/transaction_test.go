@@ -16,6 +16,9 @@ package entgql_test
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -27,6 +30,8 @@ import (
 	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
 )
 
 func TestTransaction(t *testing.T) {
@@ -140,4 +145,345 @@ func TestTransaction(t *testing.T) {
 			require.Contains(t, err.Error(), "bad tx")
 		})
 	})
+	t.Run("Options", func(t *testing.T) {
+		t.Parallel()
+		opts := &sql.TxOptions{Isolation: sql.LevelSerializable, ReadOnly: true}
+		err := entgql.Transactioner{TxOpener: &mocks.TxOpener{}, TxOptions: opts}.Validate(nil)
+		require.Error(t, err, "tx opener does not support options")
+
+		var tx mocks.Tx
+		tx.On("Commit").
+			Return(nil).
+			Once()
+		defer tx.AssertExpectations(t)
+
+		var opener optionsOpener
+		opener.On("OpenTxWithOptions", opts).
+			Return(&tx).
+			Once()
+		defer opener.AssertExpectations(t)
+
+		srv := testserver.New()
+		srv.AddTransport(transport.POST{})
+		srv.Use(entgql.Transactioner{TxOpener: &opener, TxOptions: opts})
+		srv.AroundResponses(func(context.Context, graphql.ResponseHandler) *graphql.Response {
+			return &graphql.Response{Data: []byte(`{"name":"test"}`)}
+		})
+		c := client.New(srv)
+		err = c.Post(`mutation { name }`, &struct{ Name string }{})
+		require.NoError(t, err)
+	})
+	t.Run("Snapshot", func(t *testing.T) {
+		t.Parallel()
+		err := entgql.Transactioner{TxOpener: &mocks.TxOpener{}, SnapshotQueries: true}.Validate(nil)
+		require.Error(t, err, "tx opener does not support options")
+
+		var tx mocks.Tx
+		tx.On("Rollback").
+			Return(nil).
+			Once()
+		defer tx.AssertExpectations(t)
+
+		var opener optionsOpener
+		opener.On("OpenTxWithOptions", &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}).
+			Return(&tx).
+			Once()
+		defer opener.AssertExpectations(t)
+
+		srv := testserver.New()
+		srv.AddTransport(transport.POST{})
+		srv.Use(entgql.Transactioner{TxOpener: &opener, SnapshotQueries: true})
+		c := client.New(srv)
+		err = c.Post(`query { name }`, &struct{ Name string }{})
+		require.NoError(t, err)
+	})
+	t.Run("Retry", func(t *testing.T) {
+		t.Parallel()
+		newServer := func(opener entgql.TxOpener, failures int) *testserver.TestServer {
+			srv := testserver.New()
+			srv.AddTransport(transport.POST{})
+			srv.Use(entgql.Transactioner{TxOpener: opener, MaxRetries: 2})
+			srv.AroundResponses(func(context.Context, graphql.ResponseHandler) *graphql.Response {
+				if failures > 0 {
+					failures--
+					return &graphql.Response{Errors: gqlerror.List{
+						gqlerror.WrapPath(ast.Path{ast.PathName("name")}, sqlStateError("40001")),
+					}}
+				}
+				return &graphql.Response{Data: []byte(`{"name":"test"}`)}
+			})
+			return srv
+		}
+		t.Run("OK", func(t *testing.T) {
+			var tx mocks.Tx
+			tx.On("Rollback").
+				Return(nil).
+				Twice()
+			tx.On("Commit").
+				Return(nil).
+				Once()
+			defer tx.AssertExpectations(t)
+
+			var opener mocks.TxOpener
+			opener.On("OpenTx", mock.Anything).
+				Return(fwdCtx, &tx, nil).
+				Times(3)
+			defer opener.AssertExpectations(t)
+
+			c := client.New(newServer(&opener, 2))
+			err := c.Post(`mutation { name }`, &struct{ Name string }{})
+			require.NoError(t, err)
+		})
+		t.Run("Budget", func(t *testing.T) {
+			var tx mocks.Tx
+			tx.On("Rollback").
+				Return(nil).
+				Times(3)
+			defer tx.AssertExpectations(t)
+
+			var opener mocks.TxOpener
+			opener.On("OpenTx", mock.Anything).
+				Return(fwdCtx, &tx, nil).
+				Times(3)
+			defer opener.AssertExpectations(t)
+
+			c := client.New(newServer(&opener, 3))
+			err := c.Post(`mutation { name }`, &struct{ Name string }{})
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "sqlstate 40001")
+		})
+	})
+	t.Run("Savepoints", func(t *testing.T) {
+		t.Parallel()
+		newServer := func(opener entgql.TxOpener) *testserver.TestServer {
+			return newFieldsServer(entgql.Transactioner{TxOpener: opener, Savepoints: true}, func(name string) error {
+				if name == "b" {
+					return errors.New("bad field")
+				}
+				return nil
+			})
+		}
+		t.Run("OK", func(t *testing.T) {
+			var tx savepointTx
+			for _, m := range []string{"Savepoint", "ReleaseSavepoint"} {
+				tx.On(m, "entgql_1").
+					Return(nil).
+					Once()
+			}
+			for _, m := range []string{"Savepoint", "RollbackToSavepoint"} {
+				tx.On(m, "entgql_2").
+					Return(nil).
+					Once()
+			}
+			tx.On("Commit").
+				Return(nil).
+				Once()
+			defer tx.AssertExpectations(t)
+
+			var opener mocks.TxOpener
+			opener.On("OpenTx", mock.Anything).
+				Return(fwdCtx, &tx, nil).
+				Once()
+			defer opener.AssertExpectations(t)
+
+			c := client.New(newServer(&opener))
+			err := c.Post(`mutation { a: name b: name }`, &struct{ A, B *string }{})
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "bad field")
+		})
+		t.Run("NotSupported", func(t *testing.T) {
+			var tx mocks.Tx
+			tx.On("Rollback").
+				Return(nil).
+				Once()
+			defer tx.AssertExpectations(t)
+
+			var opener mocks.TxOpener
+			opener.On("OpenTx", mock.Anything).
+				Return(fwdCtx, &tx, nil).
+				Once()
+			defer opener.AssertExpectations(t)
+
+			c := client.New(newServer(&opener))
+			err := c.Post(`mutation { a: name b: name }`, &struct{ A, B *string }{})
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "does not support savepoints")
+		})
+	})
+	t.Run("PerField", func(t *testing.T) {
+		t.Parallel()
+		t.Run("OK", func(t *testing.T) {
+			var txA, txB mocks.Tx
+			txA.On("Commit").
+				Return(nil).
+				Once()
+			defer txA.AssertExpectations(t)
+			txB.On("Rollback").
+				Return(nil).
+				Once()
+			defer txB.AssertExpectations(t)
+
+			var opener mocks.TxOpener
+			opener.On("OpenTx", mock.Anything).
+				Return(fwdCtx, &txA, nil).
+				Once()
+			opener.On("OpenTx", mock.Anything).
+				Return(fwdCtx, &txB, nil).
+				Once()
+			defer opener.AssertExpectations(t)
+
+			srv := newFieldsServer(entgql.Transactioner{TxOpener: &opener, PerField: true}, func(name string) error {
+				if name == "b" {
+					return errors.New("bad field")
+				}
+				return nil
+			})
+			var rsp struct{ A, B *string }
+			err := client.New(srv).Post(`mutation { a: name b: name }`, &rsp)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "bad field")
+			require.Equal(t, "test", *rsp.A)
+			require.Nil(t, rsp.B)
+		})
+		t.Run("Retry", func(t *testing.T) {
+			var tx mocks.Tx
+			tx.On("Rollback").
+				Return(nil).
+				Once()
+			tx.On("Commit").
+				Return(nil).
+				Twice()
+			defer tx.AssertExpectations(t)
+
+			var opener mocks.TxOpener
+			opener.On("OpenTx", mock.Anything).
+				Return(fwdCtx, &tx, nil).
+				Times(3)
+			defer opener.AssertExpectations(t)
+
+			failed := false
+			srv := newFieldsServer(entgql.Transactioner{TxOpener: &opener, PerField: true, MaxRetries: 1}, func(name string) error {
+				if name == "b" && !failed {
+					failed = true
+					return sqlStateError("40P01")
+				}
+				return nil
+			})
+			var rsp struct{ A, B string }
+			err := client.New(srv).Post(`mutation { a: name b: name }`, &rsp)
+			require.NoError(t, err)
+			require.Equal(t, "test", rsp.B)
+		})
+		t.Run("CommitErr", func(t *testing.T) {
+			var tx mocks.Tx
+			tx.On("Commit").
+				Return(nil).
+				Once()
+			tx.On("Commit").
+				Return(errors.New("bad commit")).
+				Once()
+			defer tx.AssertExpectations(t)
+
+			var opener mocks.TxOpener
+			opener.On("OpenTx", mock.Anything).
+				Return(fwdCtx, &tx, nil).
+				Twice()
+			defer opener.AssertExpectations(t)
+
+			srv := newFieldsServer(entgql.Transactioner{TxOpener: &opener, PerField: true}, func(string) error { return nil })
+			err := client.New(srv).Post(`mutation { a: name b: name }`, &struct{ A, B string }{})
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "bad commit")
+		})
+		t.Run("FieldCommitErr", func(t *testing.T) {
+			var txA, txB mocks.Tx
+			txA.On("Commit").
+				Return(errors.New("bad commit")).
+				Once()
+			defer txA.AssertExpectations(t)
+			txB.On("Commit").
+				Return(nil).
+				Once()
+			defer txB.AssertExpectations(t)
+
+			var opener mocks.TxOpener
+			opener.On("OpenTx", mock.Anything).
+				Return(fwdCtx, &txA, nil).
+				Once()
+			opener.On("OpenTx", mock.Anything).
+				Return(fwdCtx, &txB, nil).
+				Once()
+			defer opener.AssertExpectations(t)
+
+			srv := newFieldsServer(entgql.Transactioner{TxOpener: &opener, PerField: true}, func(string) error { return nil })
+			var rsp struct{ A, B *string }
+			err := client.New(srv).Post(`mutation { a: name b: name }`, &rsp)
+			require.EqualError(t, err, `[{"message":"cannot commit transaction: bad commit","path":["a"]}]`)
+			require.Nil(t, rsp.A, "fields whose commit failed are null")
+			require.Equal(t, "test", *rsp.B)
+		})
+	})
+}
+
+// newFieldsServer returns a server that resolves two root fields named "a" and "b".
+func newFieldsServer(tr entgql.Transactioner, resolve func(string) error) *testserver.TestServer {
+	srv := testserver.New()
+	srv.AddTransport(transport.POST{})
+	srv.Use(tr)
+	srv.AroundResponses(func(ctx context.Context, _ graphql.ResponseHandler) *graphql.Response {
+		data := make(map[string]interface{})
+		for _, name := range []string{"a", "b"} {
+			fctx := graphql.WithFieldContext(ctx, &graphql.FieldContext{
+				Object: "Mutation",
+				Field: graphql.CollectedField{
+					Field: &ast.Field{Name: "name", Alias: name},
+				},
+			})
+			res, err := graphql.GetOperationContext(ctx).ResolverMiddleware(fctx, func(context.Context) (interface{}, error) {
+				if err := resolve(name); err != nil {
+					return nil, err
+				}
+				return "test", nil
+			})
+			if err != nil {
+				graphql.AddError(fctx, err)
+			}
+			data[name] = res
+		}
+		buf, err := json.Marshal(data)
+		if err != nil {
+			panic(err)
+		}
+		return &graphql.Response{Data: buf, Errors: graphql.GetErrors(ctx)}
+	})
+	return srv
+}
+
+type optionsOpener struct {
+	mocks.TxOpener
+}
+
+func (o *optionsOpener) OpenTxWithOptions(ctx context.Context, opts *sql.TxOptions) (context.Context, driver.Tx, error) {
+	return ctx, o.Called(opts).Get(0).(driver.Tx), nil
 }
+
+type savepointTx struct {
+	mocks.Tx
+}
+
+func (tx *savepointTx) Savepoint(_ context.Context, name string) error {
+	return tx.Called(name).Error(0)
+}
+
+func (tx *savepointTx) RollbackToSavepoint(_ context.Context, name string) error {
+	return tx.Called(name).Error(0)
+}
+
+func (tx *savepointTx) ReleaseSavepoint(_ context.Context, name string) error {
+	return tx.Called(name).Error(0)
+}
+
+type sqlStateError string
+
+func (e sqlStateError) Error() string    { return "sqlstate " + string(e) }
+func (e sqlStateError) SQLState() string { return string(e) }